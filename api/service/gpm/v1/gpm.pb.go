@@ -590,6 +590,8 @@ var xxx_messageInfo_StartServiceRsp proto.InternalMessageInfo
 type StopServiceReq struct {
 	// +gen:required
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 是否先停止依赖此服务的服务
+	Dependents bool `protobuf:"varint,2,opt,name=dependents,proto3" json:"dependents,omitempty"`
 }
 
 func (m *StopServiceReq) Reset()         { *m = StopServiceReq{} }
@@ -737,23 +739,23 @@ func (m *RestartServiceRsp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestartServiceRsp proto.InternalMessageInfo
 
-type DeleteServiceReq struct {
+type RunServiceReq struct {
 	// +gen:required
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *DeleteServiceReq) Reset()         { *m = DeleteServiceReq{} }
-func (m *DeleteServiceReq) String() string { return proto.CompactTextString(m) }
-func (*DeleteServiceReq) ProtoMessage()    {}
-func (*DeleteServiceReq) Descriptor() ([]byte, []int) {
+func (m *RunServiceReq) Reset()         { *m = RunServiceReq{} }
+func (m *RunServiceReq) String() string { return proto.CompactTextString(m) }
+func (*RunServiceReq) ProtoMessage()    {}
+func (*RunServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{19}
 }
-func (m *DeleteServiceReq) XXX_Unmarshal(b []byte) error {
+func (m *RunServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RunServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteServiceReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RunServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,34 +765,34 @@ func (m *DeleteServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeleteServiceReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteServiceReq.Merge(m, src)
+func (m *RunServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunServiceReq.Merge(m, src)
 }
-func (m *DeleteServiceReq) XXX_Size() int {
+func (m *RunServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *DeleteServiceReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteServiceReq.DiscardUnknown(m)
+func (m *RunServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteServiceReq proto.InternalMessageInfo
+var xxx_messageInfo_RunServiceReq proto.InternalMessageInfo
 
-type DeleteServiceRsp struct {
+type RunServiceRsp struct {
 	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *DeleteServiceRsp) Reset()         { *m = DeleteServiceRsp{} }
-func (m *DeleteServiceRsp) String() string { return proto.CompactTextString(m) }
-func (*DeleteServiceRsp) ProtoMessage()    {}
-func (*DeleteServiceRsp) Descriptor() ([]byte, []int) {
+func (m *RunServiceRsp) Reset()         { *m = RunServiceRsp{} }
+func (m *RunServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*RunServiceRsp) ProtoMessage()    {}
+func (*RunServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{20}
 }
-func (m *DeleteServiceRsp) XXX_Unmarshal(b []byte) error {
+func (m *RunServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RunServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteServiceRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RunServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -800,37 +802,38 @@ func (m *DeleteServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeleteServiceRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteServiceRsp.Merge(m, src)
+func (m *RunServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunServiceRsp.Merge(m, src)
 }
-func (m *DeleteServiceRsp) XXX_Size() int {
+func (m *RunServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *DeleteServiceRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteServiceRsp.DiscardUnknown(m)
+func (m *RunServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteServiceRsp proto.InternalMessageInfo
+var xxx_messageInfo_RunServiceRsp proto.InternalMessageInfo
 
-type WatchServiceLogReq struct {
+type SignalServiceReq struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 信号名称或编号, 如 SIGHUP, HUP, 1
 	// +gen:required
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Number int64  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
-	Follow bool   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	Signal string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
 }
 
-func (m *WatchServiceLogReq) Reset()         { *m = WatchServiceLogReq{} }
-func (m *WatchServiceLogReq) String() string { return proto.CompactTextString(m) }
-func (*WatchServiceLogReq) ProtoMessage()    {}
-func (*WatchServiceLogReq) Descriptor() ([]byte, []int) {
+func (m *SignalServiceReq) Reset()         { *m = SignalServiceReq{} }
+func (m *SignalServiceReq) String() string { return proto.CompactTextString(m) }
+func (*SignalServiceReq) ProtoMessage()    {}
+func (*SignalServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{21}
 }
-func (m *WatchServiceLogReq) XXX_Unmarshal(b []byte) error {
+func (m *SignalServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchServiceLogReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignalServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchServiceLogReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignalServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,34 +843,34 @@ func (m *WatchServiceLogReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *WatchServiceLogReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchServiceLogReq.Merge(m, src)
+func (m *SignalServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalServiceReq.Merge(m, src)
 }
-func (m *WatchServiceLogReq) XXX_Size() int {
+func (m *SignalServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *WatchServiceLogReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchServiceLogReq.DiscardUnknown(m)
+func (m *SignalServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchServiceLogReq proto.InternalMessageInfo
+var xxx_messageInfo_SignalServiceReq proto.InternalMessageInfo
 
-type WatchServiceLogRsp struct {
-	Log *v1.ServiceLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+type SignalServiceRsp struct {
+	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *WatchServiceLogRsp) Reset()         { *m = WatchServiceLogRsp{} }
-func (m *WatchServiceLogRsp) String() string { return proto.CompactTextString(m) }
-func (*WatchServiceLogRsp) ProtoMessage()    {}
-func (*WatchServiceLogRsp) Descriptor() ([]byte, []int) {
+func (m *SignalServiceRsp) Reset()         { *m = SignalServiceRsp{} }
+func (m *SignalServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*SignalServiceRsp) ProtoMessage()    {}
+func (*SignalServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{22}
 }
-func (m *WatchServiceLogRsp) XXX_Unmarshal(b []byte) error {
+func (m *SignalServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchServiceLogRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignalServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchServiceLogRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignalServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -877,34 +880,35 @@ func (m *WatchServiceLogRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *WatchServiceLogRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchServiceLogRsp.Merge(m, src)
+func (m *SignalServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalServiceRsp.Merge(m, src)
 }
-func (m *WatchServiceLogRsp) XXX_Size() int {
+func (m *SignalServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *WatchServiceLogRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchServiceLogRsp.DiscardUnknown(m)
+func (m *SignalServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchServiceLogRsp proto.InternalMessageInfo
+var xxx_messageInfo_SignalServiceRsp proto.InternalMessageInfo
 
-type InstallServiceReq struct {
-	In *v1.InstallServiceIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+type ReloadServiceReq struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *InstallServiceReq) Reset()         { *m = InstallServiceReq{} }
-func (m *InstallServiceReq) String() string { return proto.CompactTextString(m) }
-func (*InstallServiceReq) ProtoMessage()    {}
-func (*InstallServiceReq) Descriptor() ([]byte, []int) {
+func (m *ReloadServiceReq) Reset()         { *m = ReloadServiceReq{} }
+func (m *ReloadServiceReq) String() string { return proto.CompactTextString(m) }
+func (*ReloadServiceReq) ProtoMessage()    {}
+func (*ReloadServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{23}
 }
-func (m *InstallServiceReq) XXX_Unmarshal(b []byte) error {
+func (m *ReloadServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstallServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReloadServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstallServiceReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReloadServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -914,34 +918,34 @@ func (m *InstallServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *InstallServiceReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstallServiceReq.Merge(m, src)
+func (m *ReloadServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadServiceReq.Merge(m, src)
 }
-func (m *InstallServiceReq) XXX_Size() int {
+func (m *ReloadServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *InstallServiceReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstallServiceReq.DiscardUnknown(m)
+func (m *ReloadServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstallServiceReq proto.InternalMessageInfo
+var xxx_messageInfo_ReloadServiceReq proto.InternalMessageInfo
 
-type InstallServiceRsp struct {
-	Result *v1.InstallServiceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type ReloadServiceRsp struct {
+	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *InstallServiceRsp) Reset()         { *m = InstallServiceRsp{} }
-func (m *InstallServiceRsp) String() string { return proto.CompactTextString(m) }
-func (*InstallServiceRsp) ProtoMessage()    {}
-func (*InstallServiceRsp) Descriptor() ([]byte, []int) {
+func (m *ReloadServiceRsp) Reset()         { *m = ReloadServiceRsp{} }
+func (m *ReloadServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*ReloadServiceRsp) ProtoMessage()    {}
+func (*ReloadServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{24}
 }
-func (m *InstallServiceRsp) XXX_Unmarshal(b []byte) error {
+func (m *ReloadServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstallServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReloadServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstallServiceRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReloadServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -951,35 +955,35 @@ func (m *InstallServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *InstallServiceRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstallServiceRsp.Merge(m, src)
+func (m *ReloadServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadServiceRsp.Merge(m, src)
 }
-func (m *InstallServiceRsp) XXX_Size() int {
+func (m *ReloadServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *InstallServiceRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstallServiceRsp.DiscardUnknown(m)
+func (m *ReloadServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstallServiceRsp proto.InternalMessageInfo
+var xxx_messageInfo_ReloadServiceRsp proto.InternalMessageInfo
 
-type ListServiceVersionsReq struct {
+type PauseServiceReq struct {
 	// +gen:required
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *ListServiceVersionsReq) Reset()         { *m = ListServiceVersionsReq{} }
-func (m *ListServiceVersionsReq) String() string { return proto.CompactTextString(m) }
-func (*ListServiceVersionsReq) ProtoMessage()    {}
-func (*ListServiceVersionsReq) Descriptor() ([]byte, []int) {
+func (m *PauseServiceReq) Reset()         { *m = PauseServiceReq{} }
+func (m *PauseServiceReq) String() string { return proto.CompactTextString(m) }
+func (*PauseServiceReq) ProtoMessage()    {}
+func (*PauseServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{25}
 }
-func (m *ListServiceVersionsReq) XXX_Unmarshal(b []byte) error {
+func (m *PauseServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListServiceVersionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListServiceVersionsReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -989,34 +993,34 @@ func (m *ListServiceVersionsReq) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ListServiceVersionsReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListServiceVersionsReq.Merge(m, src)
+func (m *PauseServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseServiceReq.Merge(m, src)
 }
-func (m *ListServiceVersionsReq) XXX_Size() int {
+func (m *PauseServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ListServiceVersionsReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListServiceVersionsReq.DiscardUnknown(m)
+func (m *PauseServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListServiceVersionsReq proto.InternalMessageInfo
+var xxx_messageInfo_PauseServiceReq proto.InternalMessageInfo
 
-type ListServiceVersionsRsp struct {
-	Versions []*v1.ServiceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+type PauseServiceRsp struct {
+	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *ListServiceVersionsRsp) Reset()         { *m = ListServiceVersionsRsp{} }
-func (m *ListServiceVersionsRsp) String() string { return proto.CompactTextString(m) }
-func (*ListServiceVersionsRsp) ProtoMessage()    {}
-func (*ListServiceVersionsRsp) Descriptor() ([]byte, []int) {
+func (m *PauseServiceRsp) Reset()         { *m = PauseServiceRsp{} }
+func (m *PauseServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*PauseServiceRsp) ProtoMessage()    {}
+func (*PauseServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{26}
 }
-func (m *ListServiceVersionsRsp) XXX_Unmarshal(b []byte) error {
+func (m *PauseServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListServiceVersionsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListServiceVersionsRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,34 +1030,35 @@ func (m *ListServiceVersionsRsp) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ListServiceVersionsRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListServiceVersionsRsp.Merge(m, src)
+func (m *PauseServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseServiceRsp.Merge(m, src)
 }
-func (m *ListServiceVersionsRsp) XXX_Size() int {
+func (m *PauseServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ListServiceVersionsRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListServiceVersionsRsp.DiscardUnknown(m)
+func (m *PauseServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListServiceVersionsRsp proto.InternalMessageInfo
+var xxx_messageInfo_PauseServiceRsp proto.InternalMessageInfo
 
-type UpgradeServiceReq struct {
-	In *v1.UpgradeServiceIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+type ResumeServiceReq struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *UpgradeServiceReq) Reset()         { *m = UpgradeServiceReq{} }
-func (m *UpgradeServiceReq) String() string { return proto.CompactTextString(m) }
-func (*UpgradeServiceReq) ProtoMessage()    {}
-func (*UpgradeServiceReq) Descriptor() ([]byte, []int) {
+func (m *ResumeServiceReq) Reset()         { *m = ResumeServiceReq{} }
+func (m *ResumeServiceReq) String() string { return proto.CompactTextString(m) }
+func (*ResumeServiceReq) ProtoMessage()    {}
+func (*ResumeServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{27}
 }
-func (m *UpgradeServiceReq) XXX_Unmarshal(b []byte) error {
+func (m *ResumeServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpgradeServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResumeServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpgradeServiceReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResumeServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1063,34 +1068,34 @@ func (m *UpgradeServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *UpgradeServiceReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeServiceReq.Merge(m, src)
+func (m *ResumeServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeServiceReq.Merge(m, src)
 }
-func (m *UpgradeServiceReq) XXX_Size() int {
+func (m *ResumeServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpgradeServiceReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeServiceReq.DiscardUnknown(m)
+func (m *ResumeServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeServiceReq proto.InternalMessageInfo
+var xxx_messageInfo_ResumeServiceReq proto.InternalMessageInfo
 
-type UpgradeServiceRsp struct {
-	Result *v1.UpgradeServiceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type ResumeServiceRsp struct {
+	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *UpgradeServiceRsp) Reset()         { *m = UpgradeServiceRsp{} }
-func (m *UpgradeServiceRsp) String() string { return proto.CompactTextString(m) }
-func (*UpgradeServiceRsp) ProtoMessage()    {}
-func (*UpgradeServiceRsp) Descriptor() ([]byte, []int) {
+func (m *ResumeServiceRsp) Reset()         { *m = ResumeServiceRsp{} }
+func (m *ResumeServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*ResumeServiceRsp) ProtoMessage()    {}
+func (*ResumeServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{28}
 }
-func (m *UpgradeServiceRsp) XXX_Unmarshal(b []byte) error {
+func (m *ResumeServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpgradeServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResumeServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpgradeServiceRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResumeServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1100,36 +1105,35 @@ func (m *UpgradeServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *UpgradeServiceRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeServiceRsp.Merge(m, src)
+func (m *ResumeServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeServiceRsp.Merge(m, src)
 }
-func (m *UpgradeServiceRsp) XXX_Size() int {
+func (m *ResumeServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpgradeServiceRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeServiceRsp.DiscardUnknown(m)
+func (m *ResumeServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeServiceRsp proto.InternalMessageInfo
+var xxx_messageInfo_ResumeServiceRsp proto.InternalMessageInfo
 
-type RollbackServiceReq struct {
+type DeleteServiceReq struct {
 	// +gen:required
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *RollbackServiceReq) Reset()         { *m = RollbackServiceReq{} }
-func (m *RollbackServiceReq) String() string { return proto.CompactTextString(m) }
-func (*RollbackServiceReq) ProtoMessage()    {}
-func (*RollbackServiceReq) Descriptor() ([]byte, []int) {
+func (m *DeleteServiceReq) Reset()         { *m = DeleteServiceReq{} }
+func (m *DeleteServiceReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteServiceReq) ProtoMessage()    {}
+func (*DeleteServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{29}
 }
-func (m *RollbackServiceReq) XXX_Unmarshal(b []byte) error {
+func (m *DeleteServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RollbackServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RollbackServiceReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1139,33 +1143,34 @@ func (m *RollbackServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RollbackServiceReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RollbackServiceReq.Merge(m, src)
+func (m *DeleteServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteServiceReq.Merge(m, src)
 }
-func (m *RollbackServiceReq) XXX_Size() int {
+func (m *DeleteServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *RollbackServiceReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_RollbackServiceReq.DiscardUnknown(m)
+func (m *DeleteServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RollbackServiceReq proto.InternalMessageInfo
+var xxx_messageInfo_DeleteServiceReq proto.InternalMessageInfo
 
-type RollbackServiceRsp struct {
+type DeleteServiceRsp struct {
+	Service *v1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (m *RollbackServiceRsp) Reset()         { *m = RollbackServiceRsp{} }
-func (m *RollbackServiceRsp) String() string { return proto.CompactTextString(m) }
-func (*RollbackServiceRsp) ProtoMessage()    {}
-func (*RollbackServiceRsp) Descriptor() ([]byte, []int) {
+func (m *DeleteServiceRsp) Reset()         { *m = DeleteServiceRsp{} }
+func (m *DeleteServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*DeleteServiceRsp) ProtoMessage()    {}
+func (*DeleteServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{30}
 }
-func (m *RollbackServiceRsp) XXX_Unmarshal(b []byte) error {
+func (m *DeleteServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RollbackServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RollbackServiceRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1175,36 +1180,46 @@ func (m *RollbackServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RollbackServiceRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RollbackServiceRsp.Merge(m, src)
+func (m *DeleteServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteServiceRsp.Merge(m, src)
 }
-func (m *RollbackServiceRsp) XXX_Size() int {
+func (m *DeleteServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *RollbackServiceRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_RollbackServiceRsp.DiscardUnknown(m)
+func (m *DeleteServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RollbackServiceRsp proto.InternalMessageInfo
+var xxx_messageInfo_DeleteServiceRsp proto.InternalMessageInfo
 
-type ForgetServiceReq struct {
+type WatchServiceLogReq struct {
 	// +gen:required
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 返回最后 number 行日志
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Follow bool  `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	// 只返回标准错误的日志
+	StderrOnly bool `protobuf:"varint,4,opt,name=stderrOnly,proto3" json:"stderrOnly,omitempty"`
+	// 只返回此时间 (unix 秒) 之后写入的日志
+	Since int64 `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+	// 只返回此时间 (unix 秒) 之前写入的日志
+	Until int64 `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`
+	// 只返回匹配此正则表达式的日志
+	Grep string `protobuf:"bytes,7,opt,name=grep,proto3" json:"grep,omitempty"`
 }
 
-func (m *ForgetServiceReq) Reset()         { *m = ForgetServiceReq{} }
-func (m *ForgetServiceReq) String() string { return proto.CompactTextString(m) }
-func (*ForgetServiceReq) ProtoMessage()    {}
-func (*ForgetServiceReq) Descriptor() ([]byte, []int) {
+func (m *WatchServiceLogReq) Reset()         { *m = WatchServiceLogReq{} }
+func (m *WatchServiceLogReq) String() string { return proto.CompactTextString(m) }
+func (*WatchServiceLogReq) ProtoMessage()    {}
+func (*WatchServiceLogReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{31}
 }
-func (m *ForgetServiceReq) XXX_Unmarshal(b []byte) error {
+func (m *WatchServiceLogReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ForgetServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchServiceLogReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ForgetServiceReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchServiceLogReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1214,33 +1229,34 @@ func (m *ForgetServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ForgetServiceReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForgetServiceReq.Merge(m, src)
+func (m *WatchServiceLogReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchServiceLogReq.Merge(m, src)
 }
-func (m *ForgetServiceReq) XXX_Size() int {
+func (m *WatchServiceLogReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ForgetServiceReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForgetServiceReq.DiscardUnknown(m)
+func (m *WatchServiceLogReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchServiceLogReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForgetServiceReq proto.InternalMessageInfo
+var xxx_messageInfo_WatchServiceLogReq proto.InternalMessageInfo
 
-type ForgetServiceRsp struct {
+type WatchServiceLogRsp struct {
+	Log *v1.ServiceLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 }
 
-func (m *ForgetServiceRsp) Reset()         { *m = ForgetServiceRsp{} }
-func (m *ForgetServiceRsp) String() string { return proto.CompactTextString(m) }
-func (*ForgetServiceRsp) ProtoMessage()    {}
-func (*ForgetServiceRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a737174c368a3c5b, []int{32}
-}
-func (m *ForgetServiceRsp) XXX_Unmarshal(b []byte) error {
+func (m *WatchServiceLogRsp) Reset()         { *m = WatchServiceLogRsp{} }
+func (m *WatchServiceLogRsp) String() string { return proto.CompactTextString(m) }
+func (*WatchServiceLogRsp) ProtoMessage()    {}
+func (*WatchServiceLogRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{32}
+}
+func (m *WatchServiceLogRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ForgetServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchServiceLogRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ForgetServiceRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchServiceLogRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1250,35 +1266,40 @@ func (m *ForgetServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ForgetServiceRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForgetServiceRsp.Merge(m, src)
+func (m *WatchServiceLogRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchServiceLogRsp.Merge(m, src)
 }
-func (m *ForgetServiceRsp) XXX_Size() int {
+func (m *WatchServiceLogRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ForgetServiceRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForgetServiceRsp.DiscardUnknown(m)
+func (m *WatchServiceLogRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchServiceLogRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForgetServiceRsp proto.InternalMessageInfo
-
-type LsReq struct {
-	// +gen:required
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-}
+var xxx_messageInfo_WatchServiceLogRsp proto.InternalMessageInfo
 
-func (m *LsReq) Reset()         { *m = LsReq{} }
-func (m *LsReq) String() string { return proto.CompactTextString(m) }
-func (*LsReq) ProtoMessage()    {}
-func (*LsReq) Descriptor() ([]byte, []int) {
+type WatchServicesLogReq struct {
+	// 服务名称
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// 标签选择器, 如 app=web,env!=dev
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// 选择所有服务
+	All     bool           `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	Options *v1.LogOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *WatchServicesLogReq) Reset()         { *m = WatchServicesLogReq{} }
+func (m *WatchServicesLogReq) String() string { return proto.CompactTextString(m) }
+func (*WatchServicesLogReq) ProtoMessage()    {}
+func (*WatchServicesLogReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{33}
 }
-func (m *LsReq) XXX_Unmarshal(b []byte) error {
+func (m *WatchServicesLogReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchServicesLogReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LsReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchServicesLogReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1288,34 +1309,34 @@ func (m *LsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LsReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LsReq.Merge(m, src)
+func (m *WatchServicesLogReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchServicesLogReq.Merge(m, src)
 }
-func (m *LsReq) XXX_Size() int {
+func (m *WatchServicesLogReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *LsReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_LsReq.DiscardUnknown(m)
+func (m *WatchServicesLogReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchServicesLogReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LsReq proto.InternalMessageInfo
+var xxx_messageInfo_WatchServicesLogReq proto.InternalMessageInfo
 
-type LsRsp struct {
-	Files []*v1.FileInfo `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+type WatchServicesLogRsp struct {
+	Log *v1.ServiceLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 }
 
-func (m *LsRsp) Reset()         { *m = LsRsp{} }
-func (m *LsRsp) String() string { return proto.CompactTextString(m) }
-func (*LsRsp) ProtoMessage()    {}
-func (*LsRsp) Descriptor() ([]byte, []int) {
+func (m *WatchServicesLogRsp) Reset()         { *m = WatchServicesLogRsp{} }
+func (m *WatchServicesLogRsp) String() string { return proto.CompactTextString(m) }
+func (*WatchServicesLogRsp) ProtoMessage()    {}
+func (*WatchServicesLogRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{34}
 }
-func (m *LsRsp) XXX_Unmarshal(b []byte) error {
+func (m *WatchServicesLogRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchServicesLogRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LsRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchServicesLogRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1325,35 +1346,34 @@ func (m *LsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LsRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LsRsp.Merge(m, src)
+func (m *WatchServicesLogRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchServicesLogRsp.Merge(m, src)
 }
-func (m *LsRsp) XXX_Size() int {
+func (m *WatchServicesLogRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *LsRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_LsRsp.DiscardUnknown(m)
+func (m *WatchServicesLogRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchServicesLogRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LsRsp proto.InternalMessageInfo
+var xxx_messageInfo_WatchServicesLogRsp proto.InternalMessageInfo
 
-type PullReq struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Dir  bool   `protobuf:"varint,2,opt,name=dir,proto3" json:"dir,omitempty"`
+type InstallServiceReq struct {
+	In *v1.InstallServiceIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
 }
 
-func (m *PullReq) Reset()         { *m = PullReq{} }
-func (m *PullReq) String() string { return proto.CompactTextString(m) }
-func (*PullReq) ProtoMessage()    {}
-func (*PullReq) Descriptor() ([]byte, []int) {
+func (m *InstallServiceReq) Reset()         { *m = InstallServiceReq{} }
+func (m *InstallServiceReq) String() string { return proto.CompactTextString(m) }
+func (*InstallServiceReq) ProtoMessage()    {}
+func (*InstallServiceReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{35}
 }
-func (m *PullReq) XXX_Unmarshal(b []byte) error {
+func (m *InstallServiceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstallServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstallServiceReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1363,34 +1383,34 @@ func (m *PullReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PullReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullReq.Merge(m, src)
+func (m *InstallServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallServiceReq.Merge(m, src)
 }
-func (m *PullReq) XXX_Size() int {
+func (m *InstallServiceReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PullReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullReq.DiscardUnknown(m)
+func (m *InstallServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallServiceReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullReq proto.InternalMessageInfo
+var xxx_messageInfo_InstallServiceReq proto.InternalMessageInfo
 
-type PullRsp struct {
-	Result *v1.PullResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type InstallServiceRsp struct {
+	Result *v1.InstallServiceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *PullRsp) Reset()         { *m = PullRsp{} }
-func (m *PullRsp) String() string { return proto.CompactTextString(m) }
-func (*PullRsp) ProtoMessage()    {}
-func (*PullRsp) Descriptor() ([]byte, []int) {
+func (m *InstallServiceRsp) Reset()         { *m = InstallServiceRsp{} }
+func (m *InstallServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*InstallServiceRsp) ProtoMessage()    {}
+func (*InstallServiceRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{36}
 }
-func (m *PullRsp) XXX_Unmarshal(b []byte) error {
+func (m *InstallServiceRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstallServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstallServiceRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1400,34 +1420,35 @@ func (m *PullRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PullRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullRsp.Merge(m, src)
+func (m *InstallServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallServiceRsp.Merge(m, src)
 }
-func (m *PullRsp) XXX_Size() int {
+func (m *InstallServiceRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PullRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullRsp.DiscardUnknown(m)
+func (m *InstallServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallServiceRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullRsp proto.InternalMessageInfo
+var xxx_messageInfo_InstallServiceRsp proto.InternalMessageInfo
 
-type PushReq struct {
-	In *v1.PushIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+type ListServiceVersionsReq struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *PushReq) Reset()         { *m = PushReq{} }
-func (m *PushReq) String() string { return proto.CompactTextString(m) }
-func (*PushReq) ProtoMessage()    {}
-func (*PushReq) Descriptor() ([]byte, []int) {
+func (m *ListServiceVersionsReq) Reset()         { *m = ListServiceVersionsReq{} }
+func (m *ListServiceVersionsReq) String() string { return proto.CompactTextString(m) }
+func (*ListServiceVersionsReq) ProtoMessage()    {}
+func (*ListServiceVersionsReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{37}
 }
-func (m *PushReq) XXX_Unmarshal(b []byte) error {
+func (m *ListServiceVersionsReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServiceVersionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServiceVersionsReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1437,33 +1458,34 @@ func (m *PushReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PushReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushReq.Merge(m, src)
+func (m *ListServiceVersionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceVersionsReq.Merge(m, src)
 }
-func (m *PushReq) XXX_Size() int {
+func (m *ListServiceVersionsReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PushReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushReq.DiscardUnknown(m)
+func (m *ListServiceVersionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceVersionsReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushReq proto.InternalMessageInfo
+var xxx_messageInfo_ListServiceVersionsReq proto.InternalMessageInfo
 
-type PushRsp struct {
+type ListServiceVersionsRsp struct {
+	Versions []*v1.ServiceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (m *PushRsp) Reset()         { *m = PushRsp{} }
-func (m *PushRsp) String() string { return proto.CompactTextString(m) }
-func (*PushRsp) ProtoMessage()    {}
-func (*PushRsp) Descriptor() ([]byte, []int) {
+func (m *ListServiceVersionsRsp) Reset()         { *m = ListServiceVersionsRsp{} }
+func (m *ListServiceVersionsRsp) String() string { return proto.CompactTextString(m) }
+func (*ListServiceVersionsRsp) ProtoMessage()    {}
+func (*ListServiceVersionsRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{38}
 }
-func (m *PushRsp) XXX_Unmarshal(b []byte) error {
+func (m *ListServiceVersionsRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServiceVersionsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServiceVersionsRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1473,35 +1495,35 @@ func (m *PushRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PushRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushRsp.Merge(m, src)
+func (m *ListServiceVersionsRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceVersionsRsp.Merge(m, src)
 }
-func (m *PushRsp) XXX_Size() int {
+func (m *ListServiceVersionsRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PushRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushRsp.DiscardUnknown(m)
+func (m *ListServiceVersionsRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceVersionsRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushRsp proto.InternalMessageInfo
+var xxx_messageInfo_ListServiceVersionsRsp proto.InternalMessageInfo
 
-type ExecReq struct {
+type ListServiceExitsReq struct {
 	// +gen:required
-	In *v1.ExecIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *ExecReq) Reset()         { *m = ExecReq{} }
-func (m *ExecReq) String() string { return proto.CompactTextString(m) }
-func (*ExecReq) ProtoMessage()    {}
-func (*ExecReq) Descriptor() ([]byte, []int) {
+func (m *ListServiceExitsReq) Reset()         { *m = ListServiceExitsReq{} }
+func (m *ListServiceExitsReq) String() string { return proto.CompactTextString(m) }
+func (*ListServiceExitsReq) ProtoMessage()    {}
+func (*ListServiceExitsReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{39}
 }
-func (m *ExecReq) XXX_Unmarshal(b []byte) error {
+func (m *ListServiceExitsReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServiceExitsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServiceExitsReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1511,34 +1533,34 @@ func (m *ExecReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ExecReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecReq.Merge(m, src)
+func (m *ListServiceExitsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceExitsReq.Merge(m, src)
 }
-func (m *ExecReq) XXX_Size() int {
+func (m *ListServiceExitsReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ExecReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecReq.DiscardUnknown(m)
+func (m *ListServiceExitsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceExitsReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecReq proto.InternalMessageInfo
+var xxx_messageInfo_ListServiceExitsReq proto.InternalMessageInfo
 
-type ExecRsp struct {
-	Result *v1.ExecResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type ListServiceExitsRsp struct {
+	Exits []*v1.ServiceExit `protobuf:"bytes,1,rep,name=exits,proto3" json:"exits,omitempty"`
 }
 
-func (m *ExecRsp) Reset()         { *m = ExecRsp{} }
-func (m *ExecRsp) String() string { return proto.CompactTextString(m) }
-func (*ExecRsp) ProtoMessage()    {}
-func (*ExecRsp) Descriptor() ([]byte, []int) {
+func (m *ListServiceExitsRsp) Reset()         { *m = ListServiceExitsRsp{} }
+func (m *ListServiceExitsRsp) String() string { return proto.CompactTextString(m) }
+func (*ListServiceExitsRsp) ProtoMessage()    {}
+func (*ListServiceExitsRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{40}
 }
-func (m *ExecRsp) XXX_Unmarshal(b []byte) error {
+func (m *ListServiceExitsRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServiceExitsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServiceExitsRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1548,35 +1570,35 @@ func (m *ExecRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ExecRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecRsp.Merge(m, src)
+func (m *ListServiceExitsRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceExitsRsp.Merge(m, src)
 }
-func (m *ExecRsp) XXX_Size() int {
+func (m *ListServiceExitsRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ExecRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecRsp.DiscardUnknown(m)
+func (m *ListServiceExitsRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceExitsRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecRsp proto.InternalMessageInfo
+var xxx_messageInfo_ListServiceExitsRsp proto.InternalMessageInfo
 
-type TerminalReq struct {
+type CreateSecretReq struct {
 	// +gen:required
-	In *v1.TerminalIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	Spec *v1.SecretSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
 }
 
-func (m *TerminalReq) Reset()         { *m = TerminalReq{} }
-func (m *TerminalReq) String() string { return proto.CompactTextString(m) }
-func (*TerminalReq) ProtoMessage()    {}
-func (*TerminalReq) Descriptor() ([]byte, []int) {
+func (m *CreateSecretReq) Reset()         { *m = CreateSecretReq{} }
+func (m *CreateSecretReq) String() string { return proto.CompactTextString(m) }
+func (*CreateSecretReq) ProtoMessage()    {}
+func (*CreateSecretReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{41}
 }
-func (m *TerminalReq) XXX_Unmarshal(b []byte) error {
+func (m *CreateSecretReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminalReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateSecretReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminalReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateSecretReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1586,34 +1608,34 @@ func (m *TerminalReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TerminalReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminalReq.Merge(m, src)
+func (m *CreateSecretReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSecretReq.Merge(m, src)
 }
-func (m *TerminalReq) XXX_Size() int {
+func (m *CreateSecretReq) XXX_Size() int {
 	return m.XSize()
 }
-func (m *TerminalReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminalReq.DiscardUnknown(m)
+func (m *CreateSecretReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSecretReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminalReq proto.InternalMessageInfo
+var xxx_messageInfo_CreateSecretReq proto.InternalMessageInfo
 
-type TerminalRsp struct {
-	Result *v1.TerminalResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+type CreateSecretRsp struct {
+	Secret *v1.Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (m *TerminalRsp) Reset()         { *m = TerminalRsp{} }
-func (m *TerminalRsp) String() string { return proto.CompactTextString(m) }
-func (*TerminalRsp) ProtoMessage()    {}
-func (*TerminalRsp) Descriptor() ([]byte, []int) {
+func (m *CreateSecretRsp) Reset()         { *m = CreateSecretRsp{} }
+func (m *CreateSecretRsp) String() string { return proto.CompactTextString(m) }
+func (*CreateSecretRsp) ProtoMessage()    {}
+func (*CreateSecretRsp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a737174c368a3c5b, []int{42}
 }
-func (m *TerminalRsp) XXX_Unmarshal(b []byte) error {
+func (m *CreateSecretRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminalRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateSecretRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminalRsp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateSecretRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1623,1967 +1645,1837 @@ func (m *TerminalRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TerminalRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminalRsp.Merge(m, src)
+func (m *CreateSecretRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSecretRsp.Merge(m, src)
 }
-func (m *TerminalRsp) XXX_Size() int {
+func (m *CreateSecretRsp) XXX_Size() int {
 	return m.XSize()
 }
-func (m *TerminalRsp) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminalRsp.DiscardUnknown(m)
+func (m *CreateSecretRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSecretRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminalRsp proto.InternalMessageInfo
+var xxx_messageInfo_CreateSecretRsp proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "gpmv1.Empty")
-	proto.RegisterType((*UpdateSelfReq)(nil), "gpmv1.UpdateSelfReq")
-	proto.RegisterType((*UpdateSelfRsp)(nil), "gpmv1.UpdateSelfRsp")
-	proto.RegisterType((*InfoReq)(nil), "gpmv1.InfoReq")
-	proto.RegisterType((*InfoRsp)(nil), "gpmv1.InfoRsp")
-	proto.RegisterType((*ListServiceReq)(nil), "gpmv1.ListServiceReq")
-	proto.RegisterType((*ListServiceRsp)(nil), "gpmv1.ListServiceRsp")
-	proto.RegisterType((*GetServiceReq)(nil), "gpmv1.GetServiceReq")
-	proto.RegisterType((*GetServiceRsp)(nil), "gpmv1.GetServiceRsp")
-	proto.RegisterType((*CreateServiceReq)(nil), "gpmv1.CreateServiceReq")
-	proto.RegisterType((*CreateServiceRsp)(nil), "gpmv1.CreateServiceRsp")
-	proto.RegisterType((*EditServiceReq)(nil), "gpmv1.EditServiceReq")
-	proto.RegisterType((*EditServiceRsp)(nil), "gpmv1.EditServiceRsp")
-	proto.RegisterType((*StartServiceReq)(nil), "gpmv1.StartServiceReq")
-	proto.RegisterType((*StartServiceRsp)(nil), "gpmv1.StartServiceRsp")
-	proto.RegisterType((*StopServiceReq)(nil), "gpmv1.StopServiceReq")
-	proto.RegisterType((*StopServiceRsp)(nil), "gpmv1.StopServiceRsp")
-	proto.RegisterType((*RestartServiceReq)(nil), "gpmv1.RestartServiceReq")
-	proto.RegisterType((*RestartServiceRsp)(nil), "gpmv1.RestartServiceRsp")
-	proto.RegisterType((*DeleteServiceReq)(nil), "gpmv1.DeleteServiceReq")
-	proto.RegisterType((*DeleteServiceRsp)(nil), "gpmv1.DeleteServiceRsp")
-	proto.RegisterType((*WatchServiceLogReq)(nil), "gpmv1.WatchServiceLogReq")
-	proto.RegisterType((*WatchServiceLogRsp)(nil), "gpmv1.WatchServiceLogRsp")
-	proto.RegisterType((*InstallServiceReq)(nil), "gpmv1.InstallServiceReq")
-	proto.RegisterType((*InstallServiceRsp)(nil), "gpmv1.InstallServiceRsp")
-	proto.RegisterType((*ListServiceVersionsReq)(nil), "gpmv1.ListServiceVersionsReq")
-	proto.RegisterType((*ListServiceVersionsRsp)(nil), "gpmv1.ListServiceVersionsRsp")
-	proto.RegisterType((*UpgradeServiceReq)(nil), "gpmv1.UpgradeServiceReq")
-	proto.RegisterType((*UpgradeServiceRsp)(nil), "gpmv1.UpgradeServiceRsp")
-	proto.RegisterType((*RollbackServiceReq)(nil), "gpmv1.RollbackServiceReq")
-	proto.RegisterType((*RollbackServiceRsp)(nil), "gpmv1.RollbackServiceRsp")
-	proto.RegisterType((*ForgetServiceReq)(nil), "gpmv1.ForgetServiceReq")
-	proto.RegisterType((*ForgetServiceRsp)(nil), "gpmv1.ForgetServiceRsp")
-	proto.RegisterType((*LsReq)(nil), "gpmv1.LsReq")
-	proto.RegisterType((*LsRsp)(nil), "gpmv1.LsRsp")
-	proto.RegisterType((*PullReq)(nil), "gpmv1.PullReq")
-	proto.RegisterType((*PullRsp)(nil), "gpmv1.PullRsp")
-	proto.RegisterType((*PushReq)(nil), "gpmv1.PushReq")
-	proto.RegisterType((*PushRsp)(nil), "gpmv1.PushRsp")
-	proto.RegisterType((*ExecReq)(nil), "gpmv1.ExecReq")
-	proto.RegisterType((*ExecRsp)(nil), "gpmv1.ExecRsp")
-	proto.RegisterType((*TerminalReq)(nil), "gpmv1.TerminalReq")
-	proto.RegisterType((*TerminalRsp)(nil), "gpmv1.TerminalRsp")
+type ListSecretsReq struct {
 }
 
-func init() {
-	proto.RegisterFile("github.com/vine-io/gpm/api/service/gpm/v1/gpm.proto", fileDescriptor_a737174c368a3c5b)
+func (m *ListSecretsReq) Reset()         { *m = ListSecretsReq{} }
+func (m *ListSecretsReq) String() string { return proto.CompactTextString(m) }
+func (*ListSecretsReq) ProtoMessage()    {}
+func (*ListSecretsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{43}
 }
-
-var fileDescriptor_a737174c368a3c5b = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x5f, 0x53, 0xdb, 0x46,
-	0x10, 0xc7, 0x38, 0x80, 0x59, 0x82, 0x81, 0x0d, 0xa1, 0xf4, 0x32, 0x71, 0xa9, 0x52, 0x12, 0x4a,
-	0x1a, 0x0c, 0x21, 0xd3, 0x69, 0x80, 0x76, 0xa6, 0x14, 0x02, 0x4c, 0xe9, 0x4c, 0x46, 0x6e, 0xda,
-	0x4e, 0xdf, 0x84, 0x39, 0x6c, 0x4d, 0xf5, 0xe7, 0xd0, 0x09, 0xb7, 0xe9, 0xa7, 0xe8, 0x27, 0xe9,
-	0xe7, 0xc8, 0x63, 0x1e, 0xfb, 0xd8, 0xc2, 0x17, 0xe9, 0xdc, 0xe9, 0x74, 0xd6, 0x49, 0x32, 0x8e,
-	0x9f, 0xac, 0xbb, 0xdd, 0xdf, 0xee, 0xde, 0x9e, 0xf4, 0xfb, 0xad, 0x61, 0xbb, 0xe3, 0xc6, 0xdd,
-	0xab, 0xb3, 0x8d, 0x76, 0xe8, 0x37, 0x7b, 0x6e, 0x40, 0x9f, 0xb9, 0x61, 0xb3, 0xc3, 0xfc, 0xa6,
-	0xc3, 0xdc, 0x26, 0xa7, 0x51, 0xcf, 0x6d, 0x53, 0xb9, 0xee, 0x6d, 0x89, 0x9f, 0x0d, 0x16, 0x85,
-	0x71, 0x88, 0x13, 0x1d, 0xe6, 0xf7, 0xb6, 0xc8, 0xd6, 0x2d, 0xd8, 0xf8, 0x2d, 0xa3, 0xbc, 0x80,
-	0xb4, 0xa6, 0x60, 0xe2, 0xd0, 0x67, 0xf1, 0x5b, 0x6b, 0x13, 0x66, 0xdf, 0xb0, 0x73, 0x27, 0xa6,
-	0x2d, 0xea, 0x5d, 0xd8, 0xf4, 0x12, 0x3f, 0x81, 0x71, 0x37, 0x58, 0xae, 0xac, 0x54, 0xd6, 0x66,
-	0x9e, 0xcf, 0x6d, 0xc8, 0x04, 0x1b, 0x89, 0xc7, 0x49, 0x60, 0x8f, 0xbb, 0x81, 0xb5, 0x67, 0x20,
-	0x38, 0xc3, 0xa7, 0x30, 0x19, 0x51, 0x7e, 0xe5, 0xc5, 0xcb, 0xe3, 0x12, 0x75, 0xcf, 0x40, 0xd9,
-	0xd2, 0x64, 0x2b, 0x17, 0x6b, 0x1a, 0xa6, 0x4e, 0x82, 0x8b, 0xd0, 0xa6, 0x97, 0xd6, 0x53, 0xf5,
-	0xc8, 0x19, 0xae, 0x40, 0xb5, 0xc3, 0x7c, 0x95, 0xb5, 0xae, 0xf0, 0x47, 0xcc, 0x97, 0x76, 0x61,
-	0xb2, 0xe6, 0xa1, 0x7e, 0xea, 0xf2, 0xb8, 0x95, 0xb4, 0x42, 0xc0, 0x6d, 0x73, 0x87, 0x33, 0x5c,
-	0x87, 0x9a, 0x6a, 0x15, 0x5f, 0xae, 0xac, 0x54, 0x33, 0xa1, 0x52, 0x27, 0x6d, 0xc7, 0x45, 0x98,
-	0x88, 0xc3, 0xd8, 0xf1, 0x64, 0xcd, 0x55, 0x3b, 0x59, 0x58, 0x8f, 0x60, 0xf6, 0x88, 0x66, 0x92,
-	0x20, 0xc2, 0x9d, 0xc0, 0xf1, 0xa9, 0xac, 0x6c, 0xda, 0x96, 0xcf, 0xd6, 0x4b, 0xc3, 0x89, 0x33,
-	0x5c, 0x83, 0x29, 0x15, 0x37, 0x77, 0x82, 0xd4, 0x27, 0x35, 0x5b, 0x3b, 0x30, 0xff, 0x5d, 0x44,
-	0x65, 0xef, 0x74, 0x8a, 0xc7, 0x70, 0x87, 0x33, 0xda, 0x56, 0x50, 0x34, 0xa1, 0x2d, 0x46, 0xdb,
-	0xb6, 0xb4, 0x5b, 0x7b, 0x79, 0xec, 0x48, 0x99, 0x5f, 0x43, 0xfd, 0xf0, 0xdc, 0x1d, 0x72, 0x34,
-	0x5c, 0x57, 0xb5, 0x24, 0x17, 0xb9, 0xa4, 0x82, 0x65, 0x80, 0x99, 0x7a, 0x76, 0xcc, 0x88, 0x23,
-	0x55, 0xb3, 0x0a, 0x73, 0xad, 0xd8, 0x89, 0x86, 0x75, 0x7a, 0x37, 0xe7, 0x36, 0x52, 0x8e, 0xcf,
-	0xa0, 0xde, 0x8a, 0x43, 0x36, 0x24, 0xc5, 0x8e, 0xe9, 0x35, 0x52, 0x86, 0x27, 0xb0, 0x60, 0x53,
-	0xfe, 0x01, 0xe7, 0xf8, 0xba, 0xe0, 0x38, 0x52, 0x9e, 0xc7, 0x30, 0x7f, 0x40, 0x3d, 0x6a, 0xbc,
-	0x35, 0x65, 0x69, 0xf6, 0xf2, 0x7e, 0x23, 0x65, 0xf9, 0x05, 0xf0, 0x67, 0x27, 0x6e, 0x77, 0x95,
-	0xe1, 0x34, 0xec, 0x0c, 0x7a, 0x4b, 0x96, 0x60, 0x32, 0xb8, 0xf2, 0xcf, 0x68, 0xa4, 0x3e, 0x1e,
-	0xb5, 0x12, 0xfb, 0x17, 0xa1, 0xe7, 0x85, 0xbf, 0x2f, 0x57, 0x57, 0x2a, 0x6b, 0x35, 0x5b, 0xad,
-	0xac, 0x97, 0xc5, 0xc8, 0x9c, 0xe1, 0x23, 0xa8, 0x7a, 0x61, 0x47, 0x55, 0xb5, 0x60, 0x56, 0x25,
-	0x5c, 0x84, 0xd5, 0xda, 0x83, 0x85, 0x93, 0x80, 0xc7, 0x8e, 0xe7, 0x65, 0xce, 0xfe, 0x24, 0x43,
-	0x51, 0x1f, 0x29, 0xa0, 0xe9, 0xa5, 0xa8, 0xea, 0xb8, 0x80, 0xe6, 0x0c, 0xb7, 0x35, 0x5d, 0x25,
-	0x11, 0x1e, 0x94, 0x46, 0xc8, 0xd1, 0xd6, 0x17, 0xb0, 0x94, 0x21, 0x9b, 0x9f, 0x68, 0xc4, 0xdd,
-	0x30, 0xe0, 0x83, 0x2e, 0xe2, 0xfb, 0x72, 0x6f, 0xce, 0x70, 0x0b, 0x6a, 0x3d, 0xb5, 0x54, 0x14,
-	0x75, 0xdf, 0x3c, 0xb9, 0x72, 0xb6, 0xb5, 0x9b, 0x68, 0xc1, 0x1b, 0xd6, 0x89, 0x9c, 0x73, 0x3a,
-	0xa4, 0x05, 0xa6, 0x57, 0xbf, 0x05, 0x39, 0xf4, 0x2d, 0x2d, 0xc8, 0xe7, 0x31, 0x5a, 0x70, 0x00,
-	0x68, 0x87, 0x9e, 0x77, 0xe6, 0xb4, 0x7f, 0x1b, 0xc2, 0x22, 0x04, 0x6a, 0x11, 0xed, 0xb9, 0xa2,
-	0x7c, 0xf9, 0x86, 0x4c, 0xdb, 0x7a, 0x6d, 0x2d, 0x16, 0xa3, 0x70, 0x66, 0xed, 0xc3, 0xfc, 0xab,
-	0x30, 0xea, 0x0c, 0xa3, 0xde, 0x5b, 0x23, 0x63, 0x3e, 0x06, 0x67, 0xd6, 0x03, 0x98, 0x38, 0x4d,
-	0x6f, 0x89, 0x39, 0x71, 0x37, 0x0d, 0x26, 0x9e, 0xad, 0x0d, 0x69, 0xe4, 0x0c, 0x57, 0x61, 0xe2,
-	0xc2, 0xf5, 0xb4, 0x68, 0xa4, 0xaa, 0xf7, 0xca, 0xf5, 0xa8, 0x14, 0xa0, 0xc4, 0x6a, 0x35, 0x61,
-	0xea, 0xf5, 0x95, 0xe7, 0x0d, 0xaa, 0x6d, 0x1e, 0xaa, 0xe7, 0x6e, 0xf2, 0x49, 0xd4, 0x6c, 0xf1,
-	0x68, 0xbd, 0x50, 0x00, 0xce, 0xf0, 0xf3, 0x5c, 0xc7, 0xd3, 0xf7, 0x3d, 0x09, 0x68, 0xf4, 0x79,
-	0x4d, 0xa0, 0x78, 0x57, 0xa4, 0x79, 0x98, 0xb9, 0xe5, 0x59, 0x8d, 0xe0, 0x5d, 0x75, 0xb7, 0xd3,
-	0xca, 0x93, 0x33, 0x01, 0x3a, 0xfc, 0x83, 0xb6, 0x07, 0x81, 0x84, 0x4d, 0x81, 0x5e, 0x28, 0xcf,
-	0x5b, 0x8a, 0x4a, 0x22, 0x19, 0x45, 0x6d, 0xc2, 0xcc, 0x8f, 0x34, 0xf2, 0xdd, 0xc0, 0x91, 0xe7,
-	0xff, 0x34, 0x93, 0x23, 0x45, 0xa5, 0x76, 0x3d, 0x26, 0xf4, 0x11, 0x9c, 0xe1, 0xb3, 0xdc, 0x90,
-	0x70, 0x3f, 0x87, 0x32, 0xf3, 0x3d, 0xff, 0x1b, 0x00, 0x8e, 0x98, 0xaf, 0xae, 0x12, 0x57, 0x61,
-	0xea, 0x98, 0x3a, 0x5e, 0xdc, 0xfd, 0x13, 0xef, 0xa6, 0x45, 0x8a, 0xf1, 0x85, 0x18, 0x2b, 0xdc,
-	0x03, 0xe8, 0x8f, 0x26, 0xb8, 0x68, 0xcc, 0x21, 0x6a, 0xbe, 0x21, 0x25, 0xbb, 0x9c, 0xad, 0x55,
-	0x36, 0x2b, 0x42, 0x88, 0xc5, 0x75, 0x63, 0x5d, 0x13, 0x82, 0x9c, 0x53, 0x88, 0xb1, 0xe6, 0x0c,
-	0x77, 0x61, 0x26, 0xf3, 0x75, 0x63, 0x7a, 0x12, 0x73, 0x3c, 0x21, 0x65, 0xdb, 0x9c, 0xe1, 0x57,
-	0x00, 0xfd, 0xe1, 0x41, 0x97, 0x68, 0x0c, 0x1d, 0xa4, 0x64, 0x97, 0x33, 0xfc, 0x16, 0x66, 0x0d,
-	0xfd, 0xc7, 0xf4, 0xbb, 0xcf, 0x4f, 0x14, 0xa4, 0xdc, 0x90, 0x54, 0x9e, 0x91, 0x6c, 0x5d, 0xb9,
-	0x39, 0x18, 0x90, 0xb2, 0x6d, 0xce, 0xf0, 0x1b, 0xb8, 0x9b, 0x15, 0x63, 0x4c, 0xa7, 0x83, 0x9c,
-	0x90, 0x93, 0xd2, 0xfd, 0x24, 0x79, 0x46, 0x69, 0x75, 0x72, 0x53, 0xa3, 0x49, 0xd9, 0x36, 0x67,
-	0x78, 0x00, 0x75, 0x53, 0x41, 0x71, 0x59, 0x39, 0x16, 0x14, 0x98, 0x0c, 0xb0, 0x24, 0x2d, 0x34,
-	0x04, 0x52, 0xb7, 0x30, 0x2f, 0xaf, 0xa4, 0xdc, 0xc0, 0x19, 0x9e, 0xc0, 0x5c, 0x4e, 0xcb, 0xf0,
-	0x63, 0xe5, 0x5b, 0x54, 0x4f, 0x32, 0xc8, 0xc4, 0xd9, 0x66, 0x05, 0x8f, 0xa1, 0x6e, 0x6a, 0x8e,
-	0x3e, 0x53, 0x41, 0xf2, 0xc8, 0x00, 0x8b, 0x7a, 0x73, 0x5b, 0x70, 0xaf, 0x44, 0x6f, 0xf0, 0x61,
-	0xf1, 0x15, 0xcc, 0x28, 0x17, 0xb9, 0xcd, 0xcc, 0x99, 0x28, 0xcf, 0xd4, 0x03, 0x5d, 0x5e, 0x41,
-	0x8e, 0xc8, 0x00, 0x8b, 0x2a, 0xef, 0x08, 0xe6, 0x04, 0xe7, 0xef, 0xf7, 0x39, 0x5f, 0xf7, 0xac,
-	0xa8, 0x28, 0x64, 0x90, 0x29, 0xb9, 0x3f, 0x83, 0xe2, 0xf5, 0xfd, 0xe5, 0xc5, 0x83, 0x94, 0x1b,
-	0xe4, 0x3f, 0x8d, 0xf1, 0x53, 0xae, 0x49, 0x44, 0x8a, 0x03, 0xc9, 0xac, 0xe4, 0xc4, 0x74, 0x47,
-	0xb0, 0xb2, 0xa6, 0x01, 0xc5, 0xf9, 0xc4, 0x58, 0xcb, 0x0b, 0x5c, 0x17, 0x9e, 0xbc, 0x9b, 0xf1,
-	0x94, 0xb4, 0x4d, 0x8c, 0x75, 0x9f, 0x5c, 0x04, 0xad, 0x6a, 0x5f, 0xc5, 0xd6, 0xc4, 0x58, 0x73,
-	0x86, 0x5f, 0x42, 0x2d, 0xa5, 0x44, 0xc4, 0x02, 0x47, 0x5e, 0x92, 0xc2, 0x5e, 0x12, 0x7f, 0xff,
-	0x87, 0x77, 0xff, 0x35, 0xc6, 0xde, 0x5d, 0x37, 0x2a, 0xef, 0xaf, 0x1b, 0x95, 0x7f, 0xaf, 0x1b,
-	0x95, 0xbf, 0x6e, 0x1a, 0x63, 0xef, 0x6f, 0x1a, 0x63, 0xff, 0xdc, 0x34, 0xc6, 0x7e, 0x6d, 0x7e,
-	0xf0, 0xbf, 0xcb, 0x5d, 0x19, 0xfe, 0x6c, 0x52, 0xfe, 0x4d, 0xdc, 0xfe, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0xb5, 0x9c, 0xba, 0x6f, 0x97, 0x0e, 0x00, 0x00,
+func (m *ListSecretsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Empty) XSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListSecretsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSecretsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
-
-func (m *UpdateSelfReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ListSecretsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecretsReq.Merge(m, src)
 }
-
-func (m *UpdateSelfRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ListSecretsReq) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *InfoReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *ListSecretsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecretsReq.DiscardUnknown(m)
 }
 
-func (m *InfoRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gpm != nil {
-		l = m.Gpm.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
-}
+var xxx_messageInfo_ListSecretsReq proto.InternalMessageInfo
 
-func (m *ListServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type ListSecretsRsp struct {
+	Secrets []*v1.Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
 }
 
-func (m *ListServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.XSize()
-			n += 1 + l + sovGpm(uint64(l))
+func (m *ListSecretsRsp) Reset()         { *m = ListSecretsRsp{} }
+func (m *ListSecretsRsp) String() string { return proto.CompactTextString(m) }
+func (*ListSecretsRsp) ProtoMessage()    {}
+func (*ListSecretsRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{44}
+}
+func (m *ListSecretsRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSecretsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSecretsRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Total != 0 {
-		n += 1 + sovGpm(uint64(m.Total))
-	}
-	return n
 }
-
-func (m *GetServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ListSecretsRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecretsRsp.Merge(m, src)
 }
-
-func (m *GetServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ListSecretsRsp) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *CreateServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Spec != nil {
-		l = m.Spec.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ListSecretsRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecretsRsp.DiscardUnknown(m)
 }
 
-func (m *CreateServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_ListSecretsRsp proto.InternalMessageInfo
+
+type DeleteSecretReq struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EditServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Spec != nil {
-		l = m.Spec.XSize()
-		n += 1 + l + sovGpm(uint64(l))
+func (m *DeleteSecretReq) Reset()         { *m = DeleteSecretReq{} }
+func (m *DeleteSecretReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteSecretReq) ProtoMessage()    {}
+func (*DeleteSecretReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{45}
+}
+func (m *DeleteSecretReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSecretReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSecretReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DeleteSecretReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSecretReq.Merge(m, src)
+}
+func (m *DeleteSecretReq) XXX_Size() int {
+	return m.XSize()
+}
+func (m *DeleteSecretReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSecretReq.DiscardUnknown(m)
 }
 
-func (m *EditServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_DeleteSecretReq proto.InternalMessageInfo
+
+type DeleteSecretRsp struct {
+	Secret *v1.Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (m *StartServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
+func (m *DeleteSecretRsp) Reset()         { *m = DeleteSecretRsp{} }
+func (m *DeleteSecretRsp) String() string { return proto.CompactTextString(m) }
+func (*DeleteSecretRsp) ProtoMessage()    {}
+func (*DeleteSecretRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{46}
+}
+func (m *DeleteSecretRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSecretRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSecretRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DeleteSecretRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSecretRsp.Merge(m, src)
+}
+func (m *DeleteSecretRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *DeleteSecretRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSecretRsp.DiscardUnknown(m)
 }
 
-func (m *StartServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_DeleteSecretRsp proto.InternalMessageInfo
+
+type UpgradeServiceReq struct {
+	In *v1.UpgradeServiceIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
 }
 
-func (m *StopServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceReq) Reset()         { *m = UpgradeServiceReq{} }
+func (m *UpgradeServiceReq) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceReq) ProtoMessage()    {}
+func (*UpgradeServiceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{47}
 }
-
-func (m *StopServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *RestartServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
+func (m *UpgradeServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *RestartServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceReq.Merge(m, src)
 }
-
-func (m *DeleteServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceReq) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *DeleteServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceReq.DiscardUnknown(m)
 }
 
-func (m *WatchServiceLogReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Number != 0 {
-		n += 1 + sovGpm(uint64(m.Number))
-	}
-	if m.Follow {
-		n += 2
-	}
-	return n
-}
+var xxx_messageInfo_UpgradeServiceReq proto.InternalMessageInfo
 
-func (m *WatchServiceLogRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Log != nil {
-		l = m.Log.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+type UpgradeServiceRsp struct {
+	Result *v1.UpgradeServiceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *InstallServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *UpgradeServiceRsp) Reset()         { *m = UpgradeServiceRsp{} }
+func (m *UpgradeServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceRsp) ProtoMessage()    {}
+func (*UpgradeServiceRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{48}
 }
-
-func (m *InstallServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
+func (m *UpgradeServiceRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UpgradeServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceRsp.Merge(m, src)
+}
+func (m *UpgradeServiceRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *UpgradeServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceRsp.DiscardUnknown(m)
 }
 
-func (m *ListServiceVersionsReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_UpgradeServiceRsp proto.InternalMessageInfo
+
+type RollbackServiceReq struct {
+	// +gen:required
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *ListServiceVersionsRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Versions) > 0 {
-		for _, e := range m.Versions {
-			l = e.XSize()
-			n += 1 + l + sovGpm(uint64(l))
+func (m *RollbackServiceReq) Reset()         { *m = RollbackServiceReq{} }
+func (m *RollbackServiceReq) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceReq) ProtoMessage()    {}
+func (*RollbackServiceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{49}
+}
+func (m *RollbackServiceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *UpgradeServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *RollbackServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceReq.Merge(m, src)
+}
+func (m *RollbackServiceReq) XXX_Size() int {
+	return m.XSize()
+}
+func (m *RollbackServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceReq.DiscardUnknown(m)
 }
 
-func (m *UpgradeServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_RollbackServiceReq proto.InternalMessageInfo
+
+type RollbackServiceRsp struct {
 }
 
-func (m *RollbackServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *RollbackServiceRsp) Reset()         { *m = RollbackServiceRsp{} }
+func (m *RollbackServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceRsp) ProtoMessage()    {}
+func (*RollbackServiceRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{50}
 }
-
-func (m *RollbackServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RollbackServiceRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ForgetServiceReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
+func (m *RollbackServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *ForgetServiceRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RollbackServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceRsp.Merge(m, src)
+}
+func (m *RollbackServiceRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *RollbackServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceRsp.DiscardUnknown(m)
 }
 
-func (m *LsReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_RollbackServiceRsp proto.InternalMessageInfo
+
+type ForgetServiceReq struct {
+	// +gen:required
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *LsRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Files) > 0 {
-		for _, e := range m.Files {
-			l = e.XSize()
-			n += 1 + l + sovGpm(uint64(l))
+func (m *ForgetServiceReq) Reset()         { *m = ForgetServiceReq{} }
+func (m *ForgetServiceReq) String() string { return proto.CompactTextString(m) }
+func (*ForgetServiceReq) ProtoMessage()    {}
+func (*ForgetServiceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{51}
+}
+func (m *ForgetServiceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForgetServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForgetServiceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *PullReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Dir {
-		n += 2
-	}
-	return n
+func (m *ForgetServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetServiceReq.Merge(m, src)
 }
-
-func (m *PullRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ForgetServiceReq) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *PushReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ForgetServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetServiceReq.DiscardUnknown(m)
 }
 
-func (m *PushRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+var xxx_messageInfo_ForgetServiceReq proto.InternalMessageInfo
 
-func (m *ExecReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+type ForgetServiceRsp struct {
 }
 
-func (m *ExecRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ForgetServiceRsp) Reset()         { *m = ForgetServiceRsp{} }
+func (m *ForgetServiceRsp) String() string { return proto.CompactTextString(m) }
+func (*ForgetServiceRsp) ProtoMessage()    {}
+func (*ForgetServiceRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{52}
 }
-
-func (m *TerminalReq) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.In != nil {
-		l = m.In.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ForgetServiceRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *TerminalRsp) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.XSize()
-		n += 1 + l + sovGpm(uint64(l))
+func (m *ForgetServiceRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForgetServiceRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func sovGpm(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
+func (m *ForgetServiceRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetServiceRsp.Merge(m, src)
 }
-func sozGpm(x uint64) (n int) {
-	return sovGpm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ForgetServiceRsp) XXX_Size() int {
+	return m.XSize()
 }
-func (m *Empty) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ForgetServiceRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetServiceRsp.DiscardUnknown(m)
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ForgetServiceRsp proto.InternalMessageInfo
+
+type LsReq struct {
+	// +gen:required
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *LsReq) Reset()         { *m = LsReq{} }
+func (m *LsReq) String() string { return proto.CompactTextString(m) }
+func (*LsReq) ProtoMessage()    {}
+func (*LsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{53}
 }
-
-func (m *UpdateSelfReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *LsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LsReq.Merge(m, src)
+}
+func (m *LsReq) XXX_Size() int {
+	return m.XSize()
+}
+func (m *LsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LsReq.DiscardUnknown(m)
 }
 
-func (m *UpdateSelfReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LsReq proto.InternalMessageInfo
+
+type LsRsp struct {
+	Files []*v1.FileInfo `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (m *UpdateSelfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.In != nil {
-		{
-			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *LsRsp) Reset()         { *m = LsRsp{} }
+func (m *LsRsp) String() string { return proto.CompactTextString(m) }
+func (*LsRsp) ProtoMessage()    {}
+func (*LsRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{54}
+}
+func (m *LsRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LsRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UpdateSelfRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *LsRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LsRsp.Merge(m, src)
+}
+func (m *LsRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *LsRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LsRsp.DiscardUnknown(m)
 }
 
-func (m *UpdateSelfRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LsRsp proto.InternalMessageInfo
+
+type PullReq struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dir  bool   `protobuf:"varint,2,opt,name=dir,proto3" json:"dir,omitempty"`
 }
 
-func (m *UpdateSelfRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *PullReq) Reset()         { *m = PullReq{} }
+func (m *PullReq) String() string { return proto.CompactTextString(m) }
+func (*PullReq) ProtoMessage()    {}
+func (*PullReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{55}
+}
+func (m *PullReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *InfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PullReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullReq.Merge(m, src)
 }
-
-func (m *InfoReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullReq) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *InfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PullReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullReq.DiscardUnknown(m)
 }
 
-func (m *InfoRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_PullReq proto.InternalMessageInfo
 
-func (m *InfoRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PullRsp struct {
+	Result *v1.PullResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *InfoRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gpm != nil {
-		{
-			size, err := m.Gpm.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *PullRsp) Reset()         { *m = PullRsp{} }
+func (m *PullRsp) String() string { return proto.CompactTextString(m) }
+func (*PullRsp) ProtoMessage()    {}
+func (*PullRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{56}
+}
+func (m *PullRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ListServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PullRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRsp.Merge(m, src)
 }
-
-func (m *ListServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullRsp) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *ListServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PullRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRsp.DiscardUnknown(m)
 }
 
-func (m *ListServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_PullRsp proto.InternalMessageInfo
 
-func (m *ListServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PushReq struct {
+	In *v1.PushIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
 }
 
-func (m *ListServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Total != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGpm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PushReq) Reset()         { *m = PushReq{} }
+func (m *PushReq) String() string { return proto.CompactTextString(m) }
+func (*PushReq) ProtoMessage()    {}
+func (*PushReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{57}
+}
+func (m *PushReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PushReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushReq.Merge(m, src)
 }
-
-func (m *GetServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PushReq) XXX_Size() int {
+	return m.XSize()
 }
-
-func (m *GetServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PushReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushReq.DiscardUnknown(m)
 }
 
-func (m *GetServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_PushReq proto.InternalMessageInfo
 
-func (m *GetServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PushRsp struct {
 }
 
-func (m *GetServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *PushRsp) Reset()         { *m = PushRsp{} }
+func (m *PushRsp) String() string { return proto.CompactTextString(m) }
+func (*PushRsp) ProtoMessage()    {}
+func (*PushRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{58}
+}
+func (m *PushRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CreateServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PushRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushRsp.Merge(m, src)
+}
+func (m *PushRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *PushRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushRsp.DiscardUnknown(m)
 }
 
-func (m *CreateServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PushRsp proto.InternalMessageInfo
+
+type ExecReq struct {
+	// +gen:required
+	In *v1.ExecIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
 }
 
-func (m *CreateServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *ExecReq) Reset()         { *m = ExecReq{} }
+func (m *ExecReq) String() string { return proto.CompactTextString(m) }
+func (*ExecReq) ProtoMessage()    {}
+func (*ExecReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{59}
+}
+func (m *ExecReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CreateServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecReq.Merge(m, src)
+}
+func (m *ExecReq) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ExecReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecReq.DiscardUnknown(m)
 }
 
-func (m *CreateServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ExecReq proto.InternalMessageInfo
+
+type ExecRsp struct {
+	Result *v1.ExecResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *CreateServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *ExecRsp) Reset()         { *m = ExecRsp{} }
+func (m *ExecRsp) String() string { return proto.CompactTextString(m) }
+func (*ExecRsp) ProtoMessage()    {}
+func (*ExecRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{60}
+}
+func (m *ExecRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EditServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecRsp.Merge(m, src)
+}
+func (m *ExecRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ExecRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecRsp.DiscardUnknown(m)
 }
 
-func (m *EditServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ExecRsp proto.InternalMessageInfo
+
+type TerminalReq struct {
+	// +gen:required
+	In *v1.TerminalIn `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
 }
 
-func (m *EditServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *TerminalReq) Reset()         { *m = TerminalReq{} }
+func (m *TerminalReq) String() string { return proto.CompactTextString(m) }
+func (*TerminalReq) ProtoMessage()    {}
+func (*TerminalReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{61}
+}
+func (m *TerminalReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminalReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EditServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TerminalReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalReq.Merge(m, src)
+}
+func (m *TerminalReq) XXX_Size() int {
+	return m.XSize()
+}
+func (m *TerminalReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalReq.DiscardUnknown(m)
 }
 
-func (m *EditServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TerminalReq proto.InternalMessageInfo
+
+type TerminalRsp struct {
+	Result *v1.TerminalResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *EditServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+func (m *TerminalRsp) Reset()         { *m = TerminalRsp{} }
+func (m *TerminalRsp) String() string { return proto.CompactTextString(m) }
+func (*TerminalRsp) ProtoMessage()    {}
+func (*TerminalRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a737174c368a3c5b, []int{62}
+}
+func (m *TerminalRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminalRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TerminalRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalRsp.Merge(m, src)
+}
+func (m *TerminalRsp) XXX_Size() int {
+	return m.XSize()
+}
+func (m *TerminalRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalRsp.DiscardUnknown(m)
 }
 
-func (m *StartServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_TerminalRsp proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "gpmv1.Empty")
+	proto.RegisterType((*UpdateSelfReq)(nil), "gpmv1.UpdateSelfReq")
+	proto.RegisterType((*UpdateSelfRsp)(nil), "gpmv1.UpdateSelfRsp")
+	proto.RegisterType((*InfoReq)(nil), "gpmv1.InfoReq")
+	proto.RegisterType((*InfoRsp)(nil), "gpmv1.InfoRsp")
+	proto.RegisterType((*ListServiceReq)(nil), "gpmv1.ListServiceReq")
+	proto.RegisterType((*ListServiceRsp)(nil), "gpmv1.ListServiceRsp")
+	proto.RegisterType((*GetServiceReq)(nil), "gpmv1.GetServiceReq")
+	proto.RegisterType((*GetServiceRsp)(nil), "gpmv1.GetServiceRsp")
+	proto.RegisterType((*CreateServiceReq)(nil), "gpmv1.CreateServiceReq")
+	proto.RegisterType((*CreateServiceRsp)(nil), "gpmv1.CreateServiceRsp")
+	proto.RegisterType((*EditServiceReq)(nil), "gpmv1.EditServiceReq")
+	proto.RegisterType((*EditServiceRsp)(nil), "gpmv1.EditServiceRsp")
+	proto.RegisterType((*StartServiceReq)(nil), "gpmv1.StartServiceReq")
+	proto.RegisterType((*StartServiceRsp)(nil), "gpmv1.StartServiceRsp")
+	proto.RegisterType((*StopServiceReq)(nil), "gpmv1.StopServiceReq")
+	proto.RegisterType((*StopServiceRsp)(nil), "gpmv1.StopServiceRsp")
+	proto.RegisterType((*RestartServiceReq)(nil), "gpmv1.RestartServiceReq")
+	proto.RegisterType((*RestartServiceRsp)(nil), "gpmv1.RestartServiceRsp")
+	proto.RegisterType((*RunServiceReq)(nil), "gpmv1.RunServiceReq")
+	proto.RegisterType((*RunServiceRsp)(nil), "gpmv1.RunServiceRsp")
+	proto.RegisterType((*SignalServiceReq)(nil), "gpmv1.SignalServiceReq")
+	proto.RegisterType((*SignalServiceRsp)(nil), "gpmv1.SignalServiceRsp")
+	proto.RegisterType((*ReloadServiceReq)(nil), "gpmv1.ReloadServiceReq")
+	proto.RegisterType((*ReloadServiceRsp)(nil), "gpmv1.ReloadServiceRsp")
+	proto.RegisterType((*PauseServiceReq)(nil), "gpmv1.PauseServiceReq")
+	proto.RegisterType((*PauseServiceRsp)(nil), "gpmv1.PauseServiceRsp")
+	proto.RegisterType((*ResumeServiceReq)(nil), "gpmv1.ResumeServiceReq")
+	proto.RegisterType((*ResumeServiceRsp)(nil), "gpmv1.ResumeServiceRsp")
+	proto.RegisterType((*DeleteServiceReq)(nil), "gpmv1.DeleteServiceReq")
+	proto.RegisterType((*DeleteServiceRsp)(nil), "gpmv1.DeleteServiceRsp")
+	proto.RegisterType((*WatchServiceLogReq)(nil), "gpmv1.WatchServiceLogReq")
+	proto.RegisterType((*WatchServiceLogRsp)(nil), "gpmv1.WatchServiceLogRsp")
+	proto.RegisterType((*WatchServicesLogReq)(nil), "gpmv1.WatchServicesLogReq")
+	proto.RegisterType((*WatchServicesLogRsp)(nil), "gpmv1.WatchServicesLogRsp")
+	proto.RegisterType((*InstallServiceReq)(nil), "gpmv1.InstallServiceReq")
+	proto.RegisterType((*InstallServiceRsp)(nil), "gpmv1.InstallServiceRsp")
+	proto.RegisterType((*ListServiceVersionsReq)(nil), "gpmv1.ListServiceVersionsReq")
+	proto.RegisterType((*ListServiceVersionsRsp)(nil), "gpmv1.ListServiceVersionsRsp")
+	proto.RegisterType((*ListServiceExitsReq)(nil), "gpmv1.ListServiceExitsReq")
+	proto.RegisterType((*ListServiceExitsRsp)(nil), "gpmv1.ListServiceExitsRsp")
+	proto.RegisterType((*CreateSecretReq)(nil), "gpmv1.CreateSecretReq")
+	proto.RegisterType((*CreateSecretRsp)(nil), "gpmv1.CreateSecretRsp")
+	proto.RegisterType((*ListSecretsReq)(nil), "gpmv1.ListSecretsReq")
+	proto.RegisterType((*ListSecretsRsp)(nil), "gpmv1.ListSecretsRsp")
+	proto.RegisterType((*DeleteSecretReq)(nil), "gpmv1.DeleteSecretReq")
+	proto.RegisterType((*DeleteSecretRsp)(nil), "gpmv1.DeleteSecretRsp")
+	proto.RegisterType((*UpgradeServiceReq)(nil), "gpmv1.UpgradeServiceReq")
+	proto.RegisterType((*UpgradeServiceRsp)(nil), "gpmv1.UpgradeServiceRsp")
+	proto.RegisterType((*RollbackServiceReq)(nil), "gpmv1.RollbackServiceReq")
+	proto.RegisterType((*RollbackServiceRsp)(nil), "gpmv1.RollbackServiceRsp")
+	proto.RegisterType((*ForgetServiceReq)(nil), "gpmv1.ForgetServiceReq")
+	proto.RegisterType((*ForgetServiceRsp)(nil), "gpmv1.ForgetServiceRsp")
+	proto.RegisterType((*LsReq)(nil), "gpmv1.LsReq")
+	proto.RegisterType((*LsRsp)(nil), "gpmv1.LsRsp")
+	proto.RegisterType((*PullReq)(nil), "gpmv1.PullReq")
+	proto.RegisterType((*PullRsp)(nil), "gpmv1.PullRsp")
+	proto.RegisterType((*PushReq)(nil), "gpmv1.PushReq")
+	proto.RegisterType((*PushRsp)(nil), "gpmv1.PushRsp")
+	proto.RegisterType((*ExecReq)(nil), "gpmv1.ExecReq")
+	proto.RegisterType((*ExecRsp)(nil), "gpmv1.ExecRsp")
+	proto.RegisterType((*TerminalReq)(nil), "gpmv1.TerminalReq")
+	proto.RegisterType((*TerminalRsp)(nil), "gpmv1.TerminalRsp")
 }
 
-func (m *StartServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("github.com/vine-io/gpm/api/service/gpm/v1/gpm.proto", fileDescriptor_a737174c368a3c5b)
 }
 
-func (m *StartServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+var fileDescriptor_a737174c368a3c5b = []byte{
+	// 1548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x72, 0xd3, 0x46,
+	0x14, 0x8e, 0xe3, 0x38, 0x4e, 0x0e, 0xc4, 0x71, 0x96, 0x40, 0xdd, 0x65, 0x70, 0xa9, 0x98, 0x40,
+	0x80, 0x92, 0x1f, 0x60, 0x3a, 0x10, 0x5c, 0x3a, 0xa5, 0x09, 0x21, 0x6d, 0x3a, 0x30, 0x72, 0x69,
+	0x67, 0x7a, 0xa7, 0xd8, 0x1b, 0x5b, 0x53, 0x59, 0x5a, 0xb4, 0x72, 0x0a, 0x7d, 0x80, 0x5e, 0xf7,
+	0x71, 0xfa, 0x08, 0x5c, 0x72, 0xd9, 0xcb, 0x96, 0xbc, 0x48, 0x67, 0x57, 0xab, 0xf5, 0xee, 0x4a,
+	0x8e, 0xe3, 0xe9, 0x95, 0x75, 0xfe, 0xcf, 0xd9, 0xbf, 0xf3, 0x1d, 0xc3, 0x83, 0x9e, 0x9f, 0xf4,
+	0x87, 0x47, 0x1b, 0x9d, 0x68, 0xb0, 0x79, 0xe2, 0x87, 0xe4, 0x9e, 0x1f, 0x6d, 0xf6, 0xe8, 0x60,
+	0xd3, 0xa3, 0xfe, 0x26, 0x23, 0xf1, 0x89, 0xdf, 0x21, 0x82, 0x3e, 0xd9, 0xe6, 0x3f, 0x1b, 0x34,
+	0x8e, 0x92, 0x08, 0x55, 0x7a, 0x74, 0x70, 0xb2, 0x8d, 0xb7, 0xcf, 0xb0, 0x4d, 0xde, 0x51, 0xc2,
+	0x72, 0x96, 0x4e, 0x15, 0x2a, 0x7b, 0x03, 0x9a, 0xbc, 0x73, 0xb6, 0x60, 0xe9, 0x35, 0xed, 0x7a,
+	0x09, 0x69, 0x93, 0xe0, 0xd8, 0x25, 0x6f, 0xd0, 0x67, 0x30, 0xeb, 0x87, 0x8d, 0xd2, 0xf5, 0xd2,
+	0xfa, 0x85, 0xfb, 0xcb, 0x1b, 0x22, 0xc0, 0x46, 0xaa, 0x71, 0x10, 0xba, 0xb3, 0x7e, 0xe8, 0xb4,
+	0x0c, 0x0b, 0x46, 0xd1, 0x5d, 0x98, 0x8f, 0x09, 0x1b, 0x06, 0x49, 0x63, 0x56, 0x58, 0x5d, 0x32,
+	0xac, 0x5c, 0x21, 0x72, 0xa5, 0x8a, 0xb3, 0x08, 0xd5, 0x83, 0xf0, 0x38, 0x72, 0xc9, 0x1b, 0xe7,
+	0xae, 0xfc, 0x64, 0x14, 0x5d, 0x87, 0x72, 0x8f, 0x0e, 0x64, 0xd4, 0x9a, 0xb4, 0xdf, 0xa7, 0x03,
+	0x21, 0xe7, 0x22, 0xa7, 0x0e, 0xb5, 0x43, 0x9f, 0x25, 0xed, 0x74, 0x29, 0xb8, 0xb9, 0x6b, 0x72,
+	0x18, 0x45, 0x77, 0x60, 0x41, 0x2e, 0x15, 0x6b, 0x94, 0xae, 0x97, 0x35, 0x57, 0x99, 0x92, 0x92,
+	0xa3, 0x55, 0xa8, 0x24, 0x51, 0xe2, 0x05, 0x22, 0xe7, 0xb2, 0x9b, 0x12, 0xce, 0x0d, 0x58, 0xda,
+	0x27, 0x5a, 0x10, 0x84, 0x60, 0x2e, 0xf4, 0x06, 0x44, 0x64, 0xb6, 0xe8, 0x8a, 0x6f, 0xe7, 0xb1,
+	0xa1, 0xc4, 0x28, 0x5a, 0x87, 0xaa, 0xf4, 0x6b, 0x55, 0x90, 0xe9, 0x64, 0x62, 0x67, 0x07, 0xea,
+	0xdf, 0xc6, 0x44, 0xac, 0x9d, 0x0a, 0x71, 0x13, 0xe6, 0x18, 0x25, 0x1d, 0x69, 0x8a, 0x4c, 0xd3,
+	0x36, 0x25, 0x1d, 0x57, 0xc8, 0x9d, 0x96, 0x6d, 0x3b, 0x55, 0xe4, 0x57, 0x50, 0xdb, 0xeb, 0xfa,
+	0x13, 0x4a, 0x43, 0x77, 0x64, 0x2e, 0xe9, 0x46, 0x5e, 0x91, 0xce, 0x34, 0x43, 0x2d, 0x9f, 0x1d,
+	0xd3, 0xe3, 0x54, 0xd9, 0xac, 0xc1, 0x72, 0x3b, 0xf1, 0xe2, 0x49, 0x2b, 0xfd, 0xc4, 0x52, 0x9b,
+	0x2a, 0xc6, 0x2e, 0xd4, 0xda, 0x49, 0x44, 0x27, 0x54, 0xdc, 0x04, 0xe8, 0x12, 0x4a, 0xc2, 0x2e,
+	0x09, 0x13, 0x26, 0xea, 0x5e, 0x70, 0x35, 0x8e, 0xb3, 0x63, 0x7a, 0x99, 0x2a, 0x83, 0x5b, 0xb0,
+	0xe2, 0x12, 0x76, 0x8e, 0x3a, 0xbf, 0xca, 0x29, 0x4e, 0x15, 0xe7, 0x06, 0x2c, 0xb9, 0xc3, 0x70,
+	0xf2, 0xa9, 0xd5, 0x94, 0xa6, 0xf2, 0xff, 0x14, 0xea, 0x6d, 0xbf, 0x17, 0x7a, 0xc1, 0x84, 0xb5,
+	0xbc, 0x02, 0xf3, 0x4c, 0xe8, 0x89, 0x75, 0x5c, 0x74, 0x25, 0xe5, 0xb4, 0x6c, 0xfb, 0xa9, 0xa2,
+	0xdf, 0x84, 0xba, 0x4b, 0x82, 0xc8, 0xeb, 0x4e, 0x28, 0xb0, 0x65, 0xeb, 0x4d, 0x7b, 0x22, 0x5f,
+	0x79, 0x43, 0x46, 0x26, 0x9f, 0x48, 0x43, 0x6d, 0xfa, 0x4a, 0xd8, 0x70, 0x40, 0xce, 0x53, 0x89,
+	0xae, 0x37, 0x6d, 0x94, 0x5d, 0x12, 0x90, 0xe4, 0x1c, 0x51, 0x4c, 0xbd, 0xa9, 0xa2, 0xfc, 0x55,
+	0x02, 0xf4, 0xb3, 0x97, 0x74, 0xfa, 0x52, 0x72, 0x18, 0xf5, 0xce, 0x38, 0x16, 0xe1, 0x70, 0x70,
+	0x44, 0x62, 0xf9, 0xd6, 0x4a, 0x8a, 0xf3, 0x8f, 0xa3, 0x20, 0x88, 0x7e, 0x6b, 0x94, 0xc5, 0xb5,
+	0x93, 0x14, 0xbf, 0x92, 0x2c, 0xe9, 0x92, 0x38, 0x7e, 0x19, 0x06, 0xef, 0x1a, 0x73, 0x42, 0xa6,
+	0x71, 0xf8, 0xd3, 0xcd, 0xfc, 0xb0, 0x43, 0x1a, 0x95, 0xf4, 0xe9, 0x16, 0x04, 0xe7, 0x0e, 0xc3,
+	0xc4, 0x0f, 0x1a, 0xf3, 0x29, 0x57, 0x10, 0x3c, 0x9f, 0x5e, 0x4c, 0x68, 0xa3, 0x9a, 0xe6, 0xc3,
+	0xbf, 0x9d, 0xc7, 0xf9, 0xcc, 0x19, 0x45, 0x37, 0xa0, 0x1c, 0x44, 0x3d, 0x59, 0xf6, 0x8a, 0x59,
+	0x36, 0x57, 0xe1, 0x52, 0xe7, 0x8f, 0x12, 0x5c, 0xd2, 0x6d, 0x99, 0x2c, 0x7b, 0x15, 0x2a, 0xbc,
+	0xd4, 0xb4, 0xed, 0x2c, 0xba, 0x29, 0x81, 0x30, 0xef, 0x47, 0x01, 0xe9, 0x24, 0x51, 0x2c, 0x6f,
+	0x84, 0xa2, 0x51, 0x1d, 0xca, 0x5e, 0x10, 0xc8, 0xca, 0xf9, 0x27, 0xba, 0x0b, 0xd5, 0x88, 0x26,
+	0x7e, 0x14, 0xb2, 0xc6, 0x9c, 0x91, 0xc4, 0x61, 0xd4, 0x7b, 0x99, 0x0a, 0xdc, 0x4c, 0xc3, 0xd9,
+	0x29, 0xc8, 0xe3, 0xbc, 0x45, 0xb4, 0x60, 0xe5, 0x20, 0x64, 0x89, 0x17, 0xe8, 0xf7, 0xf9, 0x96,
+	0xd6, 0xf6, 0x3f, 0x91, 0x86, 0xa6, 0x96, 0x6c, 0xff, 0x2f, 0x72, 0xd6, 0x8c, 0xa2, 0x07, 0x0a,
+	0x02, 0xa4, 0x1e, 0xae, 0x16, 0x7a, 0xb0, 0xa0, 0xc0, 0x17, 0x70, 0x45, 0x6b, 0xe0, 0x3f, 0x91,
+	0x98, 0x89, 0x1a, 0xc7, 0x1c, 0xd7, 0xef, 0x8b, 0xb5, 0x19, 0x45, 0xdb, 0xb0, 0x70, 0x22, 0x49,
+	0xd9, 0xf6, 0x2f, 0x9b, 0x95, 0x4b, 0x65, 0x57, 0xa9, 0x39, 0xb7, 0xe1, 0x92, 0xe6, 0x6c, 0xef,
+	0xad, 0x9f, 0x8c, 0x8d, 0xfb, 0x75, 0x81, 0xaa, 0xb8, 0x29, 0x15, 0xc2, 0xbf, 0x65, 0x44, 0xab,
+	0x6d, 0x73, 0x35, 0x37, 0x55, 0x70, 0x1e, 0xc1, 0x72, 0xd6, 0xb7, 0x3b, 0x31, 0x49, 0x78, 0x9c,
+	0x35, 0xa3, 0xe5, 0x8f, 0xf6, 0x89, 0xcb, 0xb5, 0x0e, 0x6b, 0x5b, 0x32, 0x8a, 0xd6, 0x60, 0x9e,
+	0x09, 0x42, 0xda, 0x2e, 0x19, 0xb6, 0xae, 0x14, 0x8e, 0xd0, 0x12, 0xa7, 0x78, 0x69, 0xce, 0x63,
+	0x93, 0xc3, 0x28, 0xba, 0xc5, 0xef, 0xba, 0xa0, 0x64, 0x0d, 0x96, 0xaf, 0x4c, 0xca, 0x9f, 0xc6,
+	0xec, 0xa1, 0xc8, 0x0a, 0x28, 0x5a, 0xa8, 0x47, 0x96, 0xda, 0xf9, 0xb3, 0x6d, 0xc1, 0xca, 0x6b,
+	0xda, 0x8b, 0xbd, 0x2e, 0x99, 0x70, 0x20, 0x4d, 0xad, 0xd1, 0x81, 0xb4, 0xac, 0xcf, 0x38, 0x90,
+	0x76, 0x1c, 0xe3, 0x40, 0xee, 0x02, 0x72, 0xa3, 0x20, 0x38, 0xf2, 0x3a, 0xbf, 0x4e, 0xe8, 0x74,
+	0x18, 0x16, 0x62, 0x72, 0xe2, 0xf3, 0xc3, 0x94, 0xdd, 0xec, 0x8c, 0x76, 0x56, 0xf3, 0x5e, 0x18,
+	0x75, 0x9e, 0x41, 0xfd, 0x79, 0x14, 0xf7, 0x48, 0xf2, 0x3f, 0x3c, 0x23, 0xdb, 0x07, 0xa3, 0xce,
+	0x55, 0xa8, 0x1c, 0x66, 0x67, 0x97, 0x7a, 0x49, 0x3f, 0x73, 0xc6, 0xbf, 0x9d, 0x0d, 0x21, 0x14,
+	0x1b, 0x51, 0x39, 0xf6, 0x03, 0x05, 0x8b, 0x33, 0x5c, 0xff, 0xdc, 0x0f, 0x88, 0x80, 0xd8, 0xa9,
+	0xd4, 0xd9, 0x84, 0xea, 0xab, 0x61, 0x10, 0x8c, 0xcb, 0xad, 0x0e, 0xe5, 0xae, 0x1f, 0x4b, 0x90,
+	0xc4, 0x3f, 0x9d, 0x87, 0xd2, 0x80, 0x51, 0x74, 0xdb, 0x5a, 0xf1, 0xec, 0x54, 0xa7, 0x0e, 0x8d,
+	0x75, 0x5e, 0xe7, 0x56, 0xac, 0xcf, 0xc3, 0x5c, 0xd3, 0x76, 0x79, 0x49, 0x59, 0xb0, 0xbe, 0xdc,
+	0xdb, 0x45, 0xa9, 0xc9, 0x28, 0x37, 0xda, 0x7b, 0x4b, 0x3a, 0xe3, 0x8c, 0xb8, 0x4c, 0x1a, 0x3d,
+	0x94, 0x9a, 0x67, 0x24, 0x95, 0x7a, 0x32, 0x92, 0xda, 0x82, 0x0b, 0x3f, 0x92, 0x78, 0xe0, 0x87,
+	0x9e, 0xa8, 0xff, 0x73, 0x2d, 0x46, 0x66, 0x95, 0xc9, 0xd5, 0x20, 0x34, 0xb2, 0x60, 0x14, 0xdd,
+	0xb3, 0xc6, 0xa0, 0xcb, 0x96, 0x95, 0x19, 0xef, 0xfe, 0xfb, 0x65, 0x80, 0x7d, 0x3a, 0x90, 0x5b,
+	0x89, 0xd6, 0xa0, 0xfa, 0x82, 0x78, 0x41, 0xd2, 0xff, 0x1d, 0x5d, 0xcc, 0x92, 0xe4, 0x03, 0x1a,
+	0x36, 0x28, 0xd4, 0x02, 0x18, 0x0d, 0x5f, 0x68, 0xd5, 0x98, 0xb4, 0xe4, 0x04, 0x87, 0x0b, 0xb8,
+	0x8c, 0xae, 0x97, 0xb6, 0x4a, 0x7c, 0xd4, 0xe0, 0xdb, 0x8d, 0x6a, 0xea, 0x79, 0x16, 0x93, 0x18,
+	0x36, 0x68, 0x46, 0xd1, 0x13, 0xb8, 0xa0, 0xbd, 0x79, 0x28, 0xab, 0xc4, 0x1c, 0xc0, 0x70, 0x11,
+	0x9b, 0x51, 0xf4, 0x08, 0x60, 0x34, 0x1e, 0xa9, 0x14, 0x8d, 0xb1, 0x0a, 0x17, 0x70, 0x19, 0x45,
+	0xdf, 0xc0, 0x92, 0x31, 0xe1, 0xa0, 0xec, 0xde, 0xdb, 0x33, 0x13, 0x2e, 0x16, 0xa4, 0x99, 0x6b,
+	0x43, 0x89, 0xca, 0xdc, 0x1c, 0x7d, 0x70, 0x11, 0x9b, 0x51, 0xf4, 0x14, 0x2e, 0xea, 0xe3, 0x06,
+	0xca, 0xe6, 0x1f, 0x6b, 0x54, 0xc1, 0x85, 0xfc, 0x34, 0xb8, 0x36, 0x2b, 0xa8, 0xe0, 0xe6, 0x14,
+	0x82, 0x8b, 0xd8, 0x8c, 0xa2, 0x5d, 0xa8, 0x99, 0x33, 0x00, 0x6a, 0x48, 0xc5, 0xdc, 0x0c, 0x81,
+	0xc7, 0x48, 0xd2, 0xc5, 0x1f, 0xa1, 0x7c, 0xb5, 0xf8, 0xc6, 0x74, 0x80, 0x0b, 0xb8, 0xe9, 0xe2,
+	0x1b, 0x20, 0x5d, 0x2d, 0xbe, 0x0d, 0xfd, 0x71, 0xb1, 0x20, 0x75, 0x61, 0x20, 0x70, 0xe5, 0xc2,
+	0xc6, 0xef, 0xb8, 0x58, 0x90, 0x6e, 0x81, 0x8e, 0xaf, 0xd5, 0x16, 0x58, 0xd8, 0x1c, 0x17, 0xf2,
+	0xb3, 0x14, 0x34, 0xe8, 0xac, 0xa5, 0x60, 0x02, 0x6f, 0x5c, 0x2c, 0x48, 0x5d, 0x18, 0xb8, 0x58,
+	0xb9, 0xb0, 0x51, 0x35, 0x2e, 0x16, 0x30, 0x8a, 0x0e, 0x60, 0xd9, 0x42, 0x98, 0xe8, 0x53, 0xa9,
+	0x9b, 0xc7, 0xcc, 0x78, 0x9c, 0x88, 0xd1, 0xad, 0x12, 0x3a, 0x84, 0xba, 0x0d, 0xf4, 0x10, 0x2e,
+	0x30, 0x90, 0x48, 0x14, 0x8f, 0x95, 0x09, 0x6f, 0x2f, 0xa0, 0x66, 0x42, 0x32, 0x75, 0xc8, 0x72,
+	0x88, 0x10, 0x8f, 0x91, 0xc8, 0xa7, 0xa4, 0x6d, 0xc0, 0xa2, 0x0c, 0x8e, 0xa1, 0x6b, 0xf9, 0x37,
+	0x41, 0x03, 0x76, 0xf8, 0x2c, 0x31, 0xa3, 0xe8, 0x3b, 0xa8, 0xdb, 0x58, 0x4b, 0x15, 0x5b, 0x80,
+	0xd7, 0xf0, 0x58, 0x19, 0xa3, 0xbc, 0x54, 0xb3, 0xd9, 0xab, 0x52, 0x73, 0x58, 0x03, 0x8f, 0x91,
+	0xc8, 0x52, 0xf7, 0x61, 0x99, 0x37, 0xf4, 0x67, 0xa3, 0x86, 0xae, 0x76, 0x33, 0x0f, 0x17, 0xf0,
+	0x38, 0x51, 0x7a, 0xb2, 0x8c, 0xfe, 0xad, 0x4e, 0x96, 0x8d, 0x0c, 0x70, 0xb1, 0x20, 0xbd, 0x1f,
+	0x3a, 0x24, 0x54, 0xf7, 0xc3, 0x42, 0x98, 0xb8, 0x90, 0xaf, 0xbf, 0xec, 0x9c, 0xc1, 0xac, 0x97,
+	0x3d, 0x03, 0x8b, 0xb8, 0x88, 0x9d, 0x06, 0xd7, 0x11, 0x9e, 0x0a, 0x6e, 0xa1, 0x43, 0x5c, 0xc8,
+	0x17, 0xff, 0xf2, 0xcd, 0x1e, 0x32, 0xd5, 0xde, 0x04, 0x6c, 0xc1, 0x1a, 0x25, 0x50, 0xf5, 0x1c,
+	0xc7, 0x0b, 0xaa, 0x41, 0x49, 0x34, 0x82, 0x0d, 0x5a, 0x9c, 0xe4, 0x3b, 0x5c, 0x93, 0xf5, 0x35,
+	0x4d, 0xd6, 0x37, 0x35, 0x59, 0x3f, 0xdb, 0xc0, 0x9b, 0x30, 0xc7, 0x1b, 0xbe, 0xd2, 0x95, 0x38,
+	0x02, 0x1b, 0x34, 0xa3, 0xe8, 0x4b, 0x58, 0xc8, 0x9a, 0x35, 0x42, 0xb9, 0xee, 0xfd, 0x06, 0xe7,
+	0x78, 0xa9, 0xff, 0x67, 0x3f, 0xbc, 0xff, 0xb7, 0x39, 0xf3, 0xfe, 0x63, 0xb3, 0xf4, 0xe1, 0x63,
+	0xb3, 0xf4, 0xcf, 0xc7, 0x66, 0xe9, 0xcf, 0xd3, 0xe6, 0xcc, 0x87, 0xd3, 0xe6, 0xcc, 0xdf, 0xa7,
+	0xcd, 0x99, 0x5f, 0x36, 0xcf, 0xfd, 0xcf, 0xee, 0x13, 0xe1, 0xfe, 0x68, 0x5e, 0xfc, 0x45, 0xfb,
+	0xe0, 0xbf, 0x01, 0x00, 0xf1, 0xd8, 0xcb, 0x86, 0x13, 0x16, 0x00, 0x00,
+}
+
+func (m *Empty) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return n
+}
+
+func (m *UpdateSelfReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return len(dAtA) - i, nil
+	var l int
+	_ = l
+	if m.In != nil {
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *StartServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateSelfRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *StartServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InfoReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
-func (m *StartServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *InfoRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if m.Gpm != nil {
+		l = m.Gpm.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *StopServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	return n
 }
 
-func (m *StopServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StopServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ListServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.XSize()
+			n += 1 + l + sovGpm(uint64(l))
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StopServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Total != 0 {
+		n += 1 + sovGpm(uint64(m.Total))
 	}
-	return dAtA[:n], nil
+	return n
 }
 
-func (m *StopServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *StopServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *GetServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *RestartServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RestartServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RestartServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *EditServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	if m.Spec != nil {
+		l = m.Spec.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RestartServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EditServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RestartServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StartServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RestartServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *StartServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *DeleteServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StopServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Dependents {
+		n += 2
+	}
+	return n
 }
 
-func (m *DeleteServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *StopServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *DeleteServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RestartServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *DeleteServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RestartServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *DeleteServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *RunServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *WatchServiceLogReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RunServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WatchServiceLogReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *WatchServiceLogReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *SignalServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Follow {
-		i--
-		if m.Follow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Number != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *WatchServiceLogRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignalServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WatchServiceLogRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WatchServiceLogRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.Log != nil {
-		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *InstallServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReloadServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InstallServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InstallServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.In != nil {
-		{
-			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *InstallServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReloadServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *InstallServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PauseServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *InstallServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *PauseServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ListServiceVersionsReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ResumeServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ListServiceVersionsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ResumeServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ListServiceVersionsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *DeleteServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ListServiceVersionsRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ListServiceVersionsRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WatchServiceLogReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovGpm(uint64(m.Number))
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.StderrOnly {
+		n += 2
+	}
+	if m.Since != 0 {
+		n += 1 + sovGpm(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovGpm(uint64(m.Until))
+	}
+	l = len(m.Grep)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ListServiceVersionsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *WatchServiceLogRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGpm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Log != nil {
+		l = m.Log.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *UpgradeServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WatchServicesLogReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.Options != nil {
+		l = m.Options.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpgradeServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WatchServicesLogRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Log != nil {
+		l = m.Log.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpgradeServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *InstallServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.In != nil {
-		{
-			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *UpgradeServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InstallServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpgradeServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListServiceVersionsReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpgradeServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ListServiceVersionsRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.XSize()
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *RollbackServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListServiceExitsReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RollbackServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RollbackServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ListServiceExitsRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Revision) > 0 {
-		i -= len(m.Revision)
-		copy(dAtA[i:], m.Revision)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Revision)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Exits) > 0 {
+		for _, e := range m.Exits {
+			l = e.XSize()
+			n += 1 + l + sovGpm(uint64(l))
+		}
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *RollbackServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateSecretReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RollbackServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateSecretRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *RollbackServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ListSecretsReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ForgetServiceReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListSecretsRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.XSize()
+			n += 1 + l + sovGpm(uint64(l))
+		}
+	}
+	return n
 }
 
-func (m *ForgetServiceReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ForgetServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *DeleteSecretReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Revision) > 0 {
-		i -= len(m.Revision)
-		copy(dAtA[i:], m.Revision)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Revision)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ForgetServiceRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteSecretRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ForgetServiceRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpgradeServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.In != nil {
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ForgetServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *UpgradeServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
+	if m.Result != nil {
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *LsReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RollbackServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *LsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RollbackServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
-func (m *LsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ForgetServiceReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *LsRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForgetServiceRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	return n
 }
 
-func (m *LsRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LsReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *LsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *LsRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Files) > 0 {
-		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGpm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+		for _, e := range m.Files {
+			l = e.XSize()
+			n += 1 + l + sovGpm(uint64(l))
 		}
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *PullReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullReq) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.Dir {
-		i--
-		if m.Dir {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.Dir {
+		n += 2
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *PullRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullRsp) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
 	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *PushReq) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
+func (m *PushReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.In != nil {
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *PushRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExecReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.In != nil {
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *TerminalReq) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.In != nil {
+		l = m.In.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *TerminalRsp) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func sovGpm(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func sozGpm(x uint64) (n int) {
+	return sovGpm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
 	return dAtA[:n], nil
 }
 
-func (m *PushReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PushReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSelfReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSelfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSelfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3603,7 +3495,7 @@ func (m *PushReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PushRsp) Marshal() (dAtA []byte, err error) {
+func (m *UpdateSelfRsp) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3613,12 +3505,47 @@ func (m *PushRsp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PushRsp) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateSelfRsp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PushRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateSelfRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3626,7 +3553,7 @@ func (m *PushRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecReq) Marshal() (dAtA []byte, err error) {
+func (m *InfoRsp) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3636,19 +3563,19 @@ func (m *ExecReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *InfoRsp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InfoRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.In != nil {
+	if m.Gpm != nil {
 		{
-			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Gpm.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3661,7 +3588,7 @@ func (m *ExecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecRsp) Marshal() (dAtA []byte, err error) {
+func (m *ListServiceReq) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3671,32 +3598,92 @@ func (m *ExecRsp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecRsp) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListServiceReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminalReq) Marshal() (dAtA []byte, err error) {
+func (m *GetServiceRsp) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3706,78 +3693,3585 @@ func (m *TerminalReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TerminalReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetServiceRsp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminalReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.In != nil {
+	if m.Service != nil {
 		{
-			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TerminalRsp) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TerminalRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TerminalRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dependents {
+		i--
+		if m.Dependents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signal) > 0 {
+		i -= len(m.Signal)
+		copy(dAtA[i:], m.Signal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Signal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchServiceLogReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchServiceLogReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchServiceLogReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grep) > 0 {
+		i -= len(m.Grep)
+		copy(dAtA[i:], m.Grep)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Grep)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Until != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Since != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StderrOnly {
+		i--
+		if m.StderrOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchServiceLogRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchServiceLogRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchServiceLogRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchServicesLogReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchServicesLogReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchServicesLogReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchServicesLogRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchServicesLogRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchServicesLogRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstallServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstallServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstallServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.In != nil {
+		{
+			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstallServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstallServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstallServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceVersionsReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceVersionsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceVersionsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceVersionsRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceVersionsRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceVersionsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceExitsReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceExitsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceExitsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceExitsRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceExitsRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceExitsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exits) > 0 {
+		for iNdEx := len(m.Exits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSecretReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSecretReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSecretReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSecretRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSecretRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSecretRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSecretsReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSecretsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSecretsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSecretsRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSecretsRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSecretsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSecretReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSecretReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSecretReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSecretRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSecretRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSecretRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.In != nil {
+		{
+			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ForgetServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForgetServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForgetServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForgetServiceRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForgetServiceRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForgetServiceRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LsReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LsRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LsRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LsRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dir {
+		i--
+		if m.Dir {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.In != nil {
+		{
+			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.In != nil {
+		{
+			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminalReq) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminalReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.In != nil {
+		{
+			size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminalRsp) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminalRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGpm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGpm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSelfReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSelfReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSelfReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.In == nil {
+				m.In = &v1.UpdateIn{}
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSelfRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSelfRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSelfRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &v1.UpdateResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gpm == nil {
+				m.Gpm = &v1.GpmInfo{}
+			}
+			if err := m.Gpm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &v1.Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &v1.ServiceSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &v1.EditServiceSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dependents = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartServiceRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartServiceRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGpm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGpm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Empty) Unmarshal(dAtA []byte) error {
+func (m *RunServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3800,12 +7294,48 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -3827,7 +7357,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateSelfReq) Unmarshal(dAtA []byte) error {
+func (m *SignalServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3850,17 +7380,17 @@ func (m *UpdateSelfReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateSelfReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalServiceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateSelfReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3870,27 +7400,55 @@ func (m *UpdateSelfReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.In == nil {
-				m.In = &v1.UpdateIn{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
-			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3913,7 +7471,7 @@ func (m *UpdateSelfReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateSelfRsp) Unmarshal(dAtA []byte) error {
+func (m *SignalServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3936,15 +7494,15 @@ func (m *UpdateSelfRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateSelfRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateSelfRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3971,10 +7529,10 @@ func (m *UpdateSelfRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &v1.UpdateResult{}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3999,7 +7557,7 @@ func (m *UpdateSelfRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoReq) Unmarshal(dAtA []byte) error {
+func (m *ReloadServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,12 +7580,44 @@ func (m *InfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReloadServiceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReloadServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -4049,7 +7639,7 @@ func (m *InfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoRsp) Unmarshal(dAtA []byte) error {
+func (m *ReloadServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4072,15 +7662,15 @@ func (m *InfoRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReloadServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReloadServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4107,10 +7697,10 @@ func (m *InfoRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpm == nil {
-				m.Gpm = &v1.GpmInfo{}
+			if m.Service == nil {
+				m.Service = &v1.Service{}
 			}
-			if err := m.Gpm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4135,7 +7725,7 @@ func (m *InfoRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListServiceReq) Unmarshal(dAtA []byte) error {
+func (m *PauseServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4145,25 +7735,57 @@ func (m *ListServiceReq) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGpm
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListServiceReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -4185,7 +7807,7 @@ func (m *ListServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *PauseServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4208,15 +7830,15 @@ func (m *ListServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4243,30 +7865,13 @@ func (m *ListServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &v1.Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &v1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -4288,7 +7893,7 @@ func (m *ListServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetServiceReq) Unmarshal(dAtA []byte) error {
+func (m *ResumeServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,10 +7916,10 @@ func (m *GetServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeServiceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4370,7 +7975,7 @@ func (m *GetServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *ResumeServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4393,10 +7998,10 @@ func (m *GetServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4456,7 +8061,7 @@ func (m *GetServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateServiceReq) Unmarshal(dAtA []byte) error {
+func (m *DeleteServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4479,17 +8084,17 @@ func (m *CreateServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteServiceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4499,27 +8104,23 @@ func (m *CreateServiceReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &v1.ServiceSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4542,7 +8143,7 @@ func (m *CreateServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *DeleteServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4565,10 +8166,10 @@ func (m *CreateServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4628,7 +8229,7 @@ func (m *CreateServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
+func (m *WatchServiceLogReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4651,10 +8252,10 @@ func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchServiceLogReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchServiceLogReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4690,10 +8291,107 @@ func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StderrOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StderrOnly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grep", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4703,27 +8401,23 @@ func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &v1.EditServiceSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grep = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4746,7 +8440,7 @@ func (m *EditServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EditServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *WatchServiceLogRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4769,15 +8463,15 @@ func (m *EditServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchServiceLogRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchServiceLogRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4804,10 +8498,10 @@ func (m *EditServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &v1.Service{}
+			if m.Log == nil {
+				m.Log = &v1.ServiceLog{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4832,7 +8526,7 @@ func (m *EditServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartServiceReq) Unmarshal(dAtA []byte) error {
+func (m *WatchServicesLogReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4845,25 +8539,57 @@ func (m *StartServiceReq) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartServiceReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchServicesLogReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchServicesLogReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4891,7 +8617,63 @@ func (m *StartServiceReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &v1.LogOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4914,7 +8696,7 @@ func (m *StartServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *WatchServicesLogRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4937,15 +8719,15 @@ func (m *StartServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchServicesLogRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchServicesLogRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4972,10 +8754,10 @@ func (m *StartServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &v1.Service{}
+			if m.Log == nil {
+				m.Log = &v1.ServiceLog{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5000,7 +8782,7 @@ func (m *StartServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopServiceReq) Unmarshal(dAtA []byte) error {
+func (m *InstallServiceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5023,17 +8805,17 @@ func (m *StopServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstallServiceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstallServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5043,23 +8825,27 @@ func (m *StopServiceReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.In == nil {
+				m.In = &v1.InstallServiceIn{}
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5082,7 +8868,7 @@ func (m *StopServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *InstallServiceRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5105,15 +8891,15 @@ func (m *StopServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstallServiceRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstallServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5140,10 +8926,10 @@ func (m *StopServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &v1.Service{}
+			if m.Result == nil {
+				m.Result = &v1.InstallServiceResult{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5168,7 +8954,7 @@ func (m *StopServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartServiceReq) Unmarshal(dAtA []byte) error {
+func (m *ListServiceVersionsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5191,10 +8977,10 @@ func (m *RestartServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListServiceVersionsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListServiceVersionsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5250,7 +9036,7 @@ func (m *RestartServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *ListServiceVersionsRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5273,15 +9059,15 @@ func (m *RestartServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListServiceVersionsRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListServiceVersionsRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5308,10 +9094,8 @@ func (m *RestartServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &v1.Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, &v1.ServiceVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5336,7 +9120,7 @@ func (m *RestartServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteServiceReq) Unmarshal(dAtA []byte) error {
+func (m *ListServiceExitsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,10 +9143,10 @@ func (m *DeleteServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListServiceExitsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListServiceExitsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5418,7 +9202,7 @@ func (m *DeleteServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *ListServiceExitsRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5441,15 +9225,15 @@ func (m *DeleteServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListServiceExitsRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListServiceExitsRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5476,10 +9260,8 @@ func (m *DeleteServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &v1.Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exits = append(m.Exits, &v1.ServiceExit{})
+			if err := m.Exits[len(m.Exits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5504,7 +9286,7 @@ func (m *DeleteServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchServiceLogReq) Unmarshal(dAtA []byte) error {
+func (m *CreateSecretReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5527,17 +9309,17 @@ func (m *WatchServiceLogReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchServiceLogReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSecretReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchServiceLogReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSecretReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5547,63 +9329,28 @@ func (m *WatchServiceLogReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			if m.Spec == nil {
+				m.Spec = &v1.SecretSpec{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Follow = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5625,7 +9372,7 @@ func (m *WatchServiceLogReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchServiceLogRsp) Unmarshal(dAtA []byte) error {
+func (m *CreateSecretRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5648,15 +9395,15 @@ func (m *WatchServiceLogRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchServiceLogRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSecretRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchServiceLogRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSecretRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5683,10 +9430,10 @@ func (m *WatchServiceLogRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &v1.ServiceLog{}
+			if m.Secret == nil {
+				m.Secret = &v1.Secret{}
 			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5711,7 +9458,7 @@ func (m *WatchServiceLogRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstallServiceReq) Unmarshal(dAtA []byte) error {
+func (m *ListSecretsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5734,48 +9481,12 @@ func (m *InstallServiceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstallServiceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSecretsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstallServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSecretsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.In == nil {
-				m.In = &v1.InstallServiceIn{}
-			}
-			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5797,7 +9508,7 @@ func (m *InstallServiceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstallServiceRsp) Unmarshal(dAtA []byte) error {
+func (m *ListSecretsRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5820,15 +9531,15 @@ func (m *InstallServiceRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstallServiceRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSecretsRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstallServiceRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSecretsRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5855,10 +9566,8 @@ func (m *InstallServiceRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &v1.InstallServiceResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Secrets = append(m.Secrets, &v1.Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5883,7 +9592,7 @@ func (m *InstallServiceRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListServiceVersionsReq) Unmarshal(dAtA []byte) error {
+func (m *DeleteSecretReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5906,10 +9615,10 @@ func (m *ListServiceVersionsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListServiceVersionsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSecretReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServiceVersionsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSecretReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5965,7 +9674,7 @@ func (m *ListServiceVersionsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListServiceVersionsRsp) Unmarshal(dAtA []byte) error {
+func (m *DeleteSecretRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5988,15 +9697,15 @@ func (m *ListServiceVersionsRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListServiceVersionsRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSecretRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListServiceVersionsRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSecretRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6023,8 +9732,10 @@ func (m *ListServiceVersionsRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, &v1.ServiceVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Secret == nil {
+				m.Secret = &v1.Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7500,16 +11211,36 @@ type GpmServiceClient interface {
 	// +gen:summary=重启服务
 	// +gen:patch=/api/v1/Service/{name}/action/restart
 	RestartService(ctx context.Context, in *RestartServiceReq, opts ...grpc.CallOption) (*RestartServiceRsp, error)
+	// +gen:summary=立即运行一次 oneshot 或 cron 服务
+	// +gen:patch=/api/v1/Service/{name}/action/run
+	RunService(ctx context.Context, in *RunServiceReq, opts ...grpc.CallOption) (*RunServiceRsp, error)
+	// +gen:summary=向服务进程发送信号
+	// +gen:patch=/api/v1/Service/{name}/action/signal
+	SignalService(ctx context.Context, in *SignalServiceReq, opts ...grpc.CallOption) (*SignalServiceRsp, error)
+	// +gen:summary=重新加载服务, 不重启服务进程
+	// +gen:patch=/api/v1/Service/{name}/action/reload
+	ReloadService(ctx context.Context, in *ReloadServiceReq, opts ...grpc.CallOption) (*ReloadServiceRsp, error)
+	// +gen:summary=暂停服务, 保留进程的内存状态
+	// +gen:patch=/api/v1/Service/{name}/action/pause
+	PauseService(ctx context.Context, in *PauseServiceReq, opts ...grpc.CallOption) (*PauseServiceRsp, error)
+	// +gen:summary=恢复暂停的服务
+	// +gen:patch=/api/v1/Service/{name}/action/resume
+	ResumeService(ctx context.Context, in *ResumeServiceReq, opts ...grpc.CallOption) (*ResumeServiceRsp, error)
 	// +gen:summary=删除服务
 	// +gen:delete=/api/v1/Service/{name}
 	DeleteService(ctx context.Context, in *DeleteServiceReq, opts ...grpc.CallOption) (*DeleteServiceRsp, error)
 	// 动态监听服务日志
 	WatchServiceLog(ctx context.Context, in *WatchServiceLogReq, opts ...grpc.CallOption) (GpmService_WatchServiceLogClient, error)
+	// 同时监听多个服务的日志, 按照写入时间合并
+	WatchServicesLog(ctx context.Context, in *WatchServicesLogReq, opts ...grpc.CallOption) (GpmService_WatchServicesLogClient, error)
 	// 远程安装服务
 	InstallService(ctx context.Context, opts ...grpc.CallOption) (GpmService_InstallServiceClient, error)
 	// +gen:summary=查看服务历史版本
 	// +gen:get=/api/v1/Service/{name}/versions
 	ListServiceVersions(ctx context.Context, in *ListServiceVersionsReq, opts ...grpc.CallOption) (*ListServiceVersionsRsp, error)
+	// +gen:summary=查看服务退出记录
+	// +gen:get=/api/v1/Service/{name}/exits
+	ListServiceExits(ctx context.Context, in *ListServiceExitsReq, opts ...grpc.CallOption) (*ListServiceExitsRsp, error)
 	// 升级服务
 	UpgradeService(ctx context.Context, opts ...grpc.CallOption) (GpmService_UpgradeServiceClient, error)
 	// +gen:summary=回滚服务
@@ -7518,6 +11249,15 @@ type GpmServiceClient interface {
 	// +gen:summary=删除历史版本
 	// +gen:delete=/api/v1/Service/{name}/forget
 	ForgetService(ctx context.Context, in *ForgetServiceReq, opts ...grpc.CallOption) (*ForgetServiceRsp, error)
+	// +gen:summary=创建密钥, 密钥已经存在时更新密钥的值
+	// +gen:post=/api/v1/Secret
+	CreateSecret(ctx context.Context, in *CreateSecretReq, opts ...grpc.CallOption) (*CreateSecretRsp, error)
+	// +gen:summary=查询所有密钥, 不返回密钥的值
+	// +gen:get=/api/v1/Secrets
+	ListSecrets(ctx context.Context, in *ListSecretsReq, opts ...grpc.CallOption) (*ListSecretsRsp, error)
+	// +gen:summary=删除密钥
+	// +gen:delete=/api/v1/Secret/{name}
+	DeleteSecret(ctx context.Context, in *DeleteSecretReq, opts ...grpc.CallOption) (*DeleteSecretRsp, error)
 	// +gen:summary=获取目录信息下文件列表
 	// +gen:get=/api/v1/Action/ls
 	Ls(ctx context.Context, in *LsReq, opts ...grpc.CallOption) (*LsRsp, error)
@@ -7652,6 +11392,51 @@ func (c *gpmServiceClient) RestartService(ctx context.Context, in *RestartServic
 	return out, nil
 }
 
+func (c *gpmServiceClient) RunService(ctx context.Context, in *RunServiceReq, opts ...grpc.CallOption) (*RunServiceRsp, error) {
+	out := new(RunServiceRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/RunService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) SignalService(ctx context.Context, in *SignalServiceReq, opts ...grpc.CallOption) (*SignalServiceRsp, error) {
+	out := new(SignalServiceRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/SignalService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) ReloadService(ctx context.Context, in *ReloadServiceReq, opts ...grpc.CallOption) (*ReloadServiceRsp, error) {
+	out := new(ReloadServiceRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/ReloadService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) PauseService(ctx context.Context, in *PauseServiceReq, opts ...grpc.CallOption) (*PauseServiceRsp, error) {
+	out := new(PauseServiceRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/PauseService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) ResumeService(ctx context.Context, in *ResumeServiceReq, opts ...grpc.CallOption) (*ResumeServiceRsp, error) {
+	out := new(ResumeServiceRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/ResumeService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmServiceClient) DeleteService(ctx context.Context, in *DeleteServiceReq, opts ...grpc.CallOption) (*DeleteServiceRsp, error) {
 	out := new(DeleteServiceRsp)
 	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/DeleteService", in, out, opts...)
@@ -7693,8 +11478,40 @@ func (x *gpmServiceWatchServiceLogClient) Recv() (*WatchServiceLogRsp, error) {
 	return m, nil
 }
 
+func (c *gpmServiceClient) WatchServicesLog(ctx context.Context, in *WatchServicesLogReq, opts ...grpc.CallOption) (GpmService_WatchServicesLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[2], "/gpmv1.GpmService/WatchServicesLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gpmServiceWatchServicesLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GpmService_WatchServicesLogClient interface {
+	Recv() (*WatchServicesLogRsp, error)
+	grpc.ClientStream
+}
+
+type gpmServiceWatchServicesLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *gpmServiceWatchServicesLogClient) Recv() (*WatchServicesLogRsp, error) {
+	m := new(WatchServicesLogRsp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gpmServiceClient) InstallService(ctx context.Context, opts ...grpc.CallOption) (GpmService_InstallServiceClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[2], "/gpmv1.GpmService/InstallService", opts...)
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[3], "/gpmv1.GpmService/InstallService", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7733,8 +11550,17 @@ func (c *gpmServiceClient) ListServiceVersions(ctx context.Context, in *ListServ
 	return out, nil
 }
 
+func (c *gpmServiceClient) ListServiceExits(ctx context.Context, in *ListServiceExitsReq, opts ...grpc.CallOption) (*ListServiceExitsRsp, error) {
+	out := new(ListServiceExitsRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/ListServiceExits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmServiceClient) UpgradeService(ctx context.Context, opts ...grpc.CallOption) (GpmService_UpgradeServiceClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[3], "/gpmv1.GpmService/UpgradeService", opts...)
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[4], "/gpmv1.GpmService/UpgradeService", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7782,6 +11608,33 @@ func (c *gpmServiceClient) ForgetService(ctx context.Context, in *ForgetServiceR
 	return out, nil
 }
 
+func (c *gpmServiceClient) CreateSecret(ctx context.Context, in *CreateSecretReq, opts ...grpc.CallOption) (*CreateSecretRsp, error) {
+	out := new(CreateSecretRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/CreateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) ListSecrets(ctx context.Context, in *ListSecretsReq, opts ...grpc.CallOption) (*ListSecretsRsp, error) {
+	out := new(ListSecretsRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmServiceClient) DeleteSecret(ctx context.Context, in *DeleteSecretReq, opts ...grpc.CallOption) (*DeleteSecretRsp, error) {
+	out := new(DeleteSecretRsp)
+	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmServiceClient) Ls(ctx context.Context, in *LsReq, opts ...grpc.CallOption) (*LsRsp, error) {
 	out := new(LsRsp)
 	err := c.cc.Invoke(ctx, "/gpmv1.GpmService/Ls", in, out, opts...)
@@ -7792,7 +11645,7 @@ func (c *gpmServiceClient) Ls(ctx context.Context, in *LsReq, opts ...grpc.CallO
 }
 
 func (c *gpmServiceClient) Pull(ctx context.Context, in *PullReq, opts ...grpc.CallOption) (GpmService_PullClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[4], "/gpmv1.GpmService/Pull", opts...)
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[5], "/gpmv1.GpmService/Pull", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7824,7 +11677,7 @@ func (x *gpmServicePullClient) Recv() (*PullRsp, error) {
 }
 
 func (c *gpmServiceClient) Push(ctx context.Context, opts ...grpc.CallOption) (GpmService_PushClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[5], "/gpmv1.GpmService/Push", opts...)
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[6], "/gpmv1.GpmService/Push", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7864,7 +11717,7 @@ func (c *gpmServiceClient) Exec(ctx context.Context, in *ExecReq, opts ...grpc.C
 }
 
 func (c *gpmServiceClient) Terminal(ctx context.Context, opts ...grpc.CallOption) (GpmService_TerminalClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[6], "/gpmv1.GpmService/Terminal", opts...)
+	stream, err := c.cc.NewStream(ctx, &_GpmService_serviceDesc.Streams[7], "/gpmv1.GpmService/Terminal", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7924,16 +11777,36 @@ type GpmServiceServer interface {
 	// +gen:summary=重启服务
 	// +gen:patch=/api/v1/Service/{name}/action/restart
 	RestartService(context.Context, *RestartServiceReq) (*RestartServiceRsp, error)
+	// +gen:summary=立即运行一次 oneshot 或 cron 服务
+	// +gen:patch=/api/v1/Service/{name}/action/run
+	RunService(context.Context, *RunServiceReq) (*RunServiceRsp, error)
+	// +gen:summary=向服务进程发送信号
+	// +gen:patch=/api/v1/Service/{name}/action/signal
+	SignalService(context.Context, *SignalServiceReq) (*SignalServiceRsp, error)
+	// +gen:summary=重新加载服务, 不重启服务进程
+	// +gen:patch=/api/v1/Service/{name}/action/reload
+	ReloadService(context.Context, *ReloadServiceReq) (*ReloadServiceRsp, error)
+	// +gen:summary=暂停服务, 保留进程的内存状态
+	// +gen:patch=/api/v1/Service/{name}/action/pause
+	PauseService(context.Context, *PauseServiceReq) (*PauseServiceRsp, error)
+	// +gen:summary=恢复暂停的服务
+	// +gen:patch=/api/v1/Service/{name}/action/resume
+	ResumeService(context.Context, *ResumeServiceReq) (*ResumeServiceRsp, error)
 	// +gen:summary=删除服务
 	// +gen:delete=/api/v1/Service/{name}
 	DeleteService(context.Context, *DeleteServiceReq) (*DeleteServiceRsp, error)
 	// 动态监听服务日志
 	WatchServiceLog(*WatchServiceLogReq, GpmService_WatchServiceLogServer) error
+	// 同时监听多个服务的日志, 按照写入时间合并
+	WatchServicesLog(*WatchServicesLogReq, GpmService_WatchServicesLogServer) error
 	// 远程安装服务
 	InstallService(GpmService_InstallServiceServer) error
 	// +gen:summary=查看服务历史版本
 	// +gen:get=/api/v1/Service/{name}/versions
 	ListServiceVersions(context.Context, *ListServiceVersionsReq) (*ListServiceVersionsRsp, error)
+	// +gen:summary=查看服务退出记录
+	// +gen:get=/api/v1/Service/{name}/exits
+	ListServiceExits(context.Context, *ListServiceExitsReq) (*ListServiceExitsRsp, error)
 	// 升级服务
 	UpgradeService(GpmService_UpgradeServiceServer) error
 	// +gen:summary=回滚服务
@@ -7942,6 +11815,15 @@ type GpmServiceServer interface {
 	// +gen:summary=删除历史版本
 	// +gen:delete=/api/v1/Service/{name}/forget
 	ForgetService(context.Context, *ForgetServiceReq) (*ForgetServiceRsp, error)
+	// +gen:summary=创建密钥, 密钥已经存在时更新密钥的值
+	// +gen:post=/api/v1/Secret
+	CreateSecret(context.Context, *CreateSecretReq) (*CreateSecretRsp, error)
+	// +gen:summary=查询所有密钥, 不返回密钥的值
+	// +gen:get=/api/v1/Secrets
+	ListSecrets(context.Context, *ListSecretsReq) (*ListSecretsRsp, error)
+	// +gen:summary=删除密钥
+	// +gen:delete=/api/v1/Secret/{name}
+	DeleteSecret(context.Context, *DeleteSecretReq) (*DeleteSecretRsp, error)
 	// +gen:summary=获取目录信息下文件列表
 	// +gen:get=/api/v1/Action/ls
 	Ls(context.Context, *LsReq) (*LsRsp, error)
@@ -7990,18 +11872,39 @@ func (*UnimplementedGpmServiceServer) StopService(ctx context.Context, req *Stop
 func (*UnimplementedGpmServiceServer) RestartService(ctx context.Context, req *RestartServiceReq) (*RestartServiceRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartService not implemented")
 }
+func (*UnimplementedGpmServiceServer) RunService(ctx context.Context, req *RunServiceReq) (*RunServiceRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunService not implemented")
+}
+func (*UnimplementedGpmServiceServer) SignalService(ctx context.Context, req *SignalServiceReq) (*SignalServiceRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalService not implemented")
+}
+func (*UnimplementedGpmServiceServer) ReloadService(ctx context.Context, req *ReloadServiceReq) (*ReloadServiceRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadService not implemented")
+}
+func (*UnimplementedGpmServiceServer) PauseService(ctx context.Context, req *PauseServiceReq) (*PauseServiceRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseService not implemented")
+}
+func (*UnimplementedGpmServiceServer) ResumeService(ctx context.Context, req *ResumeServiceReq) (*ResumeServiceRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeService not implemented")
+}
 func (*UnimplementedGpmServiceServer) DeleteService(ctx context.Context, req *DeleteServiceReq) (*DeleteServiceRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
 }
 func (*UnimplementedGpmServiceServer) WatchServiceLog(req *WatchServiceLogReq, srv GpmService_WatchServiceLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchServiceLog not implemented")
 }
+func (*UnimplementedGpmServiceServer) WatchServicesLog(req *WatchServicesLogReq, srv GpmService_WatchServicesLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchServicesLog not implemented")
+}
 func (*UnimplementedGpmServiceServer) InstallService(srv GpmService_InstallServiceServer) error {
 	return status.Errorf(codes.Unimplemented, "method InstallService not implemented")
 }
 func (*UnimplementedGpmServiceServer) ListServiceVersions(ctx context.Context, req *ListServiceVersionsReq) (*ListServiceVersionsRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServiceVersions not implemented")
 }
+func (*UnimplementedGpmServiceServer) ListServiceExits(ctx context.Context, req *ListServiceExitsReq) (*ListServiceExitsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceExits not implemented")
+}
 func (*UnimplementedGpmServiceServer) UpgradeService(srv GpmService_UpgradeServiceServer) error {
 	return status.Errorf(codes.Unimplemented, "method UpgradeService not implemented")
 }
@@ -8011,6 +11914,15 @@ func (*UnimplementedGpmServiceServer) RollBackService(ctx context.Context, req *
 func (*UnimplementedGpmServiceServer) ForgetService(ctx context.Context, req *ForgetServiceReq) (*ForgetServiceRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForgetService not implemented")
 }
+func (*UnimplementedGpmServiceServer) CreateSecret(ctx context.Context, req *CreateSecretReq) (*CreateSecretRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
+}
+func (*UnimplementedGpmServiceServer) ListSecrets(ctx context.Context, req *ListSecretsReq) (*ListSecretsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (*UnimplementedGpmServiceServer) DeleteSecret(ctx context.Context, req *DeleteSecretReq) (*DeleteSecretRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
 func (*UnimplementedGpmServiceServer) Ls(ctx context.Context, req *LsReq) (*LsRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ls not implemented")
 }
@@ -8219,6 +12131,96 @@ func _GpmService_RestartService_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GpmService_RunService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).RunService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/RunService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).RunService(ctx, req.(*RunServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_SignalService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).SignalService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/SignalService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).SignalService(ctx, req.(*SignalServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_ReloadService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).ReloadService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/ReloadService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).ReloadService(ctx, req.(*ReloadServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_PauseService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).PauseService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/PauseService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).PauseService(ctx, req.(*PauseServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_ResumeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).ResumeService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/ResumeService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).ResumeService(ctx, req.(*ResumeServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GpmService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteServiceReq)
 	if err := dec(in); err != nil {
@@ -8258,6 +12260,27 @@ func (x *gpmServiceWatchServiceLogServer) Send(m *WatchServiceLogRsp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GpmService_WatchServicesLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchServicesLogReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GpmServiceServer).WatchServicesLog(m, &gpmServiceWatchServicesLogServer{stream})
+}
+
+type GpmService_WatchServicesLogServer interface {
+	Send(*WatchServicesLogRsp) error
+	grpc.ServerStream
+}
+
+type gpmServiceWatchServicesLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *gpmServiceWatchServicesLogServer) Send(m *WatchServicesLogRsp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GpmService_InstallService_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(GpmServiceServer).InstallService(&gpmServiceInstallServiceServer{stream})
 }
@@ -8302,6 +12325,24 @@ func _GpmService_ListServiceVersions_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GpmService_ListServiceExits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceExitsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).ListServiceExits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/ListServiceExits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).ListServiceExits(ctx, req.(*ListServiceExitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GpmService_UpgradeService_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(GpmServiceServer).UpgradeService(&gpmServiceUpgradeServiceServer{stream})
 }
@@ -8364,6 +12405,60 @@ func _GpmService_ForgetService_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GpmService_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).CreateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/CreateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).CreateSecret(ctx, req.(*CreateSecretReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).ListSecrets(ctx, req.(*ListSecretsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GpmService_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GpmServiceServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpmv1.GpmService/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GpmServiceServer).DeleteSecret(ctx, req.(*DeleteSecretReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GpmService_Ls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LsReq)
 	if err := dec(in); err != nil {
@@ -8513,6 +12608,26 @@ var _GpmService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartService",
 			Handler:    _GpmService_RestartService_Handler,
 		},
+		{
+			MethodName: "RunService",
+			Handler:    _GpmService_RunService_Handler,
+		},
+		{
+			MethodName: "SignalService",
+			Handler:    _GpmService_SignalService_Handler,
+		},
+		{
+			MethodName: "ReloadService",
+			Handler:    _GpmService_ReloadService_Handler,
+		},
+		{
+			MethodName: "PauseService",
+			Handler:    _GpmService_PauseService_Handler,
+		},
+		{
+			MethodName: "ResumeService",
+			Handler:    _GpmService_ResumeService_Handler,
+		},
 		{
 			MethodName: "DeleteService",
 			Handler:    _GpmService_DeleteService_Handler,
@@ -8521,6 +12636,10 @@ var _GpmService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListServiceVersions",
 			Handler:    _GpmService_ListServiceVersions_Handler,
 		},
+		{
+			MethodName: "ListServiceExits",
+			Handler:    _GpmService_ListServiceExits_Handler,
+		},
 		{
 			MethodName: "RollBackService",
 			Handler:    _GpmService_RollBackService_Handler,
@@ -8529,6 +12648,18 @@ var _GpmService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForgetService",
 			Handler:    _GpmService_ForgetService_Handler,
 		},
+		{
+			MethodName: "CreateSecret",
+			Handler:    _GpmService_CreateSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _GpmService_ListSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _GpmService_DeleteSecret_Handler,
+		},
 		{
 			MethodName: "Ls",
 			Handler:    _GpmService_Ls_Handler,
@@ -8550,6 +12681,11 @@ var _GpmService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GpmService_WatchServiceLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchServicesLog",
+			Handler:       _GpmService_WatchServicesLog_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "InstallService",
 			Handler:       _GpmService_InstallService_Handler,
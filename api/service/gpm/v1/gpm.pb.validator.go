@@ -202,6 +202,114 @@ func (m *RestartServiceRsp) ValidateE(prefix string) error {
 	return is.MargeErr(errs...)
 }
 
+func (m *RunServiceReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *RunServiceReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *RunServiceRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *RunServiceRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *SignalServiceReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *SignalServiceReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	if len(m.Signal) == 0 {
+		errs = append(errs, fmt.Errorf("field '%ssignal' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *SignalServiceRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *SignalServiceRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ReloadServiceReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ReloadServiceReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *ReloadServiceRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ReloadServiceRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *PauseServiceReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *PauseServiceReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *PauseServiceRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *PauseServiceRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ResumeServiceReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ResumeServiceReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *ResumeServiceRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ResumeServiceRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
 func (m *DeleteServiceReq) Validate() error {
 	return m.ValidateE("")
 }
@@ -244,6 +352,24 @@ func (m *WatchServiceLogRsp) ValidateE(prefix string) error {
 	return is.MargeErr(errs...)
 }
 
+func (m *WatchServicesLogReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *WatchServicesLogReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *WatchServicesLogRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *WatchServicesLogRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
 func (m *InstallServiceReq) Validate() error {
 	return m.ValidateE("")
 }
@@ -283,6 +409,89 @@ func (m *ListServiceVersionsRsp) ValidateE(prefix string) error {
 	return is.MargeErr(errs...)
 }
 
+func (m *ListServiceExitsReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ListServiceExitsReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *ListServiceExitsRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ListServiceExitsRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *CreateSecretReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *CreateSecretReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if m.Spec == nil {
+		errs = append(errs, fmt.Errorf("field '%sspec' is required", prefix))
+	} else {
+		errs = append(errs, m.Spec.ValidateE(prefix+"spec."))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *CreateSecretRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *CreateSecretRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ListSecretsReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ListSecretsReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ListSecretsRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ListSecretsRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *DeleteSecretReq) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *DeleteSecretReq) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *DeleteSecretRsp) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *DeleteSecretRsp) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
 func (m *UpgradeServiceReq) Validate() error {
 	return m.ValidateE("")
 }
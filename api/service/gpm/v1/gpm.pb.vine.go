@@ -94,6 +94,46 @@ func NewGpmServiceEndpoints() []*api.Endpoint {
 			Body:        "*",
 			Handler:     "rpc",
 		},
+		&api.Endpoint{
+			Name:        "GpmService.RunService",
+			Description: "GpmService.RunService",
+			Path:        []string{"/api/v1/Service/{name}/action/run"},
+			Method:      []string{"PATCH"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.SignalService",
+			Description: "GpmService.SignalService",
+			Path:        []string{"/api/v1/Service/{name}/action/signal"},
+			Method:      []string{"PATCH"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.ReloadService",
+			Description: "GpmService.ReloadService",
+			Path:        []string{"/api/v1/Service/{name}/action/reload"},
+			Method:      []string{"PATCH"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.PauseService",
+			Description: "GpmService.PauseService",
+			Path:        []string{"/api/v1/Service/{name}/action/pause"},
+			Method:      []string{"PATCH"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.ResumeService",
+			Description: "GpmService.ResumeService",
+			Path:        []string{"/api/v1/Service/{name}/action/resume"},
+			Method:      []string{"PATCH"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
 		&api.Endpoint{
 			Name:        "GpmService.DeleteService",
 			Description: "GpmService.DeleteService",
@@ -110,6 +150,14 @@ func NewGpmServiceEndpoints() []*api.Endpoint {
 			Body:        "*",
 			Handler:     "rpc",
 		},
+		&api.Endpoint{
+			Name:        "GpmService.ListServiceExits",
+			Description: "GpmService.ListServiceExits",
+			Path:        []string{"/api/v1/Service/{name}/exits"},
+			Method:      []string{"GET"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
 		&api.Endpoint{
 			Name:        "GpmService.RollBackService",
 			Description: "GpmService.RollBackService",
@@ -126,6 +174,30 @@ func NewGpmServiceEndpoints() []*api.Endpoint {
 			Body:        "*",
 			Handler:     "rpc",
 		},
+		&api.Endpoint{
+			Name:        "GpmService.CreateSecret",
+			Description: "GpmService.CreateSecret",
+			Path:        []string{"/api/v1/Secret"},
+			Method:      []string{"POST"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.ListSecrets",
+			Description: "GpmService.ListSecrets",
+			Path:        []string{"/api/v1/Secrets"},
+			Method:      []string{"GET"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
+		&api.Endpoint{
+			Name:        "GpmService.DeleteSecret",
+			Description: "GpmService.DeleteSecret",
+			Path:        []string{"/api/v1/Secret/{name}"},
+			Method:      []string{"DELETE"},
+			Body:        "*",
+			Handler:     "rpc",
+		},
 		&api.Endpoint{
 			Name:        "GpmService.Ls",
 			Description: "GpmService.Ls",
@@ -224,6 +296,87 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
+			"/api/v1/Secret": &openapipb.OpenAPIPath{
+				Post: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "创建密钥, 密钥已经存在时更新密钥的值",
+					Description: "GpmService CreateSecret",
+					OperationId: "GpmServiceCreateSecret",
+					Parameters:  []*openapipb.PathParameters{},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "CreateSecret CreateSecretReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.CreateSecretReq",
+								},
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.CreateSecretRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Secret/{name}": &openapipb.OpenAPIPath{
+				Delete: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "删除密钥",
+					Description: "GpmService DeleteSecret",
+					OperationId: "GpmServiceDeleteSecret",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "DeleteSecretReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.DeleteSecretRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Secrets": &openapipb.OpenAPIPath{
+				Get: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "查询所有密钥, 不返回密钥的值",
+					Description: "GpmService ListSecrets",
+					OperationId: "GpmServiceListSecrets",
+					Parameters:  []*openapipb.PathParameters{},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListSecretsRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
 			"/api/v1/Service": &openapipb.OpenAPIPath{
 				Post: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
@@ -353,17 +506,17 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/action/restart": &openapipb.OpenAPIPath{
+			"/api/v1/Service/{name}/action/pause": &openapipb.OpenAPIPath{
 				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "重启服务",
-					Description: "GpmService RestartService",
-					OperationId: "GpmServiceRestartService",
+					Summary:     "暂停服务, 保留进程的内存状态",
+					Description: "GpmService PauseService",
+					OperationId: "GpmServicePauseService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "RestartServiceReq field name",
+							Description: "PauseServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
@@ -372,11 +525,11 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 					RequestBody: &openapipb.PathRequestBody{
-						Description: "RestartService RestartServiceReq",
+						Description: "PauseService PauseServiceReq",
 						Content: &openapipb.PathRequestBodyContent{
 							ApplicationJson: &openapipb.ApplicationContent{
 								Schema: &openapipb.Schema{
-									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceReq",
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.PauseServiceReq",
 								},
 							},
 						},
@@ -386,7 +539,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.PauseServiceRsp"},
 								},
 							},
 						},
@@ -394,17 +547,17 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/action/start": &openapipb.OpenAPIPath{
+			"/api/v1/Service/{name}/action/reload": &openapipb.OpenAPIPath{
 				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "启动服务",
-					Description: "GpmService StartService",
-					OperationId: "GpmServiceStartService",
+					Summary:     "重新加载服务, 不重启服务进程",
+					Description: "GpmService ReloadService",
+					OperationId: "GpmServiceReloadService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "StartServiceReq field name",
+							Description: "ReloadServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
@@ -413,11 +566,11 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 					RequestBody: &openapipb.PathRequestBody{
-						Description: "StartService StartServiceReq",
+						Description: "ReloadService ReloadServiceReq",
 						Content: &openapipb.PathRequestBodyContent{
 							ApplicationJson: &openapipb.ApplicationContent{
 								Schema: &openapipb.Schema{
-									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StartServiceReq",
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ReloadServiceReq",
 								},
 							},
 						},
@@ -427,7 +580,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StartServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ReloadServiceRsp"},
 								},
 							},
 						},
@@ -435,17 +588,17 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/action/stop": &openapipb.OpenAPIPath{
+			"/api/v1/Service/{name}/action/restart": &openapipb.OpenAPIPath{
 				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "停止服务",
-					Description: "GpmService StopService",
-					OperationId: "GpmServiceStopService",
+					Summary:     "重启服务",
+					Description: "GpmService RestartService",
+					OperationId: "GpmServiceRestartService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "StopServiceReq field name",
+							Description: "RestartServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
@@ -454,11 +607,11 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 					RequestBody: &openapipb.PathRequestBody{
-						Description: "StopService StopServiceReq",
+						Description: "RestartService RestartServiceReq",
 						Content: &openapipb.PathRequestBodyContent{
 							ApplicationJson: &openapipb.ApplicationContent{
 								Schema: &openapipb.Schema{
-									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StopServiceReq",
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceReq",
 								},
 							},
 						},
@@ -468,7 +621,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StopServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceRsp"},
 								},
 							},
 						},
@@ -476,31 +629,31 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/forget": &openapipb.OpenAPIPath{
-				Delete: &openapipb.OpenAPIPathDocs{
+			"/api/v1/Service/{name}/action/resume": &openapipb.OpenAPIPath{
+				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "删除历史版本",
-					Description: "GpmService ForgetService",
-					OperationId: "GpmServiceForgetService",
+					Summary:     "恢复暂停的服务",
+					Description: "GpmService ResumeService",
+					OperationId: "GpmServiceResumeService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "ForgetServiceReq field name",
+							Description: "ResumeServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
 								Type: "string",
 							},
 						},
-						&openapipb.PathParameters{
-							Name:        "revision",
-							In:          "query",
-							Description: "ForgetServiceReq field revision",
-							Style:       "form",
-							Explode:     true,
-							Schema: &openapipb.Schema{
-								Type: "string",
+					},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "ResumeService ResumeServiceReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ResumeServiceReq",
+								},
 							},
 						},
 					},
@@ -509,7 +662,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ResumeServiceRsp"},
 								},
 							},
 						},
@@ -517,17 +670,17 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/rollback": &openapipb.OpenAPIPath{
-				Post: &openapipb.OpenAPIPathDocs{
+			"/api/v1/Service/{name}/action/run": &openapipb.OpenAPIPath{
+				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "回滚服务",
-					Description: "GpmService RollBackService",
-					OperationId: "GpmServiceRollBackService",
+					Summary:     "立即运行一次 oneshot 或 cron 服务",
+					Description: "GpmService RunService",
+					OperationId: "GpmServiceRunService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "RollbackServiceReq field name",
+							Description: "RunServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
@@ -536,11 +689,11 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 					RequestBody: &openapipb.PathRequestBody{
-						Description: "RollBackService RollbackServiceReq",
+						Description: "RunService RunServiceReq",
 						Content: &openapipb.PathRequestBodyContent{
 							ApplicationJson: &openapipb.ApplicationContent{
 								Schema: &openapipb.Schema{
-									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceReq",
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RunServiceReq",
 								},
 							},
 						},
@@ -550,7 +703,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RunServiceRsp"},
 								},
 							},
 						},
@@ -558,17 +711,17 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Service/{name}/versions": &openapipb.OpenAPIPath{
-				Get: &openapipb.OpenAPIPathDocs{
+			"/api/v1/Service/{name}/action/signal": &openapipb.OpenAPIPath{
+				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "查看服务历史版本",
-					Description: "GpmService ListServiceVersions",
-					OperationId: "GpmServiceListServiceVersions",
+					Summary:     "向服务进程发送信号",
+					Description: "GpmService SignalService",
+					OperationId: "GpmServiceSignalService",
 					Parameters: []*openapipb.PathParameters{
 						&openapipb.PathParameters{
 							Name:        "name",
 							In:          "path",
-							Description: "ListServiceVersionsReq field name",
+							Description: "SignalServiceReq field name",
 							Required:    true,
 							Explode:     true,
 							Schema: &openapipb.Schema{
@@ -576,12 +729,22 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							},
 						},
 					},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "SignalService SignalServiceReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.SignalServiceReq",
+								},
+							},
+						},
+					},
 					Responses: map[string]*openapipb.PathResponse{
 						"200": &openapipb.PathResponse{
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.SignalServiceRsp"},
 								},
 							},
 						},
@@ -589,19 +752,40 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/Services": &openapipb.OpenAPIPath{
-				Get: &openapipb.OpenAPIPathDocs{
+			"/api/v1/Service/{name}/action/start": &openapipb.OpenAPIPath{
+				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "查询所有服务",
-					Description: "GpmService ListService",
-					OperationId: "GpmServiceListService",
-					Parameters:  []*openapipb.PathParameters{},
+					Summary:     "启动服务",
+					Description: "GpmService StartService",
+					OperationId: "GpmServiceStartService",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "StartServiceReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "StartService StartServiceReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StartServiceReq",
+								},
+							},
+						},
+					},
 					Responses: map[string]*openapipb.PathResponse{
 						"200": &openapipb.PathResponse{
 							Description: "successful response (stream response)",
 							Content: &openapipb.PathRequestBodyContent{
 								ApplicationJson: &openapipb.ApplicationContent{
-									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListServiceRsp"},
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StartServiceRsp"},
 								},
 							},
 						},
@@ -609,13 +793,218 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					Security: []*openapipb.PathSecurity{},
 				},
 			},
-			"/api/v1/info": &openapipb.OpenAPIPath{
-				Get: &openapipb.OpenAPIPathDocs{
+			"/api/v1/Service/{name}/action/stop": &openapipb.OpenAPIPath{
+				Patch: &openapipb.OpenAPIPathDocs{
 					Tags:        []string{"GpmService"},
-					Summary:     "gpm 信息",
-					Description: "GpmService Info",
-					OperationId: "GpmServiceInfo",
-					Parameters:  []*openapipb.PathParameters{},
+					Summary:     "停止服务",
+					Description: "GpmService StopService",
+					OperationId: "GpmServiceStopService",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "StopServiceReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "StopService StopServiceReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StopServiceReq",
+								},
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.StopServiceRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Service/{name}/exits": &openapipb.OpenAPIPath{
+				Get: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "查看服务退出记录",
+					Description: "GpmService ListServiceExits",
+					OperationId: "GpmServiceListServiceExits",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "ListServiceExitsReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListServiceExitsRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Service/{name}/forget": &openapipb.OpenAPIPath{
+				Delete: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "删除历史版本",
+					Description: "GpmService ForgetService",
+					OperationId: "GpmServiceForgetService",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "ForgetServiceReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+						&openapipb.PathParameters{
+							Name:        "revision",
+							In:          "query",
+							Description: "ForgetServiceReq field revision",
+							Style:       "form",
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Service/{name}/rollback": &openapipb.OpenAPIPath{
+				Post: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "回滚服务",
+					Description: "GpmService RollBackService",
+					OperationId: "GpmServiceRollBackService",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "RollbackServiceReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					RequestBody: &openapipb.PathRequestBody{
+						Description: "RollBackService RollbackServiceReq",
+						Content: &openapipb.PathRequestBodyContent{
+							ApplicationJson: &openapipb.ApplicationContent{
+								Schema: &openapipb.Schema{
+									Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceReq",
+								},
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Service/{name}/versions": &openapipb.OpenAPIPath{
+				Get: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "查看服务历史版本",
+					Description: "GpmService ListServiceVersions",
+					OperationId: "GpmServiceListServiceVersions",
+					Parameters: []*openapipb.PathParameters{
+						&openapipb.PathParameters{
+							Name:        "name",
+							In:          "path",
+							Description: "ListServiceVersionsReq field name",
+							Required:    true,
+							Explode:     true,
+							Schema: &openapipb.Schema{
+								Type: "string",
+							},
+						},
+					},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/Services": &openapipb.OpenAPIPath{
+				Get: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "查询所有服务",
+					Description: "GpmService ListService",
+					OperationId: "GpmServiceListService",
+					Parameters:  []*openapipb.PathParameters{},
+					Responses: map[string]*openapipb.PathResponse{
+						"200": &openapipb.PathResponse{
+							Description: "successful response (stream response)",
+							Content: &openapipb.PathRequestBodyContent{
+								ApplicationJson: &openapipb.ApplicationContent{
+									Schema: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.service.gpm.v1.ListServiceRsp"},
+								},
+							},
+						},
+					},
+					Security: []*openapipb.PathSecurity{},
+				},
+			},
+			"/api/v1/info": &openapipb.OpenAPIPath{
+				Get: &openapipb.OpenAPIPathDocs{
+					Tags:        []string{"GpmService"},
+					Summary:     "gpm 信息",
+					Description: "GpmService Info",
+					OperationId: "GpmServiceInfo",
+					Parameters:  []*openapipb.PathParameters{},
 					Responses: map[string]*openapipb.PathResponse{
 						"200": &openapipb.PathResponse{
 							Description: "successful response (stream response)",
@@ -670,6 +1059,56 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.CreateSecretReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"spec": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SecretSpec",
+						},
+					},
+					Required: []string{"spec"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.CreateSecretRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"secret": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Secret",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.DeleteSecretReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.DeleteSecretRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"secret": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Secret",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListSecretsReq": &openapipb.Model{
+					Type:       "object",
+					Properties: map[string]*openapipb.Schema{},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListSecretsRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"secrets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Secret"},
+						},
+					},
+				},
 				"github.com.vine-io.gpm.api.service.gpm.v1.CreateServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
@@ -747,7 +1186,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.PauseServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
@@ -756,7 +1195,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					},
 					Required: []string{"name"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceRsp": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.PauseServiceRsp": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"service": &openapipb.Schema{
@@ -765,7 +1204,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.StartServiceReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.ReloadServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
@@ -774,7 +1213,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					},
 					Required: []string{"name"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.StartServiceRsp": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.ReloadServiceRsp": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"service": &openapipb.Schema{
@@ -783,7 +1222,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.StopServiceReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
@@ -792,7 +1231,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 					},
 					Required: []string{"name"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.StopServiceRsp": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.RestartServiceRsp": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"service": &openapipb.Schema{
@@ -801,48 +1240,162 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.ResumeServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
 							Type: "string",
 						},
-						"revision": &openapipb.Schema{
-							Type: "string",
-						},
 					},
 					Required: []string{"name"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceRsp": &openapipb.Model{
-					Type:       "object",
-					Properties: map[string]*openapipb.Schema{},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ResumeServiceRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"service": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Service",
+						},
+					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.RunServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
 							Type: "string",
 						},
-						"revision": &openapipb.Schema{
-							Type: "string",
-						},
 					},
 					Required: []string{"name"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceRsp": &openapipb.Model{
-					Type:       "object",
-					Properties: map[string]*openapipb.Schema{},
+				"github.com.vine-io.gpm.api.service.gpm.v1.RunServiceRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"service": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Service",
+						},
+					},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsReq": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.SignalServiceReq": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"name": &openapipb.Schema{
 							Type: "string",
 						},
+						"signal": &openapipb.Schema{
+							Type: "string",
+						},
 					},
-					Required: []string{"name"},
+					Required: []string{"name", "signal"},
 				},
-				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsRsp": &openapipb.Model{
+				"github.com.vine-io.gpm.api.service.gpm.v1.SignalServiceRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"service": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Service",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.StartServiceReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.StartServiceRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"service": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Service",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.StopServiceReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"dependents": &openapipb.Schema{
+							Type: "boolean",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.StopServiceRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"service": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Service",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceExitsReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceExitsRsp": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"exits": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.ServiceExit"},
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"revision": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ForgetServiceRsp": &openapipb.Model{
+					Type:       "object",
+					Properties: map[string]*openapipb.Schema{},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"revision": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.RollbackServiceRsp": &openapipb.Model{
+					Type:       "object",
+					Properties: map[string]*openapipb.Schema{},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsReq": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.service.gpm.v1.ListServiceVersionsRsp": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
 						"versions": &openapipb.Schema{
@@ -899,6 +1452,11 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						"group": &openapipb.Schema{
 							Type: "string",
 						},
+						"envMode": &openapipb.Schema{
+							Type:    "string",
+							Enum:    []string{"inherit", "clean"},
+							Default: "inherit",
+						},
 					},
 					Required: []string{"shell"},
 				},
@@ -930,6 +1488,37 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 					},
 				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.SecretSpec": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"value": &openapipb.Schema{},
+					},
+					Required: []string{"name", "value"},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Secret": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"size": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"creationTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"updateTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+					},
+					Required: []string{"name"},
+				},
 				"github.com.vine-io.gpm.api.types.gpm.v1.ServiceSpec": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
@@ -949,6 +1538,15 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						"env": &openapipb.Schema{
 							AdditionalProperties: &openapipb.Schema{},
 						},
+						"envMode": &openapipb.Schema{
+							Type:    "string",
+							Enum:    []string{"inherit", "clean"},
+							Default: "inherit",
+						},
+						"envFiles": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
 						"sysProcAttr": &openapipb.Schema{
 							Type: "object",
 							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SysProcAttr",
@@ -971,6 +1569,82 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Type:   "integer",
 							Format: "int32",
 						},
+						"restartPolicy": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.RestartPolicy",
+						},
+						"stopSignal": &openapipb.Schema{
+							Type: "string",
+						},
+						"stopTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"killMode": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"leader", "group"},
+						},
+						"livenessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"readinessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"dependsOn": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"replicas": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"resources": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Resources",
+						},
+						"hooks": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hooks",
+						},
+						"type": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"simple", "oneshot", "cron", "forking"},
+						},
+						"schedule": &openapipb.Schema{
+							Type: "string",
+						},
+						"concurrencyPolicy": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"allow", "forbid", "replace"},
+						},
+						"pidFile": &openapipb.Schema{
+							Type: "string",
+						},
+						"pidFileTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"reload": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Reload",
+						},
+						"sockets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Socket"},
+						},
+						"threshold": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Threshold",
+						},
+						"secrets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SecretRef"},
+						},
+						"labels": &openapipb.Schema{
+							AdditionalProperties: &openapipb.Schema{},
+						},
 					},
 					Required: []string{"name", "bin", "version"},
 				},
@@ -991,12 +1665,27 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Type:   "integer",
 							Format: "int64",
 						},
+						"pidCreateTime": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"pidExe": &openapipb.Schema{
+							Type: "string",
+						},
 						"dir": &openapipb.Schema{
 							Type: "string",
 						},
 						"env": &openapipb.Schema{
 							AdditionalProperties: &openapipb.Schema{},
 						},
+						"envMode": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"inherit", "clean"},
+						},
+						"envFiles": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
 						"sysProcAttr": &openapipb.Schema{
 							Type: "object",
 							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SysProcAttr",
@@ -1016,6 +1705,86 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Type:   "integer",
 							Format: "int32",
 						},
+						"restartPolicy": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.RestartPolicy",
+						},
+						"lastExit": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.ExitStatus",
+						},
+						"stopSignal": &openapipb.Schema{
+							Type: "string",
+						},
+						"stopTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"killMode": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"leader", "group"},
+						},
+						"livenessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"readinessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"dependsOn": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"replicas": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"resources": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Resources",
+						},
+						"hooks": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hooks",
+						},
+						"type": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"simple", "oneshot", "cron", "forking"},
+						},
+						"schedule": &openapipb.Schema{
+							Type: "string",
+						},
+						"concurrencyPolicy": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"allow", "forbid", "replace"},
+						},
+						"pidFile": &openapipb.Schema{
+							Type: "string",
+						},
+						"pidFileTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"reload": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Reload",
+						},
+						"sockets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Socket"},
+						},
+						"threshold": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Threshold",
+						},
+						"secrets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SecretRef"},
+						},
+						"labels": &openapipb.Schema{
+							AdditionalProperties: &openapipb.Schema{},
+						},
 						"creationTimestamp": &openapipb.Schema{
 							Type:   "integer",
 							Format: "int64",
@@ -1030,7 +1799,7 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						},
 						"status": &openapipb.Schema{
 							Type: "string",
-							Enum: []string{"init", "running", "stopped", "failed", "upgrading"},
+							Enum: []string{"init", "running", "stopped", "failed", "upgrading", "crashloop", "paused"},
 						},
 						"msg": &openapipb.Schema{
 							Type: "string",
@@ -1039,6 +1808,29 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Type: "object",
 							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Stat",
 						},
+						"health": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"unknown", "healthy", "unhealthy"},
+						},
+						"healthMsg": &openapipb.Schema{
+							Type: "string",
+						},
+						"instances": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Instance"},
+						},
+						"restarts": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"lastRunTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"nextRunTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
 					},
 					Required: []string{"name", "bin"},
 				},
@@ -1058,6 +1850,14 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						"env": &openapipb.Schema{
 							AdditionalProperties: &openapipb.Schema{},
 						},
+						"envMode": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"inherit", "clean"},
+						},
+						"envFiles": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
 						"sysProcAttr": &openapipb.Schema{
 							Type: "object",
 							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SysProcAttr",
@@ -1070,8 +1870,116 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 							Type:   "integer",
 							Format: "int32",
 						},
-					},
-				},
+						"restartPolicy": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.RestartPolicy",
+						},
+						"stopSignal": &openapipb.Schema{
+							Type: "string",
+						},
+						"stopTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"killMode": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"leader", "group"},
+						},
+						"livenessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"readinessProbe": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Probe",
+						},
+						"dependsOn": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"replicas": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"resources": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Resources",
+						},
+						"hooks": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hooks",
+						},
+						"type": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"simple", "oneshot", "cron", "forking"},
+						},
+						"schedule": &openapipb.Schema{
+							Type: "string",
+						},
+						"concurrencyPolicy": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"allow", "forbid", "replace"},
+						},
+						"pidFile": &openapipb.Schema{
+							Type: "string",
+						},
+						"pidFileTimeout": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"reload": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Reload",
+						},
+						"sockets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Socket"},
+						},
+						"threshold": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Threshold",
+						},
+						"secrets": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Ref: "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.SecretRef"},
+						},
+						"labels": &openapipb.Schema{
+							AdditionalProperties: &openapipb.Schema{},
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.ServiceExit": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"instance": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"status": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.ExitStatus",
+						},
+						"reason": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"exit", "crash", "stop", "upgrade", "oom", "threshold"},
+						},
+						"message": &openapipb.Schema{
+							Type: "string",
+						},
+						"restarts": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"logs": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"timestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+					},
+				},
 				"github.com.vine-io.gpm.api.types.gpm.v1.ServiceVersion": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
@@ -1081,90 +1989,447 @@ func NewGpmServiceOpenAPI() *openapipb.OpenAPI {
 						"version": &openapipb.Schema{
 							Type: "string",
 						},
-						"timestamp": &openapipb.Schema{
+						"timestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.GpmInfo": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"version": &openapipb.Schema{
+							Type: "string",
+						},
+						"goos": &openapipb.Schema{
+							Type: "string",
+						},
+						"arch": &openapipb.Schema{
+							Type: "string",
+						},
+						"gov": &openapipb.Schema{
+							Type: "string",
+						},
+						"pid": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"stat": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Stat",
+						},
+						"upTime": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"cgroup": &openapipb.Schema{
+							Type: "string",
+						},
+						"resources": &openapipb.Schema{
+							AdditionalProperties: &openapipb.Schema{},
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.SysProcAttr": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"chroot": &openapipb.Schema{
+							Type: "string",
+						},
+						"uid": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"user": &openapipb.Schema{
+							Type: "string",
+						},
+						"gid": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"group": &openapipb.Schema{
+							Type: "string",
+						},
+						"limitNofile": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Rlimit",
+						},
+						"limitNproc": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Rlimit",
+						},
+						"limitCore": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Rlimit",
+						},
+						"limitMemlock": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Rlimit",
+						},
+						"nice": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"ioniceClass": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"realtime", "best-effort", "idle"},
+						},
+						"ioniceLevel": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"cpuAffinity": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "integer"},
+						},
+						"oomScoreAdj": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.ProcLog": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"expire": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int32",
+							Default: "30",
+						},
+						"maxSize": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"interval": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"maxTotalSize": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"maxFiles": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.RestartPolicy": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"policy": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"always", "onFailure", "never"},
+						},
+						"initialBackoff": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"maxBackoff": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"maxRetries": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int32",
+							Default: "5",
+						},
+						"window": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Probe": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"type": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"http", "tcp", "exec"},
+						},
+						"url": &openapipb.Schema{
+							Type: "string",
+						},
+						"expectStatus": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"address": &openapipb.Schema{
+							Type: "string",
+						},
+						"command": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"initialDelay": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"interval": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "10",
+						},
+						"timeout": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "1",
+						},
+						"failureThreshold": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int32",
+							Default: "3",
+						},
+					},
+					Required: []string{"type"},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Resources": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"memory": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"cpu": &openapipb.Schema{
+							Type:   "number",
+							Format: "double",
+						},
+						"pids": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"ioWeight": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Hooks": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"preStart": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hook",
+						},
+						"postStart": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hook",
+						},
+						"preStop": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hook",
+						},
+						"postStop": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Hook",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Reload": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"signal": &openapipb.Schema{
+							Type: "string",
+						},
+						"command": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"timeout": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "30",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Socket": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"address": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"address"},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Threshold": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"memory": &openapipb.Schema{},
+						"memPercent": &openapipb.Schema{
+							Type:   "number",
+							Format: "float",
+						},
+						"cpuPercent": &openapipb.Schema{
+							Type:   "number",
+							Format: "double",
+						},
+						"cpuWindow": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "60",
+						},
+						"interval": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "10",
+						},
+						"action": &openapipb.Schema{
+							Type:    "string",
+							Enum:    []string{"restart", "signal", "event"},
+							Default: "event",
+						},
+						"signal": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.SecretRef": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"name": &openapipb.Schema{
+							Type: "string",
+						},
+						"env": &openapipb.Schema{
+							Type: "string",
+						},
+						"file": &openapipb.Schema{
+							Type: "string",
+						},
+					},
+					Required: []string{"name"},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.ExitStatus": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"pid": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"code": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"signal": &openapipb.Schema{
+							Type: "string",
+						},
+						"startTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"exitTimestamp": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Stat": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"cpuPercent": &openapipb.Schema{
+							Type:   "number",
+							Format: "double",
+						},
+						"memory": &openapipb.Schema{},
+						"memPercent": &openapipb.Schema{
+							Type:   "number",
+							Format: "float",
+						},
+					},
+				},
+				"github.com.vine-io.gpm.api.types.gpm.v1.Instance": &openapipb.Model{
+					Type: "object",
+					Properties: map[string]*openapipb.Schema{
+						"index": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int32",
+						},
+						"pid": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
+						},
+						"startTimestamp": &openapipb.Schema{
 							Type:   "integer",
 							Format: "int64",
 						},
-					},
-				},
-				"github.com.vine-io.gpm.api.types.gpm.v1.GpmInfo": &openapipb.Model{
-					Type: "object",
-					Properties: map[string]*openapipb.Schema{
-						"version": &openapipb.Schema{
+						"status": &openapipb.Schema{
 							Type: "string",
 						},
-						"goos": &openapipb.Schema{
+						"msg": &openapipb.Schema{
 							Type: "string",
 						},
-						"arch": &openapipb.Schema{
+						"health": &openapipb.Schema{
 							Type: "string",
 						},
-						"gov": &openapipb.Schema{
+						"healthMsg": &openapipb.Schema{
 							Type: "string",
 						},
-						"pid": &openapipb.Schema{
-							Type:   "integer",
-							Format: "int32",
+						"lastExit": &openapipb.Schema{
+							Type: "object",
+							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.ExitStatus",
 						},
 						"stat": &openapipb.Schema{
 							Type: "object",
 							Ref:  "#/components/schemas/github.com.vine-io.gpm.api.types.gpm.v1.Stat",
 						},
-						"upTime": &openapipb.Schema{
-							Type:   "integer",
-							Format: "int64",
-						},
-					},
-				},
-				"github.com.vine-io.gpm.api.types.gpm.v1.SysProcAttr": &openapipb.Model{
-					Type: "object",
-					Properties: map[string]*openapipb.Schema{
-						"chroot": &openapipb.Schema{
-							Type: "string",
-						},
-						"uid": &openapipb.Schema{
+						"restarts": &openapipb.Schema{
 							Type:   "integer",
 							Format: "int32",
 						},
-						"user": &openapipb.Schema{
-							Type: "string",
-						},
-						"gid": &openapipb.Schema{
+						"pidCreateTime": &openapipb.Schema{
 							Type:   "integer",
-							Format: "int32",
+							Format: "int64",
 						},
-						"group": &openapipb.Schema{
+						"pidExe": &openapipb.Schema{
 							Type: "string",
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.types.gpm.v1.ProcLog": &openapipb.Model{
+				"github.com.vine-io.gpm.api.types.gpm.v1.Rlimit": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
-						"expire": &openapipb.Schema{
-							Type:    "integer",
-							Format:  "int32",
-							Default: "30",
+						"soft": &openapipb.Schema{
+							Type:   "integer",
+							Format: "int64",
 						},
-						"maxSize": &openapipb.Schema{
+						"hard": &openapipb.Schema{
 							Type:   "integer",
 							Format: "int64",
 						},
 					},
 				},
-				"github.com.vine-io.gpm.api.types.gpm.v1.Stat": &openapipb.Model{
+				"github.com.vine-io.gpm.api.types.gpm.v1.Hook": &openapipb.Model{
 					Type: "object",
 					Properties: map[string]*openapipb.Schema{
-						"cpuPercent": &openapipb.Schema{
-							Type:   "number",
-							Format: "double",
+						"type": &openapipb.Schema{
+							Type: "string",
+							Enum: []string{"exec", "http"},
 						},
-						"memory": &openapipb.Schema{},
-						"memPercent": &openapipb.Schema{
-							Type:   "number",
-							Format: "float",
+						"command": &openapipb.Schema{
+							Type:  "array",
+							Items: &openapipb.Schema{Type: "string"},
+						},
+						"url": &openapipb.Schema{
+							Type: "string",
+						},
+						"method": &openapipb.Schema{
+							Type:    "string",
+							Default: "GET",
+						},
+						"timeout": &openapipb.Schema{
+							Type:    "integer",
+							Format:  "int64",
+							Default: "30",
+						},
+						"onFailure": &openapipb.Schema{
+							Type:    "string",
+							Enum:    []string{"abort", "continue"},
+							Default: "abort",
 						},
 					},
+					Required: []string{"type"},
 				},
 			},
 		},
@@ -1202,16 +2467,36 @@ type GpmService interface {
 	// +gen:summary=重启服务
 	// +gen:patch=/api/v1/Service/{name}/action/restart
 	RestartService(ctx context.Context, in *RestartServiceReq, opts ...client.CallOption) (*RestartServiceRsp, error)
+	// +gen:summary=立即运行一次 oneshot 或 cron 服务
+	// +gen:patch=/api/v1/Service/{name}/action/run
+	RunService(ctx context.Context, in *RunServiceReq, opts ...client.CallOption) (*RunServiceRsp, error)
+	// +gen:summary=向服务进程发送信号
+	// +gen:patch=/api/v1/Service/{name}/action/signal
+	SignalService(ctx context.Context, in *SignalServiceReq, opts ...client.CallOption) (*SignalServiceRsp, error)
+	// +gen:summary=重新加载服务, 不重启服务进程
+	// +gen:patch=/api/v1/Service/{name}/action/reload
+	ReloadService(ctx context.Context, in *ReloadServiceReq, opts ...client.CallOption) (*ReloadServiceRsp, error)
+	// +gen:summary=暂停服务, 保留进程的内存状态
+	// +gen:patch=/api/v1/Service/{name}/action/pause
+	PauseService(ctx context.Context, in *PauseServiceReq, opts ...client.CallOption) (*PauseServiceRsp, error)
+	// +gen:summary=恢复暂停的服务
+	// +gen:patch=/api/v1/Service/{name}/action/resume
+	ResumeService(ctx context.Context, in *ResumeServiceReq, opts ...client.CallOption) (*ResumeServiceRsp, error)
 	// +gen:summary=删除服务
 	// +gen:delete=/api/v1/Service/{name}
 	DeleteService(ctx context.Context, in *DeleteServiceReq, opts ...client.CallOption) (*DeleteServiceRsp, error)
 	// 动态监听服务日志
 	WatchServiceLog(ctx context.Context, in *WatchServiceLogReq, opts ...client.CallOption) (GpmService_WatchServiceLogService, error)
+	// 同时监听多个服务的日志, 按照写入时间合并
+	WatchServicesLog(ctx context.Context, in *WatchServicesLogReq, opts ...client.CallOption) (GpmService_WatchServicesLogService, error)
 	// 远程安装服务
 	InstallService(ctx context.Context, opts ...client.CallOption) (GpmService_InstallServiceService, error)
 	// +gen:summary=查看服务历史版本
 	// +gen:get=/api/v1/Service/{name}/versions
 	ListServiceVersions(ctx context.Context, in *ListServiceVersionsReq, opts ...client.CallOption) (*ListServiceVersionsRsp, error)
+	// +gen:summary=查看服务退出记录
+	// +gen:get=/api/v1/Service/{name}/exits
+	ListServiceExits(ctx context.Context, in *ListServiceExitsReq, opts ...client.CallOption) (*ListServiceExitsRsp, error)
 	// 升级服务
 	UpgradeService(ctx context.Context, opts ...client.CallOption) (GpmService_UpgradeServiceService, error)
 	// +gen:summary=回滚服务
@@ -1220,6 +2505,15 @@ type GpmService interface {
 	// +gen:summary=删除历史版本
 	// +gen:delete=/api/v1/Service/{name}/forget
 	ForgetService(ctx context.Context, in *ForgetServiceReq, opts ...client.CallOption) (*ForgetServiceRsp, error)
+	// +gen:summary=创建密钥, 密钥已经存在时更新密钥的值
+	// +gen:post=/api/v1/Secret
+	CreateSecret(ctx context.Context, in *CreateSecretReq, opts ...client.CallOption) (*CreateSecretRsp, error)
+	// +gen:summary=查询所有密钥, 不返回密钥的值
+	// +gen:get=/api/v1/Secrets
+	ListSecrets(ctx context.Context, in *ListSecretsReq, opts ...client.CallOption) (*ListSecretsRsp, error)
+	// +gen:summary=删除密钥
+	// +gen:delete=/api/v1/Secret/{name}
+	DeleteSecret(ctx context.Context, in *DeleteSecretReq, opts ...client.CallOption) (*DeleteSecretRsp, error)
 	// +gen:summary=获取目录信息下文件列表
 	// +gen:get=/api/v1/Action/ls
 	Ls(ctx context.Context, in *LsReq, opts ...client.CallOption) (*LsRsp, error)
@@ -1387,6 +2681,56 @@ func (c *gpmService) RestartService(ctx context.Context, in *RestartServiceReq,
 	return out, nil
 }
 
+func (c *gpmService) RunService(ctx context.Context, in *RunServiceReq, opts ...client.CallOption) (*RunServiceRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.RunService", in)
+	out := new(RunServiceRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) SignalService(ctx context.Context, in *SignalServiceReq, opts ...client.CallOption) (*SignalServiceRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.SignalService", in)
+	out := new(SignalServiceRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) ReloadService(ctx context.Context, in *ReloadServiceReq, opts ...client.CallOption) (*ReloadServiceRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.ReloadService", in)
+	out := new(ReloadServiceRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) PauseService(ctx context.Context, in *PauseServiceReq, opts ...client.CallOption) (*PauseServiceRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.PauseService", in)
+	out := new(PauseServiceRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) ResumeService(ctx context.Context, in *ResumeServiceReq, opts ...client.CallOption) (*ResumeServiceRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.ResumeService", in)
+	out := new(ResumeServiceRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmService) DeleteService(ctx context.Context, in *DeleteServiceReq, opts ...client.CallOption) (*DeleteServiceRsp, error) {
 	req := c.c.NewRequest(c.name, "GpmService.DeleteService", in)
 	out := new(DeleteServiceRsp)
@@ -1446,6 +2790,55 @@ func (x *gpmServiceWatchServiceLog) Recv() (*WatchServiceLogRsp, error) {
 	return m, nil
 }
 
+func (c *gpmService) WatchServicesLog(ctx context.Context, in *WatchServicesLogReq, opts ...client.CallOption) (GpmService_WatchServicesLogService, error) {
+	req := c.c.NewRequest(c.name, "GpmService.WatchServicesLog", &WatchServicesLogReq{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &gpmServiceWatchServicesLog{stream}, nil
+}
+
+type GpmService_WatchServicesLogService interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*WatchServicesLogRsp, error)
+}
+
+type gpmServiceWatchServicesLog struct {
+	stream client.Stream
+}
+
+func (x *gpmServiceWatchServicesLog) Close() error {
+	return x.stream.Close()
+}
+
+func (x *gpmServiceWatchServicesLog) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *gpmServiceWatchServicesLog) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *gpmServiceWatchServicesLog) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *gpmServiceWatchServicesLog) Recv() (*WatchServicesLogRsp, error) {
+	m := new(WatchServicesLogRsp)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gpmService) InstallService(ctx context.Context, opts ...client.CallOption) (GpmService_InstallServiceService, error) {
 	req := c.c.NewRequest(c.name, "GpmService.InstallService", &InstallServiceReq{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -1507,6 +2900,16 @@ func (c *gpmService) ListServiceVersions(ctx context.Context, in *ListServiceVer
 	return out, nil
 }
 
+func (c *gpmService) ListServiceExits(ctx context.Context, in *ListServiceExitsReq, opts ...client.CallOption) (*ListServiceExitsRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.ListServiceExits", in)
+	out := new(ListServiceExitsRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmService) UpgradeService(ctx context.Context, opts ...client.CallOption) (GpmService_UpgradeServiceService, error) {
 	req := c.c.NewRequest(c.name, "GpmService.UpgradeService", &UpgradeServiceReq{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -1578,6 +2981,36 @@ func (c *gpmService) ForgetService(ctx context.Context, in *ForgetServiceReq, op
 	return out, nil
 }
 
+func (c *gpmService) CreateSecret(ctx context.Context, in *CreateSecretReq, opts ...client.CallOption) (*CreateSecretRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.CreateSecret", in)
+	out := new(CreateSecretRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) ListSecrets(ctx context.Context, in *ListSecretsReq, opts ...client.CallOption) (*ListSecretsRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.ListSecrets", in)
+	out := new(ListSecretsRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gpmService) DeleteSecret(ctx context.Context, in *DeleteSecretReq, opts ...client.CallOption) (*DeleteSecretRsp, error) {
+	req := c.c.NewRequest(c.name, "GpmService.DeleteSecret", in)
+	out := new(DeleteSecretRsp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gpmService) Ls(ctx context.Context, in *LsReq, opts ...client.CallOption) (*LsRsp, error) {
 	req := c.c.NewRequest(c.name, "GpmService.Ls", in)
 	out := new(LsRsp)
@@ -1780,16 +3213,36 @@ type GpmServiceHandler interface {
 	// +gen:summary=重启服务
 	// +gen:patch=/api/v1/Service/{name}/action/restart
 	RestartService(context.Context, *RestartServiceReq, *RestartServiceRsp) error
+	// +gen:summary=立即运行一次 oneshot 或 cron 服务
+	// +gen:patch=/api/v1/Service/{name}/action/run
+	RunService(context.Context, *RunServiceReq, *RunServiceRsp) error
+	// +gen:summary=向服务进程发送信号
+	// +gen:patch=/api/v1/Service/{name}/action/signal
+	SignalService(context.Context, *SignalServiceReq, *SignalServiceRsp) error
+	// +gen:summary=重新加载服务, 不重启服务进程
+	// +gen:patch=/api/v1/Service/{name}/action/reload
+	ReloadService(context.Context, *ReloadServiceReq, *ReloadServiceRsp) error
+	// +gen:summary=暂停服务, 保留进程的内存状态
+	// +gen:patch=/api/v1/Service/{name}/action/pause
+	PauseService(context.Context, *PauseServiceReq, *PauseServiceRsp) error
+	// +gen:summary=恢复暂停的服务
+	// +gen:patch=/api/v1/Service/{name}/action/resume
+	ResumeService(context.Context, *ResumeServiceReq, *ResumeServiceRsp) error
 	// +gen:summary=删除服务
 	// +gen:delete=/api/v1/Service/{name}
 	DeleteService(context.Context, *DeleteServiceReq, *DeleteServiceRsp) error
 	// 动态监听服务日志
 	WatchServiceLog(context.Context, *WatchServiceLogReq, GpmService_WatchServiceLogStream) error
+	// 同时监听多个服务的日志, 按照写入时间合并
+	WatchServicesLog(context.Context, *WatchServicesLogReq, GpmService_WatchServicesLogStream) error
 	// 远程安装服务
 	InstallService(context.Context, GpmService_InstallServiceStream) error
 	// +gen:summary=查看服务历史版本
 	// +gen:get=/api/v1/Service/{name}/versions
 	ListServiceVersions(context.Context, *ListServiceVersionsReq, *ListServiceVersionsRsp) error
+	// +gen:summary=查看服务退出记录
+	// +gen:get=/api/v1/Service/{name}/exits
+	ListServiceExits(context.Context, *ListServiceExitsReq, *ListServiceExitsRsp) error
 	// 升级服务
 	UpgradeService(context.Context, GpmService_UpgradeServiceStream) error
 	// +gen:summary=回滚服务
@@ -1798,6 +3251,15 @@ type GpmServiceHandler interface {
 	// +gen:summary=删除历史版本
 	// +gen:delete=/api/v1/Service/{name}/forget
 	ForgetService(context.Context, *ForgetServiceReq, *ForgetServiceRsp) error
+	// +gen:summary=创建密钥, 密钥已经存在时更新密钥的值
+	// +gen:post=/api/v1/Secret
+	CreateSecret(context.Context, *CreateSecretReq, *CreateSecretRsp) error
+	// +gen:summary=查询所有密钥, 不返回密钥的值
+	// +gen:get=/api/v1/Secrets
+	ListSecrets(context.Context, *ListSecretsReq, *ListSecretsRsp) error
+	// +gen:summary=删除密钥
+	// +gen:delete=/api/v1/Secret/{name}
+	DeleteSecret(context.Context, *DeleteSecretReq, *DeleteSecretRsp) error
 	// +gen:summary=获取目录信息下文件列表
 	// +gen:get=/api/v1/Action/ls
 	Ls(context.Context, *LsReq, *LsRsp) error
@@ -1824,13 +3286,23 @@ func RegisterGpmServiceHandler(s server.Server, hdlr GpmServiceHandler, opts ...
 		StartService(ctx context.Context, in *StartServiceReq, out *StartServiceRsp) error
 		StopService(ctx context.Context, in *StopServiceReq, out *StopServiceRsp) error
 		RestartService(ctx context.Context, in *RestartServiceReq, out *RestartServiceRsp) error
+		RunService(ctx context.Context, in *RunServiceReq, out *RunServiceRsp) error
+		SignalService(ctx context.Context, in *SignalServiceReq, out *SignalServiceRsp) error
+		ReloadService(ctx context.Context, in *ReloadServiceReq, out *ReloadServiceRsp) error
+		PauseService(ctx context.Context, in *PauseServiceReq, out *PauseServiceRsp) error
+		ResumeService(ctx context.Context, in *ResumeServiceReq, out *ResumeServiceRsp) error
 		DeleteService(ctx context.Context, in *DeleteServiceReq, out *DeleteServiceRsp) error
 		WatchServiceLog(ctx context.Context, stream server.Stream) error
+		WatchServicesLog(ctx context.Context, stream server.Stream) error
 		InstallService(ctx context.Context, stream server.Stream) error
 		ListServiceVersions(ctx context.Context, in *ListServiceVersionsReq, out *ListServiceVersionsRsp) error
+		ListServiceExits(ctx context.Context, in *ListServiceExitsReq, out *ListServiceExitsRsp) error
 		UpgradeService(ctx context.Context, stream server.Stream) error
 		RollBackService(ctx context.Context, in *RollbackServiceReq, out *RollbackServiceRsp) error
 		ForgetService(ctx context.Context, in *ForgetServiceReq, out *ForgetServiceRsp) error
+		CreateSecret(ctx context.Context, in *CreateSecretReq, out *CreateSecretRsp) error
+		ListSecrets(ctx context.Context, in *ListSecretsReq, out *ListSecretsRsp) error
+		DeleteSecret(ctx context.Context, in *DeleteSecretReq, out *DeleteSecretRsp) error
 		Ls(ctx context.Context, in *LsReq, out *LsRsp) error
 		Pull(ctx context.Context, stream server.Stream) error
 		Push(ctx context.Context, stream server.Stream) error
@@ -1905,6 +3377,46 @@ func RegisterGpmServiceHandler(s server.Server, hdlr GpmServiceHandler, opts ...
 		Body:        "*",
 		Handler:     "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.RunService",
+		Description: "GpmService.RunService",
+		Path:        []string{"/api/v1/Service/{name}/action/run"},
+		Method:      []string{"PATCH"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.SignalService",
+		Description: "GpmService.SignalService",
+		Path:        []string{"/api/v1/Service/{name}/action/signal"},
+		Method:      []string{"PATCH"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.ReloadService",
+		Description: "GpmService.ReloadService",
+		Path:        []string{"/api/v1/Service/{name}/action/reload"},
+		Method:      []string{"PATCH"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.PauseService",
+		Description: "GpmService.PauseService",
+		Path:        []string{"/api/v1/Service/{name}/action/pause"},
+		Method:      []string{"PATCH"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.ResumeService",
+		Description: "GpmService.ResumeService",
+		Path:        []string{"/api/v1/Service/{name}/action/resume"},
+		Method:      []string{"PATCH"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:        "GpmService.DeleteService",
 		Description: "GpmService.DeleteService",
@@ -1921,6 +3433,14 @@ func RegisterGpmServiceHandler(s server.Server, hdlr GpmServiceHandler, opts ...
 		Body:        "*",
 		Handler:     "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.ListServiceExits",
+		Description: "GpmService.ListServiceExits",
+		Path:        []string{"/api/v1/Service/{name}/exits"},
+		Method:      []string{"GET"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:        "GpmService.RollBackService",
 		Description: "GpmService.RollBackService",
@@ -1937,6 +3457,30 @@ func RegisterGpmServiceHandler(s server.Server, hdlr GpmServiceHandler, opts ...
 		Body:        "*",
 		Handler:     "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.CreateSecret",
+		Description: "GpmService.CreateSecret",
+		Path:        []string{"/api/v1/Secret"},
+		Method:      []string{"POST"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.ListSecrets",
+		Description: "GpmService.ListSecrets",
+		Path:        []string{"/api/v1/Secrets"},
+		Method:      []string{"GET"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:        "GpmService.DeleteSecret",
+		Description: "GpmService.DeleteSecret",
+		Path:        []string{"/api/v1/Secret/{name}"},
+		Method:      []string{"DELETE"},
+		Body:        "*",
+		Handler:     "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:        "GpmService.Ls",
 		Description: "GpmService.Ls",
@@ -2042,6 +3586,26 @@ func (h *gpmServiceHandler) RestartService(ctx context.Context, in *RestartServi
 	return h.GpmServiceHandler.RestartService(ctx, in, out)
 }
 
+func (h *gpmServiceHandler) RunService(ctx context.Context, in *RunServiceReq, out *RunServiceRsp) error {
+	return h.GpmServiceHandler.RunService(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) SignalService(ctx context.Context, in *SignalServiceReq, out *SignalServiceRsp) error {
+	return h.GpmServiceHandler.SignalService(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) ReloadService(ctx context.Context, in *ReloadServiceReq, out *ReloadServiceRsp) error {
+	return h.GpmServiceHandler.ReloadService(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) PauseService(ctx context.Context, in *PauseServiceReq, out *PauseServiceRsp) error {
+	return h.GpmServiceHandler.PauseService(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) ResumeService(ctx context.Context, in *ResumeServiceReq, out *ResumeServiceRsp) error {
+	return h.GpmServiceHandler.ResumeService(ctx, in, out)
+}
+
 func (h *gpmServiceHandler) DeleteService(ctx context.Context, in *DeleteServiceReq, out *DeleteServiceRsp) error {
 	return h.GpmServiceHandler.DeleteService(ctx, in, out)
 }
@@ -2086,6 +3650,46 @@ func (x *gpmServiceWatchServiceLogStream) Send(m *WatchServiceLogRsp) error {
 	return x.stream.Send(m)
 }
 
+func (h *gpmServiceHandler) WatchServicesLog(ctx context.Context, stream server.Stream) error {
+	m := new(WatchServicesLogReq)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.GpmServiceHandler.WatchServicesLog(ctx, m, &gpmServiceWatchServicesLogStream{stream})
+}
+
+type GpmService_WatchServicesLogStream interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*WatchServicesLogRsp) error
+}
+
+type gpmServiceWatchServicesLogStream struct {
+	stream server.Stream
+}
+
+func (x *gpmServiceWatchServicesLogStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *gpmServiceWatchServicesLogStream) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *gpmServiceWatchServicesLogStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *gpmServiceWatchServicesLogStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *gpmServiceWatchServicesLogStream) Send(m *WatchServicesLogRsp) error {
+	return x.stream.Send(m)
+}
+
 func (h *gpmServiceHandler) InstallService(ctx context.Context, stream server.Stream) error {
 	return h.GpmServiceHandler.InstallService(ctx, &gpmServiceInstallServiceStream{stream})
 }
@@ -2135,6 +3739,10 @@ func (h *gpmServiceHandler) ListServiceVersions(ctx context.Context, in *ListSer
 	return h.GpmServiceHandler.ListServiceVersions(ctx, in, out)
 }
 
+func (h *gpmServiceHandler) ListServiceExits(ctx context.Context, in *ListServiceExitsReq, out *ListServiceExitsRsp) error {
+	return h.GpmServiceHandler.ListServiceExits(ctx, in, out)
+}
+
 func (h *gpmServiceHandler) UpgradeService(ctx context.Context, stream server.Stream) error {
 	return h.GpmServiceHandler.UpgradeService(ctx, &gpmServiceUpgradeServiceStream{stream})
 }
@@ -2188,6 +3796,18 @@ func (h *gpmServiceHandler) ForgetService(ctx context.Context, in *ForgetService
 	return h.GpmServiceHandler.ForgetService(ctx, in, out)
 }
 
+func (h *gpmServiceHandler) CreateSecret(ctx context.Context, in *CreateSecretReq, out *CreateSecretRsp) error {
+	return h.GpmServiceHandler.CreateSecret(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) ListSecrets(ctx context.Context, in *ListSecretsReq, out *ListSecretsRsp) error {
+	return h.GpmServiceHandler.ListSecrets(ctx, in, out)
+}
+
+func (h *gpmServiceHandler) DeleteSecret(ctx context.Context, in *DeleteSecretReq, out *DeleteSecretRsp) error {
+	return h.GpmServiceHandler.DeleteSecret(ctx, in, out)
+}
+
 func (h *gpmServiceHandler) Ls(ctx context.Context, in *LsReq, out *LsRsp) error {
 	return h.GpmServiceHandler.Ls(ctx, in, out)
 }
@@ -28,4 +28,75 @@ const (
 	StatusStopped   string = "stopped"   // 进程停止
 	StatusFailed    string = "failed"    // 进程执行失败
 	StatusUpgrading string = "upgrading" // 进程升级中
+	StatusCrashLoop string = "crashloop" // 进程频繁崩溃, 停止重启
+	StatusPaused    string = "paused"    // 进程被暂停
+)
+
+const (
+	RestartAlways    string = "always"    // 进程退出后总是重启
+	RestartOnFailure string = "onFailure" // 进程异常退出时重启
+	RestartNever     string = "never"     // 进程退出后不重启
+)
+
+const (
+	ServiceSimple  string = "simple"  // 常驻服务
+	ServiceOneshot string = "oneshot" // 启动后运行一次
+	ServiceCron    string = "cron"    // 按照 cron 表达式定时运行
+	ServiceForking string = "forking" // 启动后 fork 出守护进程
+)
+
+const (
+	ConcurrencyAllow   string = "allow"   // 上一次运行未结束时同时运行
+	ConcurrencyForbid  string = "forbid"  // 上一次运行未结束时跳过本次运行
+	ConcurrencyReplace string = "replace" // 上一次运行未结束时停止上一次运行
+)
+
+const (
+	KillModeLeader string = "leader" // 停止服务时只向主进程发送信号
+	KillModeGroup  string = "group"  // 停止服务时向整个进程组发送信号
+)
+
+const (
+	EnvInherit string = "inherit" // 继承 gpmd 的环境变量
+	EnvClean   string = "clean"   // 不继承 gpmd 的环境变量
+)
+
+const (
+	IoniceRealtime   string = "realtime"    // 实时 io 调度
+	IoniceBestEffort string = "best-effort" // 默认的 io 调度
+	IoniceIdle       string = "idle"        // 系统空闲时才进行 io
+)
+
+const (
+	HookExec     string = "exec"     // 执行命令
+	HookHTTP     string = "http"     // 发送 HTTP 请求
+	HookAbort    string = "abort"    // 钩子失败时中止启动或停止服务
+	HookContinue string = "continue" // 钩子失败时继续
+)
+
+const (
+	ExitNormal    string = "exit"      // 进程正常退出
+	ExitCrash     string = "crash"     // 进程异常退出
+	ExitStop      string = "stop"      // 被 gpmd 停止
+	ExitUpgrade   string = "upgrade"   // 升级或回滚服务时停止
+	ExitOOM       string = "oom"       // 超出内存限制被结束
+	ExitThreshold string = "threshold" // 资源占用超过阈值
+)
+
+const (
+	ThresholdRestart string = "restart" // 重启进程
+	ThresholdSignal  string = "signal"  // 向进程发送信号
+	ThresholdEvent   string = "event"   // 只记录事件
+)
+
+const (
+	HealthUnknown   string = "unknown"   // 还未得到探测结果
+	HealthHealthy   string = "healthy"   // 探测成功
+	HealthUnhealthy string = "unhealthy" // 探测连续失败
+)
+
+const (
+	ProbeHTTP string = "http" // 发送 HTTP GET 请求
+	ProbeTCP  string = "tcp"  // 建立 TCP 连接
+	ProbeExec string = "exec" // 执行命令
 )
@@ -20,6 +20,11 @@ func (in *Service) DeepCopyInto(out *Service) {
 			(*out)[key] = val
 		}
 	}
+	if in.EnvFiles != nil {
+		in, out := &in.EnvFiles, &out.EnvFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SysProcAttr != nil {
 		in, out := &in.SysProcAttr, &out.SysProcAttr
 		*out = new(SysProcAttr)
@@ -30,6 +35,106 @@ func (in *Service) DeepCopyInto(out *Service) {
 		*out = new(ProcLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(RestartPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastExit != nil {
+		in, out := &in.LastExit, &out.LastExit
+		*out = new(ExitStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(Resources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(Hooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reload != nil {
+		in, out := &in.Reload, &out.Reload
+		*out = new(Reload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sockets != nil {
+		in, out := &in.Sockets, &out.Sockets
+		*out = make([]*Socket, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Socket)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(Threshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]*SecretRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecretRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(Stat)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]*Instance, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Instance)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	if in.LastExit != nil {
+		in, out := &in.LastExit, &out.LastExit
+		*out = new(ExitStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Stat != nil {
 		in, out := &in.Stat, &out.Stat
 		*out = new(Stat)
@@ -40,6 +145,36 @@ func (in *Service) DeepCopyInto(out *Service) {
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
 func (in *SysProcAttr) DeepCopyInto(out *SysProcAttr) {
 	*out = *in
+	if in.LimitNofile != nil {
+		in, out := &in.LimitNofile, &out.LimitNofile
+		*out = new(Rlimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitNproc != nil {
+		in, out := &in.LimitNproc, &out.LimitNproc
+		*out = new(Rlimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitCore != nil {
+		in, out := &in.LimitCore, &out.LimitCore
+		*out = new(Rlimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitMemlock != nil {
+		in, out := &in.LimitMemlock, &out.LimitMemlock
+		*out = new(Rlimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CpuAffinity != nil {
+		in, out := &in.CpuAffinity, &out.CpuAffinity
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Rlimit) DeepCopyInto(out *Rlimit) {
+	*out = *in
 }
 
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
@@ -57,6 +192,11 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EnvFiles != nil {
+		in, out := &in.EnvFiles, &out.EnvFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SysProcAttr != nil {
 		in, out := &in.SysProcAttr, &out.SysProcAttr
 		*out = new(SysProcAttr)
@@ -67,6 +207,75 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 		*out = new(ProcLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(RestartPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(Resources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(Hooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reload != nil {
+		in, out := &in.Reload, &out.Reload
+		*out = new(Reload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sockets != nil {
+		in, out := &in.Sockets, &out.Sockets
+		*out = make([]*Socket, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Socket)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(Threshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]*SecretRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecretRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
@@ -89,6 +298,11 @@ func (in *EditServiceSpec) DeepCopyInto(out *EditServiceSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EnvFiles != nil {
+		in, out := &in.EnvFiles, &out.EnvFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SysProcAttr != nil {
 		in, out := &in.SysProcAttr, &out.SysProcAttr
 		*out = new(SysProcAttr)
@@ -99,6 +313,185 @@ func (in *EditServiceSpec) DeepCopyInto(out *EditServiceSpec) {
 		*out = new(ProcLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(RestartPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(Resources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(Hooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reload != nil {
+		in, out := &in.Reload, &out.Reload
+		*out = new(Reload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sockets != nil {
+		in, out := &in.Sockets, &out.Sockets
+		*out = make([]*Socket, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Socket)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(Threshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]*SecretRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecretRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *RestartPolicy) DeepCopyInto(out *RestartPolicy) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Probe) DeepCopyInto(out *Probe) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Hooks) DeepCopyInto(out *Hooks) {
+	*out = *in
+	if in.PreStart != nil {
+		in, out := &in.PreStart, &out.PreStart
+		*out = new(Hook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostStart != nil {
+		in, out := &in.PostStart, &out.PostStart
+		*out = new(Hook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreStop != nil {
+		in, out := &in.PreStop, &out.PreStop
+		*out = new(Hook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostStop != nil {
+		in, out := &in.PostStop, &out.PostStop
+		*out = new(Hook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Socket) DeepCopyInto(out *Socket) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Reload) DeepCopyInto(out *Reload) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Secret) DeepCopyInto(out *Secret) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *SecretSpec) DeepCopyInto(out *SecretSpec) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Threshold) DeepCopyInto(out *Threshold) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Resources) DeepCopyInto(out *Resources) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *ExitStatus) DeepCopyInto(out *ExitStatus) {
+	*out = *in
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *ServiceExit) DeepCopyInto(out *ServiceExit) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ExitStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
@@ -119,6 +512,21 @@ func (in *GpmInfo) DeepCopyInto(out *GpmInfo) {
 		*out = new(Stat)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string]*Resources, len(*in))
+		for key, val := range *in {
+			var outVal *Resources
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(Resources)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
@@ -171,6 +579,11 @@ func (in *ServiceLog) DeepCopyInto(out *ServiceLog) {
 	*out = *in
 }
 
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *LogOptions) DeepCopyInto(out *LogOptions) {
+	*out = *in
+}
+
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
 func (in *ServiceVersion) DeepCopyInto(out *ServiceVersion) {
 	*out = *in
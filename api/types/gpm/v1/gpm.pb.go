@@ -35,10 +35,19 @@ type Service struct {
 	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 	// 服务进程 id
 	Pid int64 `protobuf:"varint,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	// 服务进程的创建时间 (毫秒), gpmd 重启后用于确认 pid 对应的进程没有变化
+	PidCreateTime int64 `protobuf:"varint,40,opt,name=pidCreateTime,proto3" json:"pidCreateTime,omitempty"`
+	// 服务进程的可执行文件路径, gpmd 重启后用于确认 pid 对应的进程没有变化
+	PidExe string `protobuf:"bytes,41,opt,name=pidExe,proto3" json:"pidExe,omitempty"`
 	// 服务目录
 	Dir string `protobuf:"bytes,5,opt,name=dir,proto3" json:"dir,omitempty"`
 	// 服务环境变量
 	Env map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 环境变量的来源, inherit: 继承 gpmd 的环境变量, clean: 不继承, 只传递 PATH 以及服务用户的 HOME, USER, LOGNAME
+	// +gen:enum=[inherit,clean]
+	EnvMode string `protobuf:"bytes,45,opt,name=envMode,proto3" json:"envMode,omitempty"`
+	// 每次启动时读取的环境变量文件 (dotenv 格式), 相对路径基于服务目录, 以 - 开头时文件不存在不报错
+	EnvFiles []string `protobuf:"bytes,46,rep,name=envFiles,proto3" json:"envFiles,omitempty"`
 	// 服务系统参数
 	SysProcAttr *SysProcAttr `protobuf:"bytes,7,opt,name=sysProcAttr,proto3" json:"sysProcAttr,omitempty"`
 	// 服务进程日志配置
@@ -49,6 +58,51 @@ type Service struct {
 	AutoRestart int32 `protobuf:"varint,10,opt,name=autoRestart,proto3" json:"autoRestart,omitempty"`
 	// 是否为 install 服务, gpmd 设置
 	InstallFlag int32 `protobuf:"varint,11,opt,name=installFlag,proto3" json:"installFlag,omitempty"`
+	// 服务重启策略
+	RestartPolicy *RestartPolicy `protobuf:"bytes,12,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	// 最近一次进程退出信息
+	LastExit *ExitStatus `protobuf:"bytes,13,opt,name=lastExit,proto3" json:"lastExit,omitempty"`
+	// 停止服务时发送的信号, 如 SIGTERM, SIGQUIT, 默认为 SIGINT
+	StopSignal string `protobuf:"bytes,14,opt,name=stopSignal,proto3" json:"stopSignal,omitempty"`
+	// 停止服务的超时时间(秒), 超时后强制结束进程, 默认为 5
+	StopTimeout int64 `protobuf:"varint,15,opt,name=stopTimeout,proto3" json:"stopTimeout,omitempty"`
+	// 停止服务的方式, leader: 只向主进程发送信号, group: 向整个进程组发送信号
+	// +gen:enum=[leader,group]
+	KillMode string `protobuf:"bytes,16,opt,name=killMode,proto3" json:"killMode,omitempty"`
+	// 存活探测, 连续失败达到阈值后重启服务
+	LivenessProbe *Probe `protobuf:"bytes,17,opt,name=livenessProbe,proto3" json:"livenessProbe,omitempty"`
+	// 就绪探测, 结果体现在服务的健康状态中
+	ReadinessProbe *Probe `protobuf:"bytes,18,opt,name=readinessProbe,proto3" json:"readinessProbe,omitempty"`
+	// 依赖的服务, 启动前等待依赖的服务运行, 依赖的服务配置了健康探测时等待探测成功
+	DependsOn []string `protobuf:"bytes,19,rep,name=dependsOn,proto3" json:"dependsOn,omitempty"`
+	// 服务实例数量, 默认为 1
+	Replicas int32 `protobuf:"varint,20,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// 服务资源限制, 通过 cgroup v2 实现 (仅 linux 有效)
+	Resources *Resources `protobuf:"bytes,30,opt,name=resources,proto3" json:"resources,omitempty"`
+	// 服务生命周期钩子
+	Hooks *Hooks `protobuf:"bytes,32,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	// 服务类型, simple: 常驻服务, oneshot: 启动后运行一次, cron: 按照 cron 表达式定时运行, forking: 启动后 fork 出守护进程, 通过 pidFile 获取进程
+	// +gen:enum=[simple,oneshot,cron,forking]
+	Type string `protobuf:"bytes,33,opt,name=type,proto3" json:"type,omitempty"`
+	// cron 服务的定时规则, 如 "0 3 * * *", "@hourly", "@every 30m"
+	Schedule string `protobuf:"bytes,34,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// cron 服务上一次运行还未结束时的处理方式, allow: 同时运行, forbid: 跳过本次运行, replace: 停止上一次运行
+	// +gen:enum=[allow,forbid,replace]
+	ConcurrencyPolicy string `protobuf:"bytes,35,opt,name=concurrencyPolicy,proto3" json:"concurrencyPolicy,omitempty"`
+	// forking 服务写入守护进程 pid 的文件, 相对路径基于服务目录
+	PidFile string `protobuf:"bytes,38,opt,name=pidFile,proto3" json:"pidFile,omitempty"`
+	// 等待 pidFile 生成的超时时间 (秒), 默认 30 秒
+	PidFileTimeout int64 `protobuf:"varint,39,opt,name=pidFileTimeout,proto3" json:"pidFileTimeout,omitempty"`
+	// 重新加载服务的方式
+	Reload *Reload `protobuf:"bytes,42,opt,name=reload,proto3" json:"reload,omitempty"`
+	// gpmd 监听并传递给服务进程的套接字, 重启和升级服务时新进程继续使用相同的套接字
+	Sockets []*Socket `protobuf:"bytes,43,rep,name=sockets,proto3" json:"sockets,omitempty"`
+	// 资源占用阈值, 超过阈值时按照指定的方式处理
+	Threshold *Threshold `protobuf:"bytes,44,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// 服务使用的密钥, 启动服务时作为环境变量或者文件传递给服务进程
+	Secrets []*SecretRef `protobuf:"bytes,47,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// 服务标签, 用于按照标签选择服务
+	Labels map[string]string `protobuf:"bytes,48,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// 创建时间
 	CreationTimestamp int64 `protobuf:"varint,21,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
 	// 修改时间
@@ -56,12 +110,25 @@ type Service struct {
 	// 启动时间
 	StartTimestamp int64 `protobuf:"varint,23,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
 	// 服务状态
-	// +gen:enum=[init,running,stopped,failed,upgrading]
+	// +gen:enum=[init,running,stopped,failed,upgrading,crashloop,paused]
 	Status string `protobuf:"bytes,24,opt,name=status,proto3" json:"status,omitempty"`
-	// 服务状态为 failed 的错误信息
+	// 服务状态为 failed 或 crashloop 的错误信息
 	Msg string `protobuf:"bytes,25,opt,name=msg,proto3" json:"msg,omitempty"`
 	// 服务资源占用情况
 	Stat *Stat `protobuf:"bytes,26,opt,name=stat,proto3" json:"stat,omitempty"`
+	// 服务健康状态, 未配置探测时为空
+	// +gen:enum=[unknown,healthy,unhealthy]
+	Health string `protobuf:"bytes,27,opt,name=health,proto3" json:"health,omitempty"`
+	// 最近一次探测失败的信息
+	HealthMsg string `protobuf:"bytes,28,opt,name=healthMsg,proto3" json:"healthMsg,omitempty"`
+	// 服务所有实例的状态, 查询服务时返回, 第一个实例的状态同时保存在服务中
+	Instances []*Instance `protobuf:"bytes,29,rep,name=instances,proto3" json:"instances,omitempty"`
+	// 启动服务后进程的重启次数
+	Restarts int32 `protobuf:"varint,31,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// cron 服务上一次运行的时间
+	LastRunTimestamp int64 `protobuf:"varint,36,opt,name=lastRunTimestamp,proto3" json:"lastRunTimestamp,omitempty"`
+	// cron 服务下一次运行的时间
+	NextRunTimestamp int64 `protobuf:"varint,37,opt,name=nextRunTimestamp,proto3" json:"nextRunTimestamp,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
@@ -97,6 +164,66 @@ func (m *Service) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Service proto.InternalMessageInfo
 
+type Instance struct {
+	// 实例序号, 从 0 开始, 通过环境变量 GPM_INSTANCE 传递给进程
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// 实例进程 id
+	Pid int64 `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	// 实例启动时间
+	StartTimestamp int64 `protobuf:"varint,3,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
+	// 实例状态
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 实例状态为 failed 或 crashloop 的错误信息
+	Msg string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 实例健康状态
+	Health string `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	// 最近一次探测失败的信息
+	HealthMsg string `protobuf:"bytes,7,opt,name=healthMsg,proto3" json:"healthMsg,omitempty"`
+	// 最近一次进程退出信息
+	LastExit *ExitStatus `protobuf:"bytes,8,opt,name=lastExit,proto3" json:"lastExit,omitempty"`
+	// 实例资源占用情况
+	Stat *Stat `protobuf:"bytes,9,opt,name=stat,proto3" json:"stat,omitempty"`
+	// 启动服务后实例进程的重启次数
+	Restarts int32 `protobuf:"varint,10,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// 实例进程的创建时间 (毫秒)
+	PidCreateTime int64 `protobuf:"varint,11,opt,name=pidCreateTime,proto3" json:"pidCreateTime,omitempty"`
+	// 实例进程的可执行文件路径
+	PidExe string `protobuf:"bytes,12,opt,name=pidExe,proto3" json:"pidExe,omitempty"`
+}
+
+func (m *Instance) Reset()         { *m = Instance{} }
+func (m *Instance) String() string { return proto.CompactTextString(m) }
+func (*Instance) ProtoMessage()    {}
+func (*Instance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{1}
+}
+func (m *Instance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Instance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Instance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Instance.Merge(m, src)
+}
+func (m *Instance) XXX_Size() int {
+	return m.XSize()
+}
+func (m *Instance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Instance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Instance proto.InternalMessageInfo
+
 type SysProcAttr struct {
 	// 执行命令时所在的根目录
 	Chroot string `protobuf:"bytes,1,opt,name=chroot,proto3" json:"chroot,omitempty"`
@@ -108,13 +235,32 @@ type SysProcAttr struct {
 	Gid int32 `protobuf:"varint,4,opt,name=gid,proto3" json:"gid,omitempty"`
 	// gid 对应的用户组
 	Group string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	// 打开文件数量限制, 对应 RLIMIT_NOFILE (仅 linux 有效)
+	LimitNofile *Rlimit `protobuf:"bytes,6,opt,name=limitNofile,proto3" json:"limitNofile,omitempty"`
+	// 进程数量限制, 对应 RLIMIT_NPROC (仅 linux 有效)
+	LimitNproc *Rlimit `protobuf:"bytes,7,opt,name=limitNproc,proto3" json:"limitNproc,omitempty"`
+	// core dump 文件大小限制(字节), 为 0 时禁止 core dump, 对应 RLIMIT_CORE (仅 linux 有效)
+	LimitCore *Rlimit `protobuf:"bytes,8,opt,name=limitCore,proto3" json:"limitCore,omitempty"`
+	// 锁定内存大小限制(字节), 对应 RLIMIT_MEMLOCK (仅 linux 有效)
+	LimitMemlock *Rlimit `protobuf:"bytes,9,opt,name=limitMemlock,proto3" json:"limitMemlock,omitempty"`
+	// 进程的 nice 值, 范围 -20 到 19, 0 表示不修改 (仅 linux 有效)
+	Nice int32 `protobuf:"varint,10,opt,name=nice,proto3" json:"nice,omitempty"`
+	// io 调度类型, 为空表示不修改 (仅 linux 有效)
+	// +gen:enum=[realtime,best-effort,idle]
+	IoniceClass string `protobuf:"bytes,11,opt,name=ioniceClass,proto3" json:"ioniceClass,omitempty"`
+	// io 调度优先级, 范围 0 到 7, 数值越小优先级越高, idle 类型无效
+	IoniceLevel int32 `protobuf:"varint,12,opt,name=ioniceLevel,proto3" json:"ioniceLevel,omitempty"`
+	// 进程可以运行的 cpu 编号, 为空表示不限制 (仅 linux 有效)
+	CpuAffinity []int32 `protobuf:"varint,13,rep,packed,name=cpuAffinity,proto3" json:"cpuAffinity,omitempty"`
+	// 进程的 oom_score_adj, 范围 -1000 到 1000, 0 表示不修改 (仅 linux 有效)
+	OomScoreAdj int32 `protobuf:"varint,14,opt,name=oomScoreAdj,proto3" json:"oomScoreAdj,omitempty"`
 }
 
 func (m *SysProcAttr) Reset()         { *m = SysProcAttr{} }
 func (m *SysProcAttr) String() string { return proto.CompactTextString(m) }
 func (*SysProcAttr) ProtoMessage()    {}
 func (*SysProcAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{1}
+	return fileDescriptor_445ca262c078f1a5, []int{2}
 }
 func (m *SysProcAttr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,6 +289,46 @@ func (m *SysProcAttr) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SysProcAttr proto.InternalMessageInfo
 
+type Rlimit struct {
+	// 软限制, -1 表示不限制
+	Soft int64 `protobuf:"varint,1,opt,name=soft,proto3" json:"soft,omitempty"`
+	// 硬限制, -1 表示不限制, 为 0 时与软限制相同
+	Hard int64 `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"`
+}
+
+func (m *Rlimit) Reset()         { *m = Rlimit{} }
+func (m *Rlimit) String() string { return proto.CompactTextString(m) }
+func (*Rlimit) ProtoMessage()    {}
+func (*Rlimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{3}
+}
+func (m *Rlimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rlimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rlimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Rlimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rlimit.Merge(m, src)
+}
+func (m *Rlimit) XXX_Size() int {
+	return m.XSize()
+}
+func (m *Rlimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rlimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rlimit proto.InternalMessageInfo
+
 type ServiceSpec struct {
 	// 服务名称
 	// +gen:required
@@ -156,6 +342,12 @@ type ServiceSpec struct {
 	Dir string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
 	// 服务环境变量
 	Env map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 环境变量的来源, inherit: 继承 gpmd 的环境变量, clean: 不继承, 只传递 PATH 以及服务用户的 HOME, USER, LOGNAME
+	// +gen:enum=[inherit,clean]
+	// +gen:default=inherit
+	EnvMode string `protobuf:"bytes,30,opt,name=envMode,proto3" json:"envMode,omitempty"`
+	// 每次启动时读取的环境变量文件 (dotenv 格式), 相对路径基于服务目录, 以 - 开头时文件不存在不报错
+	EnvFiles []string `protobuf:"bytes,31,rep,name=envFiles,proto3" json:"envFiles,omitempty"`
 	// 服务系统参数
 	SysProcAttr *SysProcAttr `protobuf:"bytes,6,opt,name=sysProcAttr,proto3" json:"sysProcAttr,omitempty"`
 	// 服务日志配置
@@ -171,13 +363,56 @@ type ServiceSpec struct {
 	HeaderTrimPrefix string `protobuf:"bytes,10,opt,name=headerTrimPrefix,proto3" json:"headerTrimPrefix,omitempty"`
 	// 是否为 install 服务, gpmd 设置
 	InstallFlag int32 `protobuf:"varint,11,opt,name=installFlag,proto3" json:"installFlag,omitempty"`
+	// 服务重启策略
+	RestartPolicy *RestartPolicy `protobuf:"bytes,12,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	// 停止服务时发送的信号, 如 SIGTERM, SIGQUIT, 默认为 SIGINT
+	StopSignal string `protobuf:"bytes,13,opt,name=stopSignal,proto3" json:"stopSignal,omitempty"`
+	// 停止服务的超时时间(秒), 超时后强制结束进程, 默认为 5
+	StopTimeout int64 `protobuf:"varint,14,opt,name=stopTimeout,proto3" json:"stopTimeout,omitempty"`
+	// 停止服务的方式, leader: 只向主进程发送信号, group: 向整个进程组发送信号
+	// +gen:enum=[leader,group]
+	KillMode string `protobuf:"bytes,15,opt,name=killMode,proto3" json:"killMode,omitempty"`
+	// 存活探测, 连续失败达到阈值后重启服务
+	LivenessProbe *Probe `protobuf:"bytes,16,opt,name=livenessProbe,proto3" json:"livenessProbe,omitempty"`
+	// 就绪探测, 结果体现在服务的健康状态中
+	ReadinessProbe *Probe `protobuf:"bytes,17,opt,name=readinessProbe,proto3" json:"readinessProbe,omitempty"`
+	// 依赖的服务, 启动前等待依赖的服务运行, 依赖的服务配置了健康探测时等待探测成功
+	DependsOn []string `protobuf:"bytes,18,rep,name=dependsOn,proto3" json:"dependsOn,omitempty"`
+	// 服务实例数量, 默认为 1
+	Replicas int32 `protobuf:"varint,19,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// 服务资源限制, 通过 cgroup v2 实现 (仅 linux 有效)
+	Resources *Resources `protobuf:"bytes,20,opt,name=resources,proto3" json:"resources,omitempty"`
+	// 服务生命周期钩子
+	Hooks *Hooks `protobuf:"bytes,21,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	// 服务类型, simple: 常驻服务, oneshot: 启动后运行一次, cron: 按照 cron 表达式定时运行, forking: 启动后 fork 出守护进程, 通过 pidFile 获取进程
+	// +gen:enum=[simple,oneshot,cron,forking]
+	Type string `protobuf:"bytes,22,opt,name=type,proto3" json:"type,omitempty"`
+	// cron 服务的定时规则, 如 "0 3 * * *", "@hourly", "@every 30m"
+	Schedule string `protobuf:"bytes,23,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// cron 服务上一次运行还未结束时的处理方式, allow: 同时运行, forbid: 跳过本次运行, replace: 停止上一次运行
+	// +gen:enum=[allow,forbid,replace]
+	ConcurrencyPolicy string `protobuf:"bytes,24,opt,name=concurrencyPolicy,proto3" json:"concurrencyPolicy,omitempty"`
+	// forking 服务写入守护进程 pid 的文件, 相对路径基于服务目录
+	PidFile string `protobuf:"bytes,25,opt,name=pidFile,proto3" json:"pidFile,omitempty"`
+	// 等待 pidFile 生成的超时时间 (秒), 默认 30 秒
+	PidFileTimeout int64 `protobuf:"varint,26,opt,name=pidFileTimeout,proto3" json:"pidFileTimeout,omitempty"`
+	// 重新加载服务的方式
+	Reload *Reload `protobuf:"bytes,27,opt,name=reload,proto3" json:"reload,omitempty"`
+	// gpmd 监听并传递给服务进程的套接字
+	Sockets []*Socket `protobuf:"bytes,28,rep,name=sockets,proto3" json:"sockets,omitempty"`
+	// 资源占用阈值
+	Threshold *Threshold `protobuf:"bytes,29,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// 服务使用的密钥
+	Secrets []*SecretRef `protobuf:"bytes,32,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// 服务标签
+	Labels map[string]string `protobuf:"bytes,33,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
 func (m *ServiceSpec) String() string { return proto.CompactTextString(m) }
 func (*ServiceSpec) ProtoMessage()    {}
 func (*ServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{2}
+	return fileDescriptor_445ca262c078f1a5, []int{4}
 }
 func (m *ServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,13 +451,15 @@ type UpgradeSpec struct {
 	// headerTrimPrefix 不为空时, 解压 tar 包时，内部文件的路径会发生变化
 	// 如 dir=/opt/test/a, hdr=b/bin/test, headerTrimPrefix=b, test 文件的路径为 /opt/test/a/bin/test
 	HeaderTrimPrefix string `protobuf:"bytes,3,opt,name=headerTrimPrefix,proto3" json:"headerTrimPrefix,omitempty"`
+	// 升级后的验证时间(秒), 新版本需要在这段时间内保持运行并通过健康探测, 否则回滚到之前的版本, 为 0 时不验证
+	VerifyTimeout int64 `protobuf:"varint,4,opt,name=verifyTimeout,proto3" json:"verifyTimeout,omitempty"`
 }
 
 func (m *UpgradeSpec) Reset()         { *m = UpgradeSpec{} }
 func (m *UpgradeSpec) String() string { return proto.CompactTextString(m) }
 func (*UpgradeSpec) ProtoMessage()    {}
 func (*UpgradeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{3}
+	return fileDescriptor_445ca262c078f1a5, []int{5}
 }
 func (m *UpgradeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,19 +497,67 @@ type EditServiceSpec struct {
 	Dir string `protobuf:"bytes,3,opt,name=dir,proto3" json:"dir,omitempty"`
 	// 服务环境变量
 	Env map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 环境变量的来源, inherit: 继承 gpmd 的环境变量, clean: 不继承, 只传递 PATH 以及服务用户的 HOME, USER, LOGNAME
+	// +gen:enum=[inherit,clean]
+	EnvMode string `protobuf:"bytes,26,opt,name=envMode,proto3" json:"envMode,omitempty"`
+	// 每次启动时读取的环境变量文件 (dotenv 格式), 相对路径基于服务目录, 以 - 开头时文件不存在不报错
+	EnvFiles []string `protobuf:"bytes,27,rep,name=envFiles,proto3" json:"envFiles,omitempty"`
 	// 服务系统参数
 	SysProcAttr *SysProcAttr `protobuf:"bytes,5,opt,name=sysProcAttr,proto3" json:"sysProcAttr,omitempty"`
 	// 服务日志配置
 	Log *ProcLog `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty"`
 	// 是否自启动, 默认为 false
 	AutoRestart int32 `protobuf:"varint,7,opt,name=autoRestart,proto3" json:"autoRestart,omitempty"`
+	// 服务重启策略
+	RestartPolicy *RestartPolicy `protobuf:"bytes,8,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	// 停止服务时发送的信号, 如 SIGTERM, SIGQUIT, 默认为 SIGINT
+	StopSignal string `protobuf:"bytes,9,opt,name=stopSignal,proto3" json:"stopSignal,omitempty"`
+	// 停止服务的超时时间(秒), 超时后强制结束进程, 默认为 5
+	StopTimeout int64 `protobuf:"varint,10,opt,name=stopTimeout,proto3" json:"stopTimeout,omitempty"`
+	// 停止服务的方式, leader: 只向主进程发送信号, group: 向整个进程组发送信号
+	// +gen:enum=[leader,group]
+	KillMode string `protobuf:"bytes,11,opt,name=killMode,proto3" json:"killMode,omitempty"`
+	// 存活探测, 连续失败达到阈值后重启服务
+	LivenessProbe *Probe `protobuf:"bytes,12,opt,name=livenessProbe,proto3" json:"livenessProbe,omitempty"`
+	// 就绪探测, 结果体现在服务的健康状态中
+	ReadinessProbe *Probe `protobuf:"bytes,13,opt,name=readinessProbe,proto3" json:"readinessProbe,omitempty"`
+	// 依赖的服务, 启动前等待依赖的服务运行, 依赖的服务配置了健康探测时等待探测成功
+	DependsOn []string `protobuf:"bytes,14,rep,name=dependsOn,proto3" json:"dependsOn,omitempty"`
+	// 服务实例数量, 默认为 1
+	Replicas int32 `protobuf:"varint,15,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// 服务资源限制, 通过 cgroup v2 实现 (仅 linux 有效)
+	Resources *Resources `protobuf:"bytes,16,opt,name=resources,proto3" json:"resources,omitempty"`
+	// 服务生命周期钩子
+	Hooks *Hooks `protobuf:"bytes,17,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	// 服务类型, simple: 常驻服务, oneshot: 启动后运行一次, cron: 按照 cron 表达式定时运行, forking: 启动后 fork 出守护进程, 通过 pidFile 获取进程
+	// +gen:enum=[simple,oneshot,cron,forking]
+	Type string `protobuf:"bytes,18,opt,name=type,proto3" json:"type,omitempty"`
+	// cron 服务的定时规则, 如 "0 3 * * *", "@hourly", "@every 30m"
+	Schedule string `protobuf:"bytes,19,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// cron 服务上一次运行还未结束时的处理方式, allow: 同时运行, forbid: 跳过本次运行, replace: 停止上一次运行
+	// +gen:enum=[allow,forbid,replace]
+	ConcurrencyPolicy string `protobuf:"bytes,20,opt,name=concurrencyPolicy,proto3" json:"concurrencyPolicy,omitempty"`
+	// forking 服务写入守护进程 pid 的文件, 相对路径基于服务目录
+	PidFile string `protobuf:"bytes,21,opt,name=pidFile,proto3" json:"pidFile,omitempty"`
+	// 等待 pidFile 生成的超时时间 (秒), 默认 30 秒
+	PidFileTimeout int64 `protobuf:"varint,22,opt,name=pidFileTimeout,proto3" json:"pidFileTimeout,omitempty"`
+	// 重新加载服务的方式
+	Reload *Reload `protobuf:"bytes,23,opt,name=reload,proto3" json:"reload,omitempty"`
+	// gpmd 监听并传递给服务进程的套接字
+	Sockets []*Socket `protobuf:"bytes,24,rep,name=sockets,proto3" json:"sockets,omitempty"`
+	// 资源占用阈值
+	Threshold *Threshold `protobuf:"bytes,25,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// 服务使用的密钥
+	Secrets []*SecretRef `protobuf:"bytes,28,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// 服务标签, 指定后替换原有的标签
+	Labels map[string]string `protobuf:"bytes,29,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *EditServiceSpec) Reset()         { *m = EditServiceSpec{} }
 func (m *EditServiceSpec) String() string { return proto.CompactTextString(m) }
 func (*EditServiceSpec) ProtoMessage()    {}
 func (*EditServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{4}
+	return fileDescriptor_445ca262c078f1a5, []int{6}
 }
 func (m *EditServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,26 +586,33 @@ func (m *EditServiceSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EditServiceSpec proto.InternalMessageInfo
 
-type ProcLog struct {
-	// 日志过期时间(天)
-	// +gen:default=30
-	Expire int32 `protobuf:"varint,1,opt,name=expire,proto3" json:"expire,omitempty"`
-	// 日志最大容量，超过此容量则拆分日志
-	MaxSize int64 `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+type RestartPolicy struct {
+	// 重启策略, always: 进程退出后总是重启, onFailure: 进程异常退出时重启, never: 不重启
+	// +gen:enum=[always,onFailure,never]
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// 首次重启前的等待时间(秒), 之后每次翻倍
+	InitialBackoff int64 `protobuf:"varint,2,opt,name=initialBackoff,proto3" json:"initialBackoff,omitempty"`
+	// 重启等待时间的上限(秒)
+	MaxBackoff int64 `protobuf:"varint,3,opt,name=maxBackoff,proto3" json:"maxBackoff,omitempty"`
+	// 时间窗口内允许的最大重启次数, 超过后服务进入 crashloop 状态, -1 表示不限制
+	// +gen:default=5
+	MaxRetries int32 `protobuf:"varint,4,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	// 统计重启次数的时间窗口(秒)
+	Window int64 `protobuf:"varint,5,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *ProcLog) Reset()         { *m = ProcLog{} }
-func (m *ProcLog) String() string { return proto.CompactTextString(m) }
-func (*ProcLog) ProtoMessage()    {}
-func (*ProcLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{5}
+func (m *RestartPolicy) Reset()         { *m = RestartPolicy{} }
+func (m *RestartPolicy) String() string { return proto.CompactTextString(m) }
+func (*RestartPolicy) ProtoMessage()    {}
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{7}
 }
-func (m *ProcLog) XXX_Unmarshal(b []byte) error {
+func (m *RestartPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProcLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestartPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProcLog.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestartPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -330,39 +622,56 @@ func (m *ProcLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ProcLog) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcLog.Merge(m, src)
+func (m *RestartPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartPolicy.Merge(m, src)
 }
-func (m *ProcLog) XXX_Size() int {
+func (m *RestartPolicy) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ProcLog) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProcLog.DiscardUnknown(m)
+func (m *RestartPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProcLog proto.InternalMessageInfo
+var xxx_messageInfo_RestartPolicy proto.InternalMessageInfo
 
-type Stat struct {
-	// cpu 占用百分比
-	CpuPercent float64 `protobuf:"fixed64,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
-	// 内存占用, rss
-	Memory uint64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	// 内存占用百分比
-	MemPercent float32 `protobuf:"fixed32,3,opt,name=memPercent,proto3" json:"memPercent,omitempty"`
+type Probe struct {
+	// 探测方式, http: 发送 HTTP GET 请求, tcp: 建立 TCP 连接, exec: 在服务目录下以服务用户执行命令
+	// +gen:required
+	// +gen:enum=[http,tcp,exec]
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// http 探测的地址, 如 http://127.0.0.1:8080/healthz
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// http 探测期望的状态码, 为 0 时 200-399 均为成功
+	ExpectStatus int32 `protobuf:"varint,3,opt,name=expectStatus,proto3" json:"expectStatus,omitempty"`
+	// tcp 探测的地址, 如 127.0.0.1:8080
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// exec 探测执行的命令, 退出码为 0 表示成功
+	Command []string `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+	// 服务启动后开始探测前的等待时间(秒)
+	InitialDelay int64 `protobuf:"varint,6,opt,name=initialDelay,proto3" json:"initialDelay,omitempty"`
+	// 探测间隔(秒)
+	// +gen:default=10
+	Interval int64 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// 探测超时时间(秒)
+	// +gen:default=1
+	Timeout int64 `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 连续失败次数达到阈值后判定为失败
+	// +gen:default=3
+	FailureThreshold int32 `protobuf:"varint,9,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"`
 }
 
-func (m *Stat) Reset()         { *m = Stat{} }
-func (m *Stat) String() string { return proto.CompactTextString(m) }
-func (*Stat) ProtoMessage()    {}
-func (*Stat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{6}
+func (m *Probe) Reset()         { *m = Probe{} }
+func (m *Probe) String() string { return proto.CompactTextString(m) }
+func (*Probe) ProtoMessage()    {}
+func (*Probe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{8}
 }
-func (m *Stat) XXX_Unmarshal(b []byte) error {
+func (m *Probe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Probe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Stat.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Probe.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -372,40 +681,41 @@ func (m *Stat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Stat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stat.Merge(m, src)
+func (m *Probe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Probe.Merge(m, src)
 }
-func (m *Stat) XXX_Size() int {
+func (m *Probe) XXX_Size() int {
 	return m.XSize()
 }
-func (m *Stat) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stat.DiscardUnknown(m)
+func (m *Probe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Probe.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stat proto.InternalMessageInfo
+var xxx_messageInfo_Probe proto.InternalMessageInfo
 
-type GpmInfo struct {
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	Goos    string `protobuf:"bytes,4,opt,name=goos,proto3" json:"goos,omitempty"`
-	Arch    string `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`
-	Gov     string `protobuf:"bytes,6,opt,name=gov,proto3" json:"gov,omitempty"`
-	Pid     int32  `protobuf:"varint,7,opt,name=pid,proto3" json:"pid,omitempty"`
-	Stat    *Stat  `protobuf:"bytes,8,opt,name=stat,proto3" json:"stat,omitempty"`
-	UpTime  int64  `protobuf:"varint,9,opt,name=upTime,proto3" json:"upTime,omitempty"`
+type Hooks struct {
+	// 启动服务前执行, 如数据库迁移
+	PreStart *Hook `protobuf:"bytes,1,opt,name=preStart,proto3" json:"preStart,omitempty"`
+	// 启动服务后执行, 如预热缓存
+	PostStart *Hook `protobuf:"bytes,2,opt,name=postStart,proto3" json:"postStart,omitempty"`
+	// 停止服务前执行, 如摘除流量
+	PreStop *Hook `protobuf:"bytes,3,opt,name=preStop,proto3" json:"preStop,omitempty"`
+	// 停止服务后执行
+	PostStop *Hook `protobuf:"bytes,4,opt,name=postStop,proto3" json:"postStop,omitempty"`
 }
 
-func (m *GpmInfo) Reset()         { *m = GpmInfo{} }
-func (m *GpmInfo) String() string { return proto.CompactTextString(m) }
-func (*GpmInfo) ProtoMessage()    {}
-func (*GpmInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{7}
+func (m *Hooks) Reset()         { *m = Hooks{} }
+func (m *Hooks) String() string { return proto.CompactTextString(m) }
+func (*Hooks) ProtoMessage()    {}
+func (*Hooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{9}
 }
-func (m *GpmInfo) XXX_Unmarshal(b []byte) error {
+func (m *Hooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GpmInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Hooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GpmInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Hooks.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -415,40 +725,51 @@ func (m *GpmInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GpmInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GpmInfo.Merge(m, src)
+func (m *Hooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hooks.Merge(m, src)
 }
-func (m *GpmInfo) XXX_Size() int {
+func (m *Hooks) XXX_Size() int {
 	return m.XSize()
 }
-func (m *GpmInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GpmInfo.DiscardUnknown(m)
+func (m *Hooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hooks.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GpmInfo proto.InternalMessageInfo
+var xxx_messageInfo_Hooks proto.InternalMessageInfo
 
-type Package struct {
-	// +gen:required
-	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+type Hook struct {
+	// 执行方式, exec: 在服务目录下以服务的用户和环境变量执行命令, http: 发送 HTTP 请求
 	// +gen:required
-	Total  int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Chunk  []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	Length int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
-	IsOk   bool   `protobuf:"varint,5,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	// +gen:enum=[exec,http]
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// exec 钩子执行的命令
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	// http 钩子请求的地址
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// http 钩子的请求方法
+	// +gen:default=GET
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// 超时时间(秒)
+	// +gen:default=30
+	Timeout int64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 执行失败时的处理方式, abort: 中止启动或停止服务, continue: 忽略错误
+	// +gen:enum=[abort,continue]
+	// +gen:default=abort
+	OnFailure string `protobuf:"bytes,6,opt,name=onFailure,proto3" json:"onFailure,omitempty"`
 }
 
-func (m *Package) Reset()         { *m = Package{} }
-func (m *Package) String() string { return proto.CompactTextString(m) }
-func (*Package) ProtoMessage()    {}
-func (*Package) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{8}
+func (m *Hook) Reset()         { *m = Hook{} }
+func (m *Hook) String() string { return proto.CompactTextString(m) }
+func (*Hook) ProtoMessage()    {}
+func (*Hook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{10}
 }
-func (m *Package) XXX_Unmarshal(b []byte) error {
+func (m *Hook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Package) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Hook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Package.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Hook.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,37 +779,38 @@ func (m *Package) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Package) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Package.Merge(m, src)
+func (m *Hook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hook.Merge(m, src)
 }
-func (m *Package) XXX_Size() int {
+func (m *Hook) XXX_Size() int {
 	return m.XSize()
 }
-func (m *Package) XXX_DiscardUnknown() {
-	xxx_messageInfo_Package.DiscardUnknown(m)
+func (m *Hook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hook.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Package proto.InternalMessageInfo
+var xxx_messageInfo_Hook proto.InternalMessageInfo
 
-type InstallServiceIn struct {
-	// +gen:required
-	Spec *ServiceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+type Socket struct {
+	// 套接字名称, 通过环境变量 LISTEN_FDNAMES 传递给进程
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 监听地址, 如 tcp://0.0.0.0:8080, udp://:53, unix:///run/app.sock, 未指定协议时为 tcp
 	// +gen:required
-	Pack *Package `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *InstallServiceIn) Reset()         { *m = InstallServiceIn{} }
-func (m *InstallServiceIn) String() string { return proto.CompactTextString(m) }
-func (*InstallServiceIn) ProtoMessage()    {}
-func (*InstallServiceIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{9}
+func (m *Socket) Reset()         { *m = Socket{} }
+func (m *Socket) String() string { return proto.CompactTextString(m) }
+func (*Socket) ProtoMessage()    {}
+func (*Socket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{11}
 }
-func (m *InstallServiceIn) XXX_Unmarshal(b []byte) error {
+func (m *Socket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstallServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Socket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstallServiceIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Socket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -498,35 +820,40 @@ func (m *InstallServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *InstallServiceIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstallServiceIn.Merge(m, src)
+func (m *Socket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Socket.Merge(m, src)
 }
-func (m *InstallServiceIn) XXX_Size() int {
+func (m *Socket) XXX_Size() int {
 	return m.XSize()
 }
-func (m *InstallServiceIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstallServiceIn.DiscardUnknown(m)
+func (m *Socket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Socket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstallServiceIn proto.InternalMessageInfo
+var xxx_messageInfo_Socket proto.InternalMessageInfo
 
-type InstallServiceResult struct {
-	IsOk  bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type Reload struct {
+	// 重新加载时发送给服务进程的信号, 如 SIGHUP
+	Signal string `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	// 重新加载时执行的命令, 在服务目录下以服务的用户和环境变量执行, 环境变量 GPM_PID 为服务进程 id
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	// 执行命令的超时时间(秒)
+	// +gen:default=30
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (m *InstallServiceResult) Reset()         { *m = InstallServiceResult{} }
-func (m *InstallServiceResult) String() string { return proto.CompactTextString(m) }
-func (*InstallServiceResult) ProtoMessage()    {}
-func (*InstallServiceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{10}
+func (m *Reload) Reset()         { *m = Reload{} }
+func (m *Reload) String() string { return proto.CompactTextString(m) }
+func (*Reload) ProtoMessage()    {}
+func (*Reload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{12}
 }
-func (m *InstallServiceResult) XXX_Unmarshal(b []byte) error {
+func (m *Reload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstallServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Reload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstallServiceResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Reload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -536,37 +863,42 @@ func (m *InstallServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *InstallServiceResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstallServiceResult.Merge(m, src)
+func (m *Reload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reload.Merge(m, src)
 }
-func (m *InstallServiceResult) XXX_Size() int {
+func (m *Reload) XXX_Size() int {
 	return m.XSize()
 }
-func (m *InstallServiceResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstallServiceResult.DiscardUnknown(m)
+func (m *Reload) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstallServiceResult proto.InternalMessageInfo
+var xxx_messageInfo_Reload proto.InternalMessageInfo
 
-type UpgradeServiceIn struct {
-	// +gen:required
-	Spec *UpgradeSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+type Secret struct {
+	// 密钥名称, 只能包含字母, 数字, '.', '_' 和 '-'
 	// +gen:required
-	Pack *Package `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 密钥值的长度 (字节)
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// 创建时间
+	CreationTimestamp int64 `protobuf:"varint,3,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	// 修改时间
+	UpdateTimestamp int64 `protobuf:"varint,4,opt,name=updateTimestamp,proto3" json:"updateTimestamp,omitempty"`
 }
 
-func (m *UpgradeServiceIn) Reset()         { *m = UpgradeServiceIn{} }
-func (m *UpgradeServiceIn) String() string { return proto.CompactTextString(m) }
-func (*UpgradeServiceIn) ProtoMessage()    {}
-func (*UpgradeServiceIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{11}
+func (m *Secret) Reset()         { *m = Secret{} }
+func (m *Secret) String() string { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()    {}
+func (*Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{13}
 }
-func (m *UpgradeServiceIn) XXX_Unmarshal(b []byte) error {
+func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpgradeServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpgradeServiceIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Secret.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -576,35 +908,38 @@ func (m *UpgradeServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *UpgradeServiceIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeServiceIn.Merge(m, src)
+func (m *Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secret.Merge(m, src)
 }
-func (m *UpgradeServiceIn) XXX_Size() int {
+func (m *Secret) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpgradeServiceIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeServiceIn.DiscardUnknown(m)
+func (m *Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secret.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeServiceIn proto.InternalMessageInfo
+var xxx_messageInfo_Secret proto.InternalMessageInfo
 
-type UpgradeServiceResult struct {
-	IsOk  bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type SecretSpec struct {
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 密钥的值, gpmd 使用本机的密钥加密后保存
+	// +gen:required
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UpgradeServiceResult) Reset()         { *m = UpgradeServiceResult{} }
-func (m *UpgradeServiceResult) String() string { return proto.CompactTextString(m) }
-func (*UpgradeServiceResult) ProtoMessage()    {}
-func (*UpgradeServiceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{12}
+func (m *SecretSpec) Reset()         { *m = SecretSpec{} }
+func (m *SecretSpec) String() string { return proto.CompactTextString(m) }
+func (*SecretSpec) ProtoMessage()    {}
+func (*SecretSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{14}
 }
-func (m *UpgradeServiceResult) XXX_Unmarshal(b []byte) error {
+func (m *SecretSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpgradeServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SecretSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpgradeServiceResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SecretSpec.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -614,36 +949,40 @@ func (m *UpgradeServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *UpgradeServiceResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeServiceResult.Merge(m, src)
+func (m *SecretSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretSpec.Merge(m, src)
 }
-func (m *UpgradeServiceResult) XXX_Size() int {
+func (m *SecretSpec) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpgradeServiceResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeServiceResult.DiscardUnknown(m)
+func (m *SecretSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeServiceResult proto.InternalMessageInfo
+var xxx_messageInfo_SecretSpec proto.InternalMessageInfo
 
-type ServiceLog struct {
-	Text      string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type SecretRef struct {
+	// 密钥名称
+	// +gen:required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 作为环境变量传递时的变量名, 与 file 只能指定一个
+	Env string `protobuf:"bytes,2,opt,name=env,proto3" json:"env,omitempty"`
+	// 作为文件传递时的路径, 必须是服务目录下的相对路径, 每次启动服务时以 0600 权限写入
+	File string `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
 }
 
-func (m *ServiceLog) Reset()         { *m = ServiceLog{} }
-func (m *ServiceLog) String() string { return proto.CompactTextString(m) }
-func (*ServiceLog) ProtoMessage()    {}
-func (*ServiceLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{13}
+func (m *SecretRef) Reset()         { *m = SecretRef{} }
+func (m *SecretRef) String() string { return proto.CompactTextString(m) }
+func (*SecretRef) ProtoMessage()    {}
+func (*SecretRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{15}
 }
-func (m *ServiceLog) XXX_Unmarshal(b []byte) error {
+func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ServiceLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SecretRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ServiceLog.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SecretRef.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -653,36 +992,51 @@ func (m *ServiceLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ServiceLog) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceLog.Merge(m, src)
+func (m *SecretRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretRef.Merge(m, src)
 }
-func (m *ServiceLog) XXX_Size() int {
+func (m *SecretRef) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ServiceLog) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceLog.DiscardUnknown(m)
+func (m *SecretRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretRef.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServiceLog proto.InternalMessageInfo
+var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 
-type ServiceVersion struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type Threshold struct {
+	// 内存占用上限(字节, rss), 0 表示不检查
+	Memory uint64 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	// 内存占用百分比上限, 0 表示不检查
+	MemPercent float32 `protobuf:"fixed32,2,opt,name=memPercent,proto3" json:"memPercent,omitempty"`
+	// cpu 占用百分比上限, 多核时可以超过 100, 0 表示不检查
+	CpuPercent float64 `protobuf:"fixed64,3,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	// cpu 占用持续超过上限的时间(秒)
+	// +gen:default=60
+	CpuWindow int64 `protobuf:"varint,4,opt,name=cpuWindow,proto3" json:"cpuWindow,omitempty"`
+	// 检查间隔(秒)
+	// +gen:default=10
+	Interval int64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// 超过阈值时的处理方式, restart: 重启进程, signal: 向进程发送信号, event: 只记录事件
+	// +gen:enum=[restart,signal,event]
+	// +gen:default=event
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// action 为 signal 时发送的信号, 如 SIGUSR1
+	Signal string `protobuf:"bytes,7,opt,name=signal,proto3" json:"signal,omitempty"`
 }
 
-func (m *ServiceVersion) Reset()         { *m = ServiceVersion{} }
-func (m *ServiceVersion) String() string { return proto.CompactTextString(m) }
-func (*ServiceVersion) ProtoMessage()    {}
-func (*ServiceVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{14}
+func (m *Threshold) Reset()         { *m = Threshold{} }
+func (m *Threshold) String() string { return proto.CompactTextString(m) }
+func (*Threshold) ProtoMessage()    {}
+func (*Threshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{16}
 }
-func (m *ServiceVersion) XXX_Unmarshal(b []byte) error {
+func (m *Threshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ServiceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Threshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ServiceVersion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Threshold.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,38 +1046,41 @@ func (m *ServiceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ServiceVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceVersion.Merge(m, src)
+func (m *Threshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Threshold.Merge(m, src)
 }
-func (m *ServiceVersion) XXX_Size() int {
+func (m *Threshold) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ServiceVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceVersion.DiscardUnknown(m)
+func (m *Threshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Threshold.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServiceVersion proto.InternalMessageInfo
+var xxx_messageInfo_Threshold proto.InternalMessageInfo
 
-type FileInfo struct {
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Mode    string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	ModTime int64  `protobuf:"varint,4,opt,name=modTime,proto3" json:"modTime,omitempty"`
-	IsDir   bool   `protobuf:"varint,5,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
+type Resources struct {
+	// 内存上限(字节), 对应 memory.max, 0 表示不限制
+	Memory int64 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	// cpu 上限(核数), 如 0.5 表示半个核, 对应 cpu.max, 0 表示不限制
+	Cpu float64 `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// 进程数上限, 对应 pids.max, 0 表示不限制
+	Pids int64 `protobuf:"varint,3,opt,name=pids,proto3" json:"pids,omitempty"`
+	// io 权重, 范围 1-10000, 对应 io.weight, 0 表示使用默认值
+	IoWeight int32 `protobuf:"varint,4,opt,name=ioWeight,proto3" json:"ioWeight,omitempty"`
 }
 
-func (m *FileInfo) Reset()         { *m = FileInfo{} }
-func (m *FileInfo) String() string { return proto.CompactTextString(m) }
-func (*FileInfo) ProtoMessage()    {}
-func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{15}
+func (m *Resources) Reset()         { *m = Resources{} }
+func (m *Resources) String() string { return proto.CompactTextString(m) }
+func (*Resources) ProtoMessage()    {}
+func (*Resources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{17}
 }
-func (m *FileInfo) XXX_Unmarshal(b []byte) error {
+func (m *Resources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Resources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FileInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Resources.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -733,40 +1090,43 @@ func (m *FileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *FileInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FileInfo.Merge(m, src)
+func (m *Resources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resources.Merge(m, src)
 }
-func (m *FileInfo) XXX_Size() int {
+func (m *Resources) XXX_Size() int {
 	return m.XSize()
 }
-func (m *FileInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_FileInfo.DiscardUnknown(m)
+func (m *Resources) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resources.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FileInfo proto.InternalMessageInfo
+var xxx_messageInfo_Resources proto.InternalMessageInfo
 
-type UpdateIn struct {
-	// +gen:required
-	Version      string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	Total        int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Chunk        []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	Length       int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
-	IsOk         bool   `protobuf:"varint,5,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	DeploySignal bool   `protobuf:"varint,6,opt,name=deploySignal,proto3" json:"deploySignal,omitempty"`
+type ExitStatus struct {
+	// 退出进程的 id
+	Pid int64 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// 进程退出码, 被信号终止或无法获取时为 -1
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// 终止进程的信号名称
+	Signal string `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	// 进程启动时间
+	StartTimestamp int64 `protobuf:"varint,4,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
+	// 进程退出时间
+	ExitTimestamp int64 `protobuf:"varint,5,opt,name=exitTimestamp,proto3" json:"exitTimestamp,omitempty"`
 }
 
-func (m *UpdateIn) Reset()         { *m = UpdateIn{} }
-func (m *UpdateIn) String() string { return proto.CompactTextString(m) }
-func (*UpdateIn) ProtoMessage()    {}
-func (*UpdateIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{16}
+func (m *ExitStatus) Reset()         { *m = ExitStatus{} }
+func (m *ExitStatus) String() string { return proto.CompactTextString(m) }
+func (*ExitStatus) ProtoMessage()    {}
+func (*ExitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{18}
 }
-func (m *UpdateIn) XXX_Unmarshal(b []byte) error {
+func (m *ExitStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExitStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -776,35 +1136,49 @@ func (m *UpdateIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UpdateIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateIn.Merge(m, src)
+func (m *ExitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitStatus.Merge(m, src)
 }
-func (m *UpdateIn) XXX_Size() int {
+func (m *ExitStatus) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpdateIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateIn.DiscardUnknown(m)
+func (m *ExitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateIn proto.InternalMessageInfo
+var xxx_messageInfo_ExitStatus proto.InternalMessageInfo
 
-type UpdateResult struct {
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	IsOk  bool   `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
+type ServiceExit struct {
+	// 退出进程所属的实例序号
+	Instance int32 `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// 进程退出信息
+	Status *ExitStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 退出原因, exit: 进程正常退出, crash: 进程异常退出, stop: 被 gpmd 停止, upgrade: 升级或回滚服务时停止, oom: 超出内存限制被结束,
+	// threshold: 资源占用超过阈值, 处理方式不是 restart 时进程没有退出, status 中只有进程信息
+	// +gen:enum=[exit,crash,stop,upgrade,oom,threshold]
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 退出的详细信息, 如退出码或者存活探测失败的原因
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 退出前进程的重启次数
+	Restarts int32 `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// 进程退出时最后的日志
+	Logs []string `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 记录时间
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *UpdateResult) Reset()         { *m = UpdateResult{} }
-func (m *UpdateResult) String() string { return proto.CompactTextString(m) }
-func (*UpdateResult) ProtoMessage()    {}
-func (*UpdateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{17}
+func (m *ServiceExit) Reset()         { *m = ServiceExit{} }
+func (m *ServiceExit) String() string { return proto.CompactTextString(m) }
+func (*ServiceExit) ProtoMessage()    {}
+func (*ServiceExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{19}
 }
-func (m *UpdateResult) XXX_Unmarshal(b []byte) error {
+func (m *ServiceExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ServiceExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ServiceExit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -814,39 +1188,44 @@ func (m *UpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UpdateResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateResult.Merge(m, src)
+func (m *ServiceExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceExit.Merge(m, src)
 }
-func (m *UpdateResult) XXX_Size() int {
+func (m *ServiceExit) XXX_Size() int {
 	return m.XSize()
 }
-func (m *UpdateResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateResult.DiscardUnknown(m)
+func (m *ServiceExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceExit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateResult proto.InternalMessageInfo
+var xxx_messageInfo_ServiceExit proto.InternalMessageInfo
 
-type ExecIn struct {
-	// +gen:required
-	Shell string            `protobuf:"bytes,1,opt,name=shell,proto3" json:"shell,omitempty"`
-	Dir   string            `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
-	Env   map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	User  string            `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	Group string            `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+type ProcLog struct {
+	// 日志过期时间(天)
+	// +gen:default=30
+	Expire int32 `protobuf:"varint,1,opt,name=expire,proto3" json:"expire,omitempty"`
+	// 日志最大容量，超过此容量则拆分日志
+	MaxSize int64 `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	// 按时间拆分日志的间隔(秒), 0 表示不按时间拆分
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// 服务所有日志文件的总大小上限, 超过时从最早的归档日志开始删除, 0 表示不限制
+	MaxTotalSize int64 `protobuf:"varint,4,opt,name=maxTotalSize,proto3" json:"maxTotalSize,omitempty"`
+	// 服务保留的归档日志数量, 0 表示不限制
+	MaxFiles int32 `protobuf:"varint,5,opt,name=maxFiles,proto3" json:"maxFiles,omitempty"`
 }
 
-func (m *ExecIn) Reset()         { *m = ExecIn{} }
-func (m *ExecIn) String() string { return proto.CompactTextString(m) }
-func (*ExecIn) ProtoMessage()    {}
-func (*ExecIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{18}
+func (m *ProcLog) Reset()         { *m = ProcLog{} }
+func (m *ProcLog) String() string { return proto.CompactTextString(m) }
+func (*ProcLog) ProtoMessage()    {}
+func (*ProcLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{20}
 }
-func (m *ExecIn) XXX_Unmarshal(b []byte) error {
+func (m *ProcLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProcLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProcLog.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -856,34 +1235,39 @@ func (m *ExecIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ExecIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecIn.Merge(m, src)
+func (m *ProcLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcLog.Merge(m, src)
 }
-func (m *ExecIn) XXX_Size() int {
+func (m *ProcLog) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ExecIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecIn.DiscardUnknown(m)
+func (m *ProcLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcLog.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecIn proto.InternalMessageInfo
+var xxx_messageInfo_ProcLog proto.InternalMessageInfo
 
-type ExecResult struct {
-	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type Stat struct {
+	// cpu 占用百分比
+	CpuPercent float64 `protobuf:"fixed64,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	// 内存占用, rss, 服务运行在 cgroup 中时为 cgroup 的内存占用 (包含子进程)
+	Memory uint64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// 内存占用百分比
+	MemPercent float32 `protobuf:"fixed32,3,opt,name=memPercent,proto3" json:"memPercent,omitempty"`
 }
 
-func (m *ExecResult) Reset()         { *m = ExecResult{} }
-func (m *ExecResult) String() string { return proto.CompactTextString(m) }
-func (*ExecResult) ProtoMessage()    {}
-func (*ExecResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{19}
+func (m *Stat) Reset()         { *m = Stat{} }
+func (m *Stat) String() string { return proto.CompactTextString(m) }
+func (*Stat) ProtoMessage()    {}
+func (*Stat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{21}
 }
-func (m *ExecResult) XXX_Unmarshal(b []byte) error {
+func (m *Stat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Stat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Stat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -893,39 +1277,44 @@ func (m *ExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ExecResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecResult.Merge(m, src)
+func (m *Stat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stat.Merge(m, src)
 }
-func (m *ExecResult) XXX_Size() int {
+func (m *Stat) XXX_Size() int {
 	return m.XSize()
 }
-func (m *ExecResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecResult.DiscardUnknown(m)
+func (m *Stat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecResult proto.InternalMessageInfo
+var xxx_messageInfo_Stat proto.InternalMessageInfo
 
-type PullResult struct {
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Total    int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Chunk    []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	Length   int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
-	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	Finished bool   `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
+type GpmInfo struct {
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Goos    string `protobuf:"bytes,4,opt,name=goos,proto3" json:"goos,omitempty"`
+	Arch    string `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`
+	Gov     string `protobuf:"bytes,6,opt,name=gov,proto3" json:"gov,omitempty"`
+	Pid     int32  `protobuf:"varint,7,opt,name=pid,proto3" json:"pid,omitempty"`
+	Stat    *Stat  `protobuf:"bytes,8,opt,name=stat,proto3" json:"stat,omitempty"`
+	UpTime  int64  `protobuf:"varint,9,opt,name=upTime,proto3" json:"upTime,omitempty"`
+	// gpmd 管理的 cgroup v2 目录, 为空时表示不支持资源限制
+	Cgroup string `protobuf:"bytes,10,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	// 设置了资源限制的服务, key 为服务名称
+	Resources map[string]*Resources `protobuf:"bytes,11,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *PullResult) Reset()         { *m = PullResult{} }
-func (m *PullResult) String() string { return proto.CompactTextString(m) }
-func (*PullResult) ProtoMessage()    {}
-func (*PullResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{20}
+func (m *GpmInfo) Reset()         { *m = GpmInfo{} }
+func (m *GpmInfo) String() string { return proto.CompactTextString(m) }
+func (*GpmInfo) ProtoMessage()    {}
+func (*GpmInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{22}
 }
-func (m *PullResult) XXX_Unmarshal(b []byte) error {
+func (m *GpmInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GpmInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GpmInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -935,39 +1324,40 @@ func (m *PullResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PullResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullResult.Merge(m, src)
+func (m *GpmInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GpmInfo.Merge(m, src)
 }
-func (m *PullResult) XXX_Size() int {
+func (m *GpmInfo) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PullResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullResult.DiscardUnknown(m)
+func (m *GpmInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GpmInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullResult proto.InternalMessageInfo
+var xxx_messageInfo_GpmInfo proto.InternalMessageInfo
 
-type PushIn struct {
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Dst    string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
-	Total  int64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Chunk  []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
-	Length int64  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
-	IsOk   bool   `protobuf:"varint,6,opt,name=isOk,proto3" json:"isOk,omitempty"`
+type Package struct {
+	// +gen:required
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// +gen:required
+	Total  int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Chunk  []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Length int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	IsOk   bool   `protobuf:"varint,5,opt,name=isOk,proto3" json:"isOk,omitempty"`
 }
 
-func (m *PushIn) Reset()         { *m = PushIn{} }
-func (m *PushIn) String() string { return proto.CompactTextString(m) }
-func (*PushIn) ProtoMessage()    {}
-func (*PushIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{21}
+func (m *Package) Reset()         { *m = Package{} }
+func (m *Package) String() string { return proto.CompactTextString(m) }
+func (*Package) ProtoMessage()    {}
+func (*Package) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{23}
 }
-func (m *PushIn) XXX_Unmarshal(b []byte) error {
+func (m *Package) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Package) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Package.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -977,38 +1367,37 @@ func (m *PushIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PushIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushIn.Merge(m, src)
+func (m *Package) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Package.Merge(m, src)
 }
-func (m *PushIn) XXX_Size() int {
+func (m *Package) XXX_Size() int {
 	return m.XSize()
 }
-func (m *PushIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushIn.DiscardUnknown(m)
+func (m *Package) XXX_DiscardUnknown() {
+	xxx_messageInfo_Package.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushIn proto.InternalMessageInfo
+var xxx_messageInfo_Package proto.InternalMessageInfo
 
-type TerminalIn struct {
+type InstallServiceIn struct {
 	// +gen:required
-	Command string            `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
-	Env     map[string]string `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	User    string            `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Group   string            `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Spec *ServiceSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// +gen:required
+	Pack *Package `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"`
 }
 
-func (m *TerminalIn) Reset()         { *m = TerminalIn{} }
-func (m *TerminalIn) String() string { return proto.CompactTextString(m) }
-func (*TerminalIn) ProtoMessage()    {}
-func (*TerminalIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{22}
+func (m *InstallServiceIn) Reset()         { *m = InstallServiceIn{} }
+func (m *InstallServiceIn) String() string { return proto.CompactTextString(m) }
+func (*InstallServiceIn) ProtoMessage()    {}
+func (*InstallServiceIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{24}
 }
-func (m *TerminalIn) XXX_Unmarshal(b []byte) error {
+func (m *InstallServiceIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminalIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstallServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminalIn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstallServiceIn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1018,37 +1407,35 @@ func (m *TerminalIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TerminalIn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminalIn.Merge(m, src)
+func (m *InstallServiceIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallServiceIn.Merge(m, src)
 }
-func (m *TerminalIn) XXX_Size() int {
+func (m *InstallServiceIn) XXX_Size() int {
 	return m.XSize()
 }
-func (m *TerminalIn) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminalIn.DiscardUnknown(m)
+func (m *InstallServiceIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallServiceIn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminalIn proto.InternalMessageInfo
+var xxx_messageInfo_InstallServiceIn proto.InternalMessageInfo
 
-type TerminalResult struct {
-	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
-	Error  []byte `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	IsOk   bool   `protobuf:"varint,4,opt,name=isOk,proto3" json:"isOk,omitempty"`
+type InstallServiceResult struct {
+	IsOk  bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *TerminalResult) Reset()         { *m = TerminalResult{} }
-func (m *TerminalResult) String() string { return proto.CompactTextString(m) }
-func (*TerminalResult) ProtoMessage()    {}
-func (*TerminalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_445ca262c078f1a5, []int{23}
+func (m *InstallServiceResult) Reset()         { *m = InstallServiceResult{} }
+func (m *InstallServiceResult) String() string { return proto.CompactTextString(m) }
+func (*InstallServiceResult) ProtoMessage()    {}
+func (*InstallServiceResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{25}
 }
-func (m *TerminalResult) XXX_Unmarshal(b []byte) error {
+func (m *InstallServiceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TerminalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstallServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TerminalResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstallServiceResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1058,2263 +1445,11936 @@ func (m *TerminalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *TerminalResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TerminalResult.Merge(m, src)
+func (m *InstallServiceResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallServiceResult.Merge(m, src)
 }
-func (m *TerminalResult) XXX_Size() int {
+func (m *InstallServiceResult) XXX_Size() int {
 	return m.XSize()
 }
-func (m *TerminalResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_TerminalResult.DiscardUnknown(m)
+func (m *InstallServiceResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallServiceResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TerminalResult proto.InternalMessageInfo
+var xxx_messageInfo_InstallServiceResult proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Service)(nil), "gpmv1.Service")
-	proto.RegisterMapType((map[string]string)(nil), "gpmv1.Service.EnvEntry")
-	proto.RegisterType((*SysProcAttr)(nil), "gpmv1.SysProcAttr")
-	proto.RegisterType((*ServiceSpec)(nil), "gpmv1.ServiceSpec")
-	proto.RegisterMapType((map[string]string)(nil), "gpmv1.ServiceSpec.EnvEntry")
-	proto.RegisterType((*UpgradeSpec)(nil), "gpmv1.UpgradeSpec")
-	proto.RegisterType((*EditServiceSpec)(nil), "gpmv1.EditServiceSpec")
-	proto.RegisterMapType((map[string]string)(nil), "gpmv1.EditServiceSpec.EnvEntry")
-	proto.RegisterType((*ProcLog)(nil), "gpmv1.ProcLog")
-	proto.RegisterType((*Stat)(nil), "gpmv1.Stat")
-	proto.RegisterType((*GpmInfo)(nil), "gpmv1.GpmInfo")
-	proto.RegisterType((*Package)(nil), "gpmv1.Package")
-	proto.RegisterType((*InstallServiceIn)(nil), "gpmv1.InstallServiceIn")
-	proto.RegisterType((*InstallServiceResult)(nil), "gpmv1.InstallServiceResult")
-	proto.RegisterType((*UpgradeServiceIn)(nil), "gpmv1.UpgradeServiceIn")
-	proto.RegisterType((*UpgradeServiceResult)(nil), "gpmv1.UpgradeServiceResult")
-	proto.RegisterType((*ServiceLog)(nil), "gpmv1.ServiceLog")
-	proto.RegisterType((*ServiceVersion)(nil), "gpmv1.ServiceVersion")
-	proto.RegisterType((*FileInfo)(nil), "gpmv1.FileInfo")
-	proto.RegisterType((*UpdateIn)(nil), "gpmv1.UpdateIn")
-	proto.RegisterType((*UpdateResult)(nil), "gpmv1.UpdateResult")
-	proto.RegisterType((*ExecIn)(nil), "gpmv1.ExecIn")
-	proto.RegisterMapType((map[string]string)(nil), "gpmv1.ExecIn.EnvEntry")
-	proto.RegisterType((*ExecResult)(nil), "gpmv1.ExecResult")
-	proto.RegisterType((*PullResult)(nil), "gpmv1.PullResult")
-	proto.RegisterType((*PushIn)(nil), "gpmv1.PushIn")
-	proto.RegisterType((*TerminalIn)(nil), "gpmv1.TerminalIn")
-	proto.RegisterMapType((map[string]string)(nil), "gpmv1.TerminalIn.EnvEntry")
-	proto.RegisterType((*TerminalResult)(nil), "gpmv1.TerminalResult")
+type UpgradeServiceIn struct {
+	// +gen:required
+	Spec *UpgradeSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// +gen:required
+	Pack *Package `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("github.com/vine-io/gpm/api/types/gpm/v1/gpm.proto", fileDescriptor_445ca262c078f1a5)
+func (m *UpgradeServiceIn) Reset()         { *m = UpgradeServiceIn{} }
+func (m *UpgradeServiceIn) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceIn) ProtoMessage()    {}
+func (*UpgradeServiceIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{26}
 }
-
-var fileDescriptor_445ca262c078f1a5 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xd7, 0xde, 0x3f, 0x79, 0x1b, 0xa5, 0xc5, 0x2a, 0xa9, 0x09, 0x68, 0xbb, 0xb2, 0x50,
-	0xb5, 0xa0, 0x36, 0x51, 0x0a, 0x42, 0x15, 0xbd, 0x00, 0x22, 0x45, 0x2b, 0x90, 0x88, 0x26, 0x29,
-	0x07, 0x84, 0x2a, 0xb9, 0xf6, 0xd4, 0x6b, 0x62, 0x7b, 0xac, 0x99, 0xf1, 0x2a, 0x0b, 0x77, 0xce,
-	0x9c, 0x38, 0x23, 0x4e, 0x9c, 0x38, 0x72, 0xe2, 0x03, 0x54, 0xe2, 0xd2, 0x23, 0x47, 0x68, 0xbf,
-	0x08, 0x9a, 0x37, 0xe3, 0xb5, 0x37, 0xbb, 0x49, 0x9b, 0x50, 0x4e, 0xfb, 0xde, 0x9b, 0xf7, 0x66,
-	0xde, 0xbc, 0xdf, 0xef, 0xcd, 0xf3, 0xc2, 0x5e, 0x9c, 0xc8, 0x49, 0xf9, 0x68, 0x27, 0x64, 0xd9,
-	0xee, 0x34, 0xc9, 0xe9, 0xed, 0x84, 0xed, 0xc6, 0x45, 0xb6, 0x1b, 0x14, 0xc9, 0xae, 0x9c, 0x15,
-	0x54, 0xa0, 0x36, 0xdd, 0x53, 0x3f, 0x3b, 0x05, 0x67, 0x92, 0xb9, 0xed, 0xb8, 0xc8, 0xa6, 0x7b,
-	0xfe, 0x9f, 0x0e, 0x74, 0x0f, 0x29, 0x9f, 0x26, 0x21, 0x75, 0x5d, 0x70, 0xf2, 0x20, 0xa3, 0x9e,
-	0x35, 0xb4, 0x46, 0xeb, 0x04, 0x65, 0xf7, 0x2a, 0xd8, 0x8f, 0x92, 0xdc, 0x6b, 0xa1, 0x49, 0x89,
-	0xca, 0x2b, 0xe0, 0xb1, 0xf0, 0xec, 0xa1, 0xad, 0xbc, 0x94, 0xac, 0xbc, 0x8a, 0x24, 0xf2, 0x9c,
-	0xa1, 0x35, 0xb2, 0x89, 0x12, 0x95, 0x25, 0x4a, 0xb8, 0xd7, 0xd6, 0x71, 0x51, 0xc2, 0xdd, 0x77,
-	0xc0, 0xa6, 0xf9, 0xd4, 0xeb, 0x0c, 0xed, 0x51, 0xff, 0xce, 0xf5, 0x1d, 0x3c, 0x7e, 0xc7, 0x1c,
-	0xbd, 0xb3, 0x9f, 0x4f, 0xf7, 0x73, 0xc9, 0x67, 0x44, 0xf9, 0xb8, 0xef, 0x43, 0x5f, 0xcc, 0xc4,
-	0x01, 0x67, 0xe1, 0xc7, 0x52, 0x72, 0xaf, 0x3b, 0xb4, 0x46, 0xfd, 0x3b, 0x6e, 0x15, 0x52, 0xaf,
-	0x90, 0xa6, 0x9b, 0x3b, 0x04, 0x3b, 0x65, 0xb1, 0xd7, 0x43, 0xef, 0x4d, 0xe3, 0xad, 0x56, 0xbf,
-	0x60, 0x31, 0x51, 0x4b, 0xae, 0x07, 0xdd, 0x29, 0xe5, 0x22, 0x61, 0xb9, 0xb7, 0x8e, 0x89, 0x55,
-	0xaa, 0x3b, 0x84, 0x7e, 0x50, 0x4a, 0x46, 0xa8, 0x90, 0x01, 0x97, 0x1e, 0x0c, 0xad, 0x51, 0x9b,
-	0x34, 0x4d, 0xca, 0x23, 0xc9, 0x85, 0x0c, 0xd2, 0xf4, 0x7e, 0x1a, 0xc4, 0x5e, 0x5f, 0x7b, 0x34,
-	0x4c, 0xee, 0x2d, 0x78, 0x2d, 0xe4, 0x34, 0x90, 0x09, 0xcb, 0x8f, 0x92, 0x4c, 0x85, 0x65, 0x85,
-	0xf7, 0x3a, 0x96, 0x64, 0x79, 0xc1, 0x1d, 0xc1, 0x95, 0xb2, 0x88, 0x02, 0x49, 0x6b, 0xdf, 0x2d,
-	0xf4, 0x3d, 0x6d, 0x76, 0x6f, 0xc2, 0x26, 0xa6, 0x50, 0x3b, 0x5e, 0x47, 0xc7, 0x53, 0x56, 0x77,
-	0x0b, 0x3a, 0x42, 0x06, 0xb2, 0x14, 0x9e, 0x87, 0x97, 0x33, 0x9a, 0x82, 0x22, 0x13, 0xb1, 0xf7,
-	0x86, 0x86, 0x22, 0x13, 0xb1, 0x7b, 0x03, 0x1c, 0xb5, 0xe6, 0x6d, 0x63, 0xa9, 0xfa, 0x55, 0x61,
-	0x65, 0x20, 0x09, 0x2e, 0x6c, 0x7f, 0x00, 0xbd, 0x0a, 0x11, 0x15, 0x7e, 0x4c, 0x67, 0x86, 0x14,
-	0x4a, 0x74, 0xaf, 0x41, 0x7b, 0x1a, 0xa4, 0x25, 0x35, 0xac, 0xd0, 0xca, 0x87, 0xad, 0xbb, 0x96,
-	0x2f, 0xa0, 0xdf, 0x80, 0x47, 0x65, 0x14, 0x4e, 0x38, 0x63, 0xd2, 0x44, 0x1b, 0x4d, 0x6d, 0x59,
-	0x26, 0x11, 0x86, 0xb7, 0x89, 0x12, 0x15, 0xa9, 0x4a, 0x41, 0xb9, 0x67, 0x6b, 0xea, 0x29, 0x59,
-	0x79, 0xc5, 0x86, 0x54, 0x6d, 0xa2, 0x44, 0x75, 0x70, 0xcc, 0x59, 0x59, 0x18, 0x5a, 0x69, 0xc5,
-	0xff, 0xc5, 0x86, 0xbe, 0xe1, 0xd1, 0x61, 0x41, 0xc3, 0xff, 0x46, 0x63, 0x45, 0x5a, 0xa7, 0x26,
-	0xed, 0x6d, 0x4d, 0xda, 0x36, 0x92, 0xf6, 0xcd, 0x45, 0xd2, 0xaa, 0xc3, 0xce, 0x27, 0x6e, 0xe7,
-	0x42, 0xc4, 0xed, 0xbe, 0x14, 0x71, 0x7b, 0xe7, 0x12, 0x77, 0x7d, 0x99, 0xb8, 0xef, 0xc2, 0xd5,
-	0x09, 0x0d, 0x22, 0xca, 0x8f, 0x78, 0x92, 0x1d, 0x70, 0xfa, 0x38, 0x39, 0x41, 0x7e, 0xaf, 0x93,
-	0x25, 0xfb, 0x8b, 0x49, 0x7e, 0x69, 0x66, 0xc4, 0xd0, 0x7f, 0x50, 0xc4, 0x3c, 0x88, 0xce, 0xc6,
-	0xa8, 0x71, 0xc9, 0xd6, 0xe2, 0x25, 0x57, 0x5d, 0xc1, 0x5e, 0x7d, 0x05, 0xff, 0xb7, 0x16, 0x5c,
-	0xd9, 0x8f, 0x12, 0xd9, 0x64, 0x84, 0x41, 0xdf, 0x5a, 0x46, 0xbf, 0xb5, 0x8c, 0xbe, 0x5d, 0xa3,
-	0xbf, 0xa7, 0xd1, 0x77, 0x10, 0xfd, 0x1b, 0x06, 0x98, 0x53, 0x9b, 0x9f, 0xcf, 0x80, 0xf6, 0x85,
-	0x18, 0xd0, 0x39, 0x9b, 0x01, 0xa7, 0x70, 0xee, 0x2e, 0xe1, 0x7c, 0x69, 0x64, 0xee, 0x41, 0xd7,
-	0x9c, 0xa4, 0xfa, 0x95, 0x9e, 0x14, 0x09, 0xd7, 0xb8, 0xb4, 0x89, 0xd1, 0x14, 0x32, 0x59, 0x70,
-	0x72, 0x98, 0x7c, 0xa7, 0xc3, 0x6d, 0x52, 0xa9, 0xfe, 0x43, 0x70, 0xd4, 0xb3, 0xe1, 0x0e, 0x00,
-	0xc2, 0xa2, 0x3c, 0xa0, 0x3c, 0xa4, 0xb9, 0xee, 0x76, 0x8b, 0x34, 0x2c, 0x6a, 0xe7, 0x8c, 0x66,
-	0x8c, 0xcf, 0x70, 0x03, 0x87, 0x18, 0x4d, 0xc5, 0x65, 0x34, 0xab, 0xe2, 0x54, 0xe9, 0x5b, 0xa4,
-	0x61, 0xf1, 0x7f, 0xb5, 0xa0, 0xfb, 0x59, 0x91, 0x8d, 0xf3, 0xc7, 0xac, 0xc9, 0x0f, 0x6b, 0x91,
-	0x1f, 0x2e, 0x38, 0x31, 0x63, 0xc2, 0x34, 0x2e, 0xca, 0x1a, 0xe1, 0x70, 0x62, 0x9e, 0x0a, 0x94,
-	0xf1, 0x45, 0x61, 0x53, 0x2c, 0xf3, 0x3a, 0x51, 0x62, 0x35, 0xb8, 0x74, 0x39, 0x71, 0x70, 0x55,
-	0x6f, 0x63, 0xef, 0x8c, 0xb7, 0x51, 0x5d, 0xa5, 0x2c, 0xd4, 0xab, 0x8b, 0xcd, 0x66, 0x13, 0xa3,
-	0xf9, 0xdf, 0x43, 0xf7, 0x20, 0x08, 0x8f, 0x83, 0x18, 0xeb, 0x55, 0x68, 0xb1, 0xca, 0xd4, 0xa8,
-	0x0a, 0x06, 0xc9, 0x64, 0x90, 0x9a, 0x3a, 0x6a, 0x45, 0x59, 0xc3, 0x49, 0x99, 0x1f, 0x63, 0x01,
-	0x36, 0x88, 0x56, 0xd4, 0x41, 0x29, 0xcd, 0x63, 0x39, 0x31, 0x73, 0xd5, 0x68, 0xea, 0x66, 0x89,
-	0xf8, 0xf2, 0x18, 0x6f, 0xd6, 0x23, 0x28, 0xfb, 0x0f, 0xe1, 0xea, 0x58, 0x77, 0xa9, 0xa1, 0xe6,
-	0x38, 0x77, 0x6f, 0x82, 0x23, 0x0a, 0x1a, 0x62, 0x0a, 0x0d, 0x0e, 0xd6, 0xd4, 0x25, 0xb8, 0xee,
-	0xfa, 0xe0, 0xa8, 0xf4, 0x30, 0xa5, 0x06, 0xfb, 0x74, 0xc6, 0x04, 0xd7, 0xfc, 0x8f, 0xe0, 0xda,
-	0xe2, 0xfe, 0x84, 0x8a, 0x32, 0x95, 0xf3, 0x5c, 0xac, 0x3a, 0x17, 0x75, 0x1b, 0xca, 0x39, 0xe3,
-	0x15, 0xd5, 0x50, 0x51, 0x19, 0x56, 0x0f, 0xc0, 0x0b, 0x32, 0x6c, 0xbc, 0x13, 0x17, 0xcb, 0x70,
-	0x71, 0xff, 0x0b, 0x67, 0x78, 0x04, 0x60, 0x42, 0x55, 0x2f, 0xb8, 0xe0, 0x48, 0x7a, 0x52, 0x4d,
-	0x2e, 0x94, 0x57, 0xc7, 0xb9, 0x6f, 0xc1, 0xba, 0x9c, 0x8f, 0x66, 0x1b, 0xa1, 0xaa, 0x0d, 0xfe,
-	0x37, 0xb0, 0x69, 0x76, 0xfd, 0xaa, 0x66, 0xeb, 0x05, 0xde, 0xbe, 0xf3, 0x77, 0x9f, 0x42, 0xef,
-	0x7e, 0x92, 0x52, 0xec, 0x8f, 0x55, 0xfb, 0xba, 0xe0, 0x88, 0xba, 0x6d, 0x51, 0x56, 0xb6, 0x8c,
-	0x45, 0xb4, 0x9a, 0xb5, 0x4a, 0xc6, 0x0e, 0x67, 0x11, 0xb2, 0xda, 0x31, 0x1d, 0xae, 0x55, 0x75,
-	0xe7, 0xb1, 0xf8, 0xd4, 0x7c, 0xca, 0xf5, 0x88, 0x56, 0xfc, 0x9f, 0x2d, 0xe8, 0x3d, 0xc0, 0xef,
-	0x94, 0x71, 0x7e, 0x4e, 0x63, 0xfe, 0x4f, 0x74, 0x77, 0x7d, 0xd8, 0x88, 0x68, 0x91, 0xb2, 0xd9,
-	0x61, 0x12, 0xe7, 0x41, 0x8a, 0x1d, 0xdd, 0x23, 0x0b, 0x36, 0xff, 0x2e, 0x6c, 0xe8, 0x0c, 0x0d,
-	0x11, 0xe6, 0xe0, 0x59, 0x4d, 0xf0, 0xaa, 0xdd, 0x5b, 0x8d, 0x66, 0xfa, 0xc3, 0x82, 0xce, 0xfe,
-	0x09, 0x0d, 0xc7, 0x78, 0x01, 0x31, 0xa1, 0x69, 0x5a, 0x05, 0xa1, 0x52, 0x4d, 0x8a, 0x56, 0x3d,
-	0x29, 0x46, 0x7a, 0x52, 0xd8, 0x38, 0x29, 0xb6, 0xaa, 0x49, 0x81, 0x7b, 0x9c, 0x1a, 0x10, 0xd5,
-	0x97, 0x8e, 0xd3, 0xf8, 0xd2, 0x59, 0xf9, 0x5d, 0x73, 0xe9, 0x07, 0xfd, 0x6d, 0x00, 0x75, 0xb2,
-	0xb9, 0xf6, 0x16, 0x74, 0x38, 0x4a, 0x18, 0xbc, 0x41, 0x8c, 0xe6, 0xff, 0x64, 0x01, 0x1c, 0x94,
-	0x69, 0x5a, 0xb7, 0xc9, 0x12, 0x79, 0x5e, 0x05, 0x7a, 0xf3, 0xaa, 0xb7, 0x9b, 0x55, 0xdf, 0x86,
-	0xde, 0xe3, 0x24, 0x4f, 0xc4, 0x84, 0x46, 0x06, 0xbb, 0xb9, 0xee, 0xff, 0x60, 0x41, 0xe7, 0xa0,
-	0x14, 0x93, 0x71, 0x7e, 0xd6, 0x97, 0x5c, 0x24, 0xe4, 0xbc, 0xf6, 0x42, 0xd6, 0x69, 0xda, 0x2b,
-	0xd3, 0x74, 0x56, 0xa7, 0xd9, 0x5e, 0x49, 0xb2, 0x4e, 0x83, 0x06, 0xbf, 0x5b, 0x00, 0x47, 0x94,
-	0x67, 0x49, 0x1e, 0xa4, 0x9a, 0xe5, 0x21, 0xcb, 0xb2, 0x20, 0x8f, 0x2a, 0x96, 0x1b, 0xd5, 0xbd,
-	0xa5, 0xc1, 0x6f, 0x21, 0xf8, 0xdb, 0x06, 0xfc, 0x3a, 0xf2, 0x0c, 0x02, 0xd8, 0xab, 0x08, 0xe0,
-	0xbc, 0x0a, 0x02, 0x7c, 0x0b, 0x9b, 0xd5, 0xe9, 0x35, 0x09, 0x84, 0x8c, 0x58, 0x39, 0x27, 0x81,
-	0xd6, 0x8c, 0x9d, 0x72, 0xcd, 0x65, 0x6d, 0xa7, 0x9c, 0xd7, 0xa8, 0x19, 0x8c, 0x17, 0x7b, 0xc5,
-	0xa9, 0x8b, 0xf4, 0xc9, 0xe7, 0x4f, 0xfe, 0x19, 0xac, 0x3d, 0x79, 0x36, 0xb0, 0x9e, 0x3e, 0x1b,
-	0x58, 0x7f, 0x3f, 0x1b, 0x58, 0x3f, 0x3e, 0x1f, 0xac, 0x3d, 0x7d, 0x3e, 0x58, 0xfb, 0xeb, 0xf9,
-	0x60, 0xed, 0xeb, 0xdb, 0x2f, 0xf9, 0xbf, 0xf4, 0x1e, 0xd6, 0xec, 0x51, 0x07, 0xff, 0x9a, 0xbe,
-	0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xd9, 0x90, 0x07, 0xcf, 0x0e, 0x00, 0x00,
+func (m *UpgradeServiceIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Service) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Bin)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGpm(uint64(l))
-		}
-	}
-	if m.Pid != 0 {
-		n += 1 + sovGpm(uint64(m.Pid))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+func (m *UpgradeServiceIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SysProcAttr != nil {
-		l = m.SysProcAttr.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Log != nil {
-		l = m.Log.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.AutoRestart != 0 {
-		n += 1 + sovGpm(uint64(m.AutoRestart))
-	}
-	if m.InstallFlag != 0 {
-		n += 1 + sovGpm(uint64(m.InstallFlag))
-	}
-	if m.CreationTimestamp != 0 {
-		n += 2 + sovGpm(uint64(m.CreationTimestamp))
-	}
-	if m.UpdateTimestamp != 0 {
-		n += 2 + sovGpm(uint64(m.UpdateTimestamp))
-	}
-	if m.StartTimestamp != 0 {
-		n += 2 + sovGpm(uint64(m.StartTimestamp))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 2 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 2 + l + sovGpm(uint64(l))
-	}
-	if m.Stat != nil {
-		l = m.Stat.XSize()
-		n += 2 + l + sovGpm(uint64(l))
-	}
-	return n
+}
+func (m *UpgradeServiceIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceIn.Merge(m, src)
+}
+func (m *UpgradeServiceIn) XXX_Size() int {
+	return m.XSize()
+}
+func (m *UpgradeServiceIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceIn.DiscardUnknown(m)
 }
 
-func (m *SysProcAttr) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Chroot)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Uid != 0 {
-		n += 1 + sovGpm(uint64(m.Uid))
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Gid != 0 {
-		n += 1 + sovGpm(uint64(m.Gid))
-	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_UpgradeServiceIn proto.InternalMessageInfo
+
+type UpgradeServiceResult struct {
+	IsOk  bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// 升级过程中的提示信息
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// 升级失败后回滚到的版本
+	Rollback string `protobuf:"bytes,4,opt,name=rollback,proto3" json:"rollback,omitempty"`
 }
 
-func (m *ServiceSpec) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Bin)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGpm(uint64(l))
+func (m *UpgradeServiceResult) Reset()         { *m = UpgradeServiceResult{} }
+func (m *UpgradeServiceResult) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceResult) ProtoMessage()    {}
+func (*UpgradeServiceResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{27}
+}
+func (m *UpgradeServiceResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeServiceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
-		}
-	}
-	if m.SysProcAttr != nil {
-		l = m.SysProcAttr.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Log != nil {
-		l = m.Log.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.AutoRestart != 0 {
-		n += 1 + sovGpm(uint64(m.AutoRestart))
-	}
-	l = len(m.HeaderTrimPrefix)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.InstallFlag != 0 {
-		n += 1 + sovGpm(uint64(m.InstallFlag))
-	}
-	return n
+}
+func (m *UpgradeServiceResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceResult.Merge(m, src)
+}
+func (m *UpgradeServiceResult) XXX_Size() int {
+	return m.XSize()
+}
+func (m *UpgradeServiceResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceResult.DiscardUnknown(m)
 }
 
-func (m *UpgradeSpec) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.HeaderTrimPrefix)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_UpgradeServiceResult proto.InternalMessageInfo
+
+type ServiceLog struct {
+	Text  string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// 服务进程写入日志的时间
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 日志来源, stdout 或者 stderr
+	Stream string `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	// 日志所属的服务
+	Service string `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	// 日志所属的实例序号
+	Instance int32 `protobuf:"varint,6,opt,name=instance,proto3" json:"instance,omitempty"`
 }
 
-func (m *EditServiceSpec) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bin)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGpm(uint64(l))
-		}
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+func (m *ServiceLog) Reset()         { *m = ServiceLog{} }
+func (m *ServiceLog) String() string { return proto.CompactTextString(m) }
+func (*ServiceLog) ProtoMessage()    {}
+func (*ServiceLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{28}
+}
+func (m *ServiceLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SysProcAttr != nil {
-		l = m.SysProcAttr.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Log != nil {
-		l = m.Log.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.AutoRestart != 0 {
-		n += 1 + sovGpm(uint64(m.AutoRestart))
-	}
-	return n
+}
+func (m *ServiceLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceLog.Merge(m, src)
+}
+func (m *ServiceLog) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ServiceLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceLog.DiscardUnknown(m)
 }
 
-func (m *ProcLog) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Expire != 0 {
-		n += 1 + sovGpm(uint64(m.Expire))
-	}
-	if m.MaxSize != 0 {
-		n += 1 + sovGpm(uint64(m.MaxSize))
-	}
-	return n
+var xxx_messageInfo_ServiceLog proto.InternalMessageInfo
+
+// 查询服务日志的参数
+type LogOptions struct {
+	// 返回最后 number 行日志, 0 表示返回所有符合条件的日志
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// 持续返回新写入的日志
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// 只返回标准错误的日志
+	StderrOnly bool `protobuf:"varint,3,opt,name=stderrOnly,proto3" json:"stderrOnly,omitempty"`
+	// 只返回此时间 (unix 秒) 之后写入的日志
+	Since int64 `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	// 只返回此时间 (unix 秒) 之前写入的日志
+	Until int64 `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	// 只返回匹配此正则表达式的日志
+	Grep string `protobuf:"bytes,6,opt,name=grep,proto3" json:"grep,omitempty"`
+	// 查询单个服务时服务实例的序号, 合并多个服务的日志时返回所有实例的日志
+	Instance int32 `protobuf:"varint,7,opt,name=instance,proto3" json:"instance,omitempty"`
 }
 
-func (m *Stat) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CpuPercent != 0 {
-		n += 9
-	}
-	if m.Memory != 0 {
-		n += 1 + sovGpm(uint64(m.Memory))
-	}
-	if m.MemPercent != 0 {
-		n += 5
+func (m *LogOptions) Reset()         { *m = LogOptions{} }
+func (m *LogOptions) String() string { return proto.CompactTextString(m) }
+func (*LogOptions) ProtoMessage()    {}
+func (*LogOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{29}
+}
+func (m *LogOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LogOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogOptions.Merge(m, src)
+}
+func (m *LogOptions) XXX_Size() int {
+	return m.XSize()
+}
+func (m *LogOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogOptions.DiscardUnknown(m)
 }
 
-func (m *GpmInfo) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Goos)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Arch)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Gov)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Pid != 0 {
-		n += 1 + sovGpm(uint64(m.Pid))
-	}
-	if m.Stat != nil {
-		l = m.Stat.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.UpTime != 0 {
-		n += 1 + sovGpm(uint64(m.UpTime))
-	}
-	return n
+var xxx_messageInfo_LogOptions proto.InternalMessageInfo
+
+type ServiceVersion struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *Package) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Package)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Total != 0 {
-		n += 1 + sovGpm(uint64(m.Total))
-	}
-	l = len(m.Chunk)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Length != 0 {
-		n += 1 + sovGpm(uint64(m.Length))
-	}
-	if m.IsOk {
-		n += 2
+func (m *ServiceVersion) Reset()         { *m = ServiceVersion{} }
+func (m *ServiceVersion) String() string { return proto.CompactTextString(m) }
+func (*ServiceVersion) ProtoMessage()    {}
+func (*ServiceVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{30}
+}
+func (m *ServiceVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ServiceVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceVersion.Merge(m, src)
+}
+func (m *ServiceVersion) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ServiceVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceVersion.DiscardUnknown(m)
 }
 
-func (m *InstallServiceIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Spec != nil {
-		l = m.Spec.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Pack != nil {
-		l = m.Pack.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
-}
+var xxx_messageInfo_ServiceVersion proto.InternalMessageInfo
 
-func (m *InstallServiceResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsOk {
-		n += 2
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+type FileInfo struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Mode    string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	ModTime int64  `protobuf:"varint,4,opt,name=modTime,proto3" json:"modTime,omitempty"`
+	IsDir   bool   `protobuf:"varint,5,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
 }
 
-func (m *UpgradeServiceIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Spec != nil {
-		l = m.Spec.XSize()
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Pack != nil {
-		l = m.Pack.XSize()
-		n += 1 + l + sovGpm(uint64(l))
+func (m *FileInfo) Reset()         { *m = FileInfo{} }
+func (m *FileInfo) String() string { return proto.CompactTextString(m) }
+func (*FileInfo) ProtoMessage()    {}
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{31}
+}
+func (m *FileInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *FileInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileInfo.Merge(m, src)
+}
+func (m *FileInfo) XXX_Size() int {
+	return m.XSize()
+}
+func (m *FileInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileInfo.DiscardUnknown(m)
 }
 
-func (m *UpgradeServiceResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsOk {
-		n += 2
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+var xxx_messageInfo_FileInfo proto.InternalMessageInfo
+
+type UpdateIn struct {
+	// +gen:required
+	Version      string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Total        int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Chunk        []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Length       int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	IsOk         bool   `protobuf:"varint,5,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	DeploySignal bool   `protobuf:"varint,6,opt,name=deploySignal,proto3" json:"deploySignal,omitempty"`
 }
 
-func (m *ServiceLog) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovGpm(uint64(m.Timestamp))
+func (m *UpdateIn) Reset()         { *m = UpdateIn{} }
+func (m *UpdateIn) String() string { return proto.CompactTextString(m) }
+func (*UpdateIn) ProtoMessage()    {}
+func (*UpdateIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{32}
+}
+func (m *UpdateIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UpdateIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateIn.Merge(m, src)
+}
+func (m *UpdateIn) XXX_Size() int {
+	return m.XSize()
+}
+func (m *UpdateIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateIn.DiscardUnknown(m)
 }
 
-func (m *ServiceVersion) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovGpm(uint64(m.Timestamp))
-	}
-	return n
+var xxx_messageInfo_UpdateIn proto.InternalMessageInfo
+
+type UpdateResult struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	IsOk  bool   `protobuf:"varint,2,opt,name=isOk,proto3" json:"isOk,omitempty"`
 }
 
-func (m *FileInfo) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Size != 0 {
-		n += 1 + sovGpm(uint64(m.Size))
-	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.ModTime != 0 {
-		n += 1 + sovGpm(uint64(m.ModTime))
-	}
-	if m.IsDir {
-		n += 2
+func (m *UpdateResult) Reset()         { *m = UpdateResult{} }
+func (m *UpdateResult) String() string { return proto.CompactTextString(m) }
+func (*UpdateResult) ProtoMessage()    {}
+func (*UpdateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{33}
+}
+func (m *UpdateResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UpdateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResult.Merge(m, src)
+}
+func (m *UpdateResult) XXX_Size() int {
+	return m.XSize()
+}
+func (m *UpdateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResult.DiscardUnknown(m)
 }
 
-func (m *UpdateIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Total != 0 {
-		n += 1 + sovGpm(uint64(m.Total))
-	}
-	l = len(m.Chunk)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Length != 0 {
-		n += 1 + sovGpm(uint64(m.Length))
-	}
-	if m.IsOk {
-		n += 2
-	}
-	if m.DeploySignal {
-		n += 2
-	}
-	return n
+var xxx_messageInfo_UpdateResult proto.InternalMessageInfo
+
+type ExecIn struct {
+	// +gen:required
+	Shell string            `protobuf:"bytes,1,opt,name=shell,proto3" json:"shell,omitempty"`
+	Dir   string            `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Env   map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	User  string            `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Group string            `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	// 环境变量的来源, inherit: 继承 gpmd 的环境变量, clean: 不继承, 只传递 PATH 以及用户的 HOME, USER, LOGNAME
+	// +gen:enum=[inherit,clean]
+	// +gen:default=inherit
+	EnvMode string `protobuf:"bytes,6,opt,name=envMode,proto3" json:"envMode,omitempty"`
 }
 
-func (m *UpdateResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.IsOk {
-		n += 2
-	}
-	return n
+func (m *ExecIn) Reset()         { *m = ExecIn{} }
+func (m *ExecIn) String() string { return proto.CompactTextString(m) }
+func (*ExecIn) ProtoMessage()    {}
+func (*ExecIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{34}
 }
-
-func (m *ExecIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Shell)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+func (m *ExecIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
 }
-
-func (m *ExecResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+func (m *ExecIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecIn.Merge(m, src)
 }
-
-func (m *PullResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Total != 0 {
-		n += 1 + sovGpm(uint64(m.Total))
-	}
-	l = len(m.Chunk)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Length != 0 {
-		n += 1 + sovGpm(uint64(m.Length))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Finished {
-		n += 2
-	}
-	return n
+func (m *ExecIn) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ExecIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecIn.DiscardUnknown(m)
 }
 
-func (m *PushIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Dst)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Total != 0 {
-		n += 1 + sovGpm(uint64(m.Total))
-	}
-	l = len(m.Chunk)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.Length != 0 {
-		n += 1 + sovGpm(uint64(m.Length))
-	}
-	if m.IsOk {
-		n += 2
-	}
-	return n
+var xxx_messageInfo_ExecIn proto.InternalMessageInfo
+
+type ExecResult struct {
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *TerminalIn) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Command)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+func (m *ExecResult) Reset()         { *m = ExecResult{} }
+func (m *ExecResult) String() string { return proto.CompactTextString(m) }
+func (*ExecResult) ProtoMessage()    {}
+func (*ExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{35}
+}
+func (m *ExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	return n
+}
+func (m *ExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecResult.Merge(m, src)
+}
+func (m *ExecResult) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecResult.DiscardUnknown(m)
 }
 
-func (m *TerminalResult) XSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Stdout)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Stderr)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovGpm(uint64(l))
-	}
-	if m.IsOk {
-		n += 2
-	}
-	return n
+var xxx_messageInfo_ExecResult proto.InternalMessageInfo
+
+type PullResult struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total    int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Chunk    []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Length   int64  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Finished bool   `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
 }
 
-func sovGpm(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
+func (m *PullResult) Reset()         { *m = PullResult{} }
+func (m *PullResult) String() string { return proto.CompactTextString(m) }
+func (*PullResult) ProtoMessage()    {}
+func (*PullResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{36}
 }
-func sozGpm(x uint64) (n int) {
-	return sovGpm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PullResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullResult.Merge(m, src)
 }
-
-func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *PullResult) XXX_Size() int {
+	return m.XSize()
+}
+func (m *PullResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullResult proto.InternalMessageInfo
+
+type PushIn struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dst    string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	Total  int64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Chunk  []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Length int64  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	IsOk   bool   `protobuf:"varint,6,opt,name=isOk,proto3" json:"isOk,omitempty"`
+}
+
+func (m *PushIn) Reset()         { *m = PushIn{} }
+func (m *PushIn) String() string { return proto.CompactTextString(m) }
+func (*PushIn) ProtoMessage()    {}
+func (*PushIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{37}
+}
+func (m *PushIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PushIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushIn.Merge(m, src)
+}
+func (m *PushIn) XXX_Size() int {
+	return m.XSize()
+}
+func (m *PushIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushIn proto.InternalMessageInfo
+
+type TerminalIn struct {
+	// +gen:required
+	Command string            `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Env     map[string]string `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	User    string            `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Group   string            `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	// 环境变量的来源, inherit: 继承 gpmd 的环境变量, clean: 不继承, 只传递 PATH 以及用户的 HOME, USER, LOGNAME
+	// +gen:enum=[inherit,clean]
+	// +gen:default=inherit
+	EnvMode string `protobuf:"bytes,5,opt,name=envMode,proto3" json:"envMode,omitempty"`
+}
+
+func (m *TerminalIn) Reset()         { *m = TerminalIn{} }
+func (m *TerminalIn) String() string { return proto.CompactTextString(m) }
+func (*TerminalIn) ProtoMessage()    {}
+func (*TerminalIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{38}
+}
+func (m *TerminalIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminalIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminalIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalIn.Merge(m, src)
+}
+func (m *TerminalIn) XXX_Size() int {
+	return m.XSize()
+}
+func (m *TerminalIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminalIn proto.InternalMessageInfo
+
+type TerminalResult struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Error  []byte `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	IsOk   bool   `protobuf:"varint,4,opt,name=isOk,proto3" json:"isOk,omitempty"`
+}
+
+func (m *TerminalResult) Reset()         { *m = TerminalResult{} }
+func (m *TerminalResult) String() string { return proto.CompactTextString(m) }
+func (*TerminalResult) ProtoMessage()    {}
+func (*TerminalResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445ca262c078f1a5, []int{39}
+}
+func (m *TerminalResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminalResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalResult.Merge(m, src)
+}
+func (m *TerminalResult) XXX_Size() int {
+	return m.XSize()
+}
+func (m *TerminalResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminalResult proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Service)(nil), "gpmv1.Service")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.Service.EnvEntry")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.Service.LabelsEntry")
+	proto.RegisterType((*Instance)(nil), "gpmv1.Instance")
+	proto.RegisterType((*SysProcAttr)(nil), "gpmv1.SysProcAttr")
+	proto.RegisterType((*Rlimit)(nil), "gpmv1.Rlimit")
+	proto.RegisterType((*ServiceSpec)(nil), "gpmv1.ServiceSpec")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.ServiceSpec.EnvEntry")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.ServiceSpec.LabelsEntry")
+	proto.RegisterType((*UpgradeSpec)(nil), "gpmv1.UpgradeSpec")
+	proto.RegisterType((*EditServiceSpec)(nil), "gpmv1.EditServiceSpec")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.EditServiceSpec.EnvEntry")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.EditServiceSpec.LabelsEntry")
+	proto.RegisterType((*RestartPolicy)(nil), "gpmv1.RestartPolicy")
+	proto.RegisterType((*Probe)(nil), "gpmv1.Probe")
+	proto.RegisterType((*Hooks)(nil), "gpmv1.Hooks")
+	proto.RegisterType((*Hook)(nil), "gpmv1.Hook")
+	proto.RegisterType((*Socket)(nil), "gpmv1.Socket")
+	proto.RegisterType((*Reload)(nil), "gpmv1.Reload")
+	proto.RegisterType((*Secret)(nil), "gpmv1.Secret")
+	proto.RegisterType((*SecretSpec)(nil), "gpmv1.SecretSpec")
+	proto.RegisterType((*SecretRef)(nil), "gpmv1.SecretRef")
+	proto.RegisterType((*Threshold)(nil), "gpmv1.Threshold")
+	proto.RegisterType((*Resources)(nil), "gpmv1.Resources")
+	proto.RegisterType((*ExitStatus)(nil), "gpmv1.ExitStatus")
+	proto.RegisterType((*ServiceExit)(nil), "gpmv1.ServiceExit")
+	proto.RegisterType((*ProcLog)(nil), "gpmv1.ProcLog")
+	proto.RegisterType((*Stat)(nil), "gpmv1.Stat")
+	proto.RegisterType((*GpmInfo)(nil), "gpmv1.GpmInfo")
+	proto.RegisterMapType((map[string]*Resources)(nil), "gpmv1.GpmInfo.ResourcesEntry")
+	proto.RegisterType((*Package)(nil), "gpmv1.Package")
+	proto.RegisterType((*InstallServiceIn)(nil), "gpmv1.InstallServiceIn")
+	proto.RegisterType((*InstallServiceResult)(nil), "gpmv1.InstallServiceResult")
+	proto.RegisterType((*UpgradeServiceIn)(nil), "gpmv1.UpgradeServiceIn")
+	proto.RegisterType((*UpgradeServiceResult)(nil), "gpmv1.UpgradeServiceResult")
+	proto.RegisterType((*ServiceLog)(nil), "gpmv1.ServiceLog")
+	proto.RegisterType((*LogOptions)(nil), "gpmv1.LogOptions")
+	proto.RegisterType((*ServiceVersion)(nil), "gpmv1.ServiceVersion")
+	proto.RegisterType((*FileInfo)(nil), "gpmv1.FileInfo")
+	proto.RegisterType((*UpdateIn)(nil), "gpmv1.UpdateIn")
+	proto.RegisterType((*UpdateResult)(nil), "gpmv1.UpdateResult")
+	proto.RegisterType((*ExecIn)(nil), "gpmv1.ExecIn")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.ExecIn.EnvEntry")
+	proto.RegisterType((*ExecResult)(nil), "gpmv1.ExecResult")
+	proto.RegisterType((*PullResult)(nil), "gpmv1.PullResult")
+	proto.RegisterType((*PushIn)(nil), "gpmv1.PushIn")
+	proto.RegisterType((*TerminalIn)(nil), "gpmv1.TerminalIn")
+	proto.RegisterMapType((map[string]string)(nil), "gpmv1.TerminalIn.EnvEntry")
+	proto.RegisterType((*TerminalResult)(nil), "gpmv1.TerminalResult")
+}
+
+func init() {
+	proto.RegisterFile("github.com/vine-io/gpm/api/types/gpm/v1/gpm.proto", fileDescriptor_445ca262c078f1a5)
+}
+
+var fileDescriptor_445ca262c078f1a5 = []byte{
+	// 2982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xd7, 0xec, 0xec, 0xb3, 0x96, 0xa4, 0xa8, 0xb1, 0x4c, 0x8d, 0x29, 0x99, 0xa2, 0xe7, 0x2f,
+	0xcb, 0x94, 0x6c, 0x52, 0x96, 0xfe, 0x86, 0xe0, 0xd8, 0x97, 0xf8, 0x41, 0x27, 0x44, 0x64, 0x8b,
+	0x18, 0xca, 0x31, 0x10, 0x04, 0x06, 0x86, 0x33, 0xcd, 0xdd, 0x31, 0x67, 0xa7, 0x07, 0xdd, 0xb3,
+	0xeb, 0x65, 0x72, 0x0f, 0x02, 0x04, 0x01, 0x72, 0x08, 0x92, 0x1c, 0x83, 0x5c, 0x82, 0x20, 0x5f,
+	0x20, 0xf9, 0x06, 0xbe, 0x18, 0xf1, 0x21, 0x08, 0x72, 0x4c, 0xec, 0x7c, 0x90, 0xa0, 0xaa, 0x7b,
+	0x5e, 0xfb, 0x24, 0xe5, 0x18, 0xc9, 0x89, 0x5d, 0xd5, 0x35, 0x33, 0xd5, 0x55, 0xd5, 0xbf, 0xfe,
+	0x75, 0x2d, 0xe1, 0x7e, 0x2f, 0x4c, 0xfb, 0xc3, 0xe3, 0x3d, 0x9f, 0x0f, 0xee, 0x8d, 0xc2, 0x98,
+	0xed, 0x86, 0xfc, 0x5e, 0x2f, 0x19, 0xdc, 0xf3, 0x92, 0xf0, 0x5e, 0x7a, 0x96, 0x30, 0x49, 0xd2,
+	0xe8, 0x3e, 0xfe, 0xd9, 0x4b, 0x04, 0x4f, 0xb9, 0xd5, 0xe8, 0x25, 0x83, 0xd1, 0x7d, 0xe7, 0x97,
+	0x6b, 0xd0, 0x3a, 0x62, 0x62, 0x14, 0xfa, 0xcc, 0xb2, 0xa0, 0x1e, 0x7b, 0x03, 0x66, 0x1b, 0xdb,
+	0xc6, 0x4e, 0xc7, 0xa5, 0xb1, 0xb5, 0x0e, 0xe6, 0x71, 0x18, 0xdb, 0x35, 0x52, 0xe1, 0x10, 0xad,
+	0x3c, 0xd1, 0x93, 0xb6, 0xb9, 0x6d, 0xa2, 0x15, 0x8e, 0xd1, 0x2a, 0x09, 0x03, 0xbb, 0xbe, 0x6d,
+	0xec, 0x98, 0x2e, 0x0e, 0xad, 0x5b, 0xb0, 0x9a, 0x84, 0xc1, 0x3b, 0x82, 0x79, 0x29, 0x7b, 0x12,
+	0x0e, 0x98, 0xbd, 0x43, 0x73, 0x55, 0xa5, 0xb5, 0x01, 0xcd, 0x24, 0x0c, 0xf6, 0xc7, 0xcc, 0xbe,
+	0x43, 0x1f, 0xd0, 0x12, 0xbe, 0x2f, 0x08, 0x85, 0xdd, 0x50, 0x5f, 0x0d, 0x42, 0x61, 0xdd, 0x01,
+	0x93, 0xc5, 0x23, 0xbb, 0xb9, 0x6d, 0xee, 0x74, 0x1f, 0x5c, 0xdb, 0x23, 0xe7, 0xf7, 0xb4, 0xe3,
+	0x7b, 0xfb, 0xf1, 0x68, 0x3f, 0x4e, 0xc5, 0x99, 0x8b, 0x36, 0x96, 0x0d, 0x2d, 0x16, 0x8f, 0xde,
+	0xe7, 0x01, 0xb3, 0x77, 0xe9, 0x05, 0x99, 0x68, 0x6d, 0x42, 0x9b, 0xc5, 0xa3, 0xf7, 0xc2, 0x88,
+	0x49, 0x7b, 0x8f, 0xdc, 0xcf, 0x65, 0xeb, 0x35, 0xe8, 0xca, 0x33, 0x79, 0x28, 0xb8, 0xff, 0x56,
+	0x9a, 0x0a, 0xbb, 0xb5, 0x6d, 0xec, 0x74, 0x1f, 0x58, 0xd9, 0x87, 0x8a, 0x19, 0xb7, 0x6c, 0x66,
+	0x6d, 0x83, 0x19, 0xf1, 0x9e, 0xdd, 0x26, 0xeb, 0x35, 0x6d, 0x8d, 0xb3, 0x8f, 0x78, 0xcf, 0xc5,
+	0x29, 0xf4, 0x66, 0xc4, 0x84, 0x0c, 0x79, 0x6c, 0x77, 0x94, 0x37, 0x5a, 0xb4, 0xb6, 0xa1, 0xeb,
+	0x0d, 0x53, 0xee, 0x32, 0x99, 0x7a, 0x22, 0xb5, 0x61, 0xdb, 0xd8, 0x69, 0xb8, 0x65, 0x15, 0x5a,
+	0x84, 0xb1, 0x4c, 0xbd, 0x28, 0x7a, 0x2f, 0xf2, 0x7a, 0x76, 0x57, 0x59, 0x94, 0x54, 0xd6, 0x1b,
+	0xb0, 0x2a, 0x94, 0xf1, 0x21, 0x8f, 0x42, 0xff, 0xcc, 0x5e, 0x21, 0x4f, 0xae, 0x6a, 0x4f, 0xdc,
+	0xf2, 0x9c, 0x5b, 0x35, 0xb5, 0x76, 0xa1, 0x1d, 0x79, 0x32, 0xdd, 0x1f, 0x87, 0xa9, 0xbd, 0x4a,
+	0x8f, 0x5d, 0xd1, 0x8f, 0xa1, 0xea, 0x28, 0xf5, 0xd2, 0xa1, 0x74, 0x73, 0x13, 0x6b, 0x0b, 0x40,
+	0xa6, 0x3c, 0x39, 0x0a, 0x7b, 0xb1, 0x17, 0xd9, 0x6b, 0xb4, 0x96, 0x92, 0x06, 0x9d, 0x45, 0x09,
+	0xf3, 0xca, 0x87, 0xa9, 0x7d, 0x99, 0xf2, 0x5d, 0x56, 0x61, 0xf8, 0x4f, 0xc3, 0x28, 0xa2, 0xcc,
+	0xac, 0xd3, 0xf3, 0xb9, 0x6c, 0x3d, 0x80, 0xd5, 0x28, 0x1c, 0xb1, 0x98, 0x49, 0x0c, 0xee, 0x31,
+	0xb3, 0xaf, 0x90, 0x47, 0x2b, 0x45, 0x48, 0x8f, 0x99, 0x5b, 0x35, 0xb1, 0x5e, 0x83, 0x35, 0xc1,
+	0xbc, 0x20, 0x2c, 0x1e, 0xb2, 0x66, 0x3c, 0x34, 0x61, 0x63, 0xdd, 0x80, 0x4e, 0xc0, 0x12, 0x16,
+	0x07, 0xf2, 0x71, 0x6c, 0x3f, 0x43, 0x55, 0x50, 0x28, 0xd0, 0x47, 0xc1, 0x92, 0x28, 0xf4, 0x3d,
+	0x69, 0x5f, 0xa5, 0x78, 0xe7, 0xb2, 0xb5, 0x07, 0x1d, 0xc1, 0x24, 0x1f, 0x0a, 0x9f, 0x49, 0x7b,
+	0x8b, 0x3e, 0xb5, 0x5e, 0x04, 0x5a, 0xe9, 0xdd, 0xc2, 0xc4, 0x72, 0xa0, 0xd1, 0xe7, 0xfc, 0x54,
+	0xda, 0xdb, 0x15, 0xb7, 0xbe, 0x8b, 0x3a, 0x57, 0x4d, 0xe1, 0x6e, 0xc2, 0x0d, 0x6a, 0xbf, 0xa0,
+	0xf6, 0x1c, 0x8e, 0xd1, 0x07, 0xe9, 0xf7, 0x59, 0x30, 0x8c, 0x98, 0xed, 0xa8, 0x38, 0x65, 0xb2,
+	0xf5, 0x0a, 0x5c, 0xf1, 0x79, 0xec, 0x0f, 0x85, 0x60, 0xb1, 0x7f, 0xa6, 0x93, 0xfe, 0x7f, 0x64,
+	0x34, 0x3d, 0x81, 0xc5, 0x97, 0x84, 0x01, 0x16, 0xb8, 0x7d, 0x5b, 0x15, 0x9f, 0x16, 0xad, 0xdb,
+	0xb0, 0xa6, 0x87, 0x59, 0xc2, 0x5e, 0xa2, 0x84, 0x4d, 0x68, 0xad, 0x17, 0xa1, 0x29, 0x58, 0xc4,
+	0xbd, 0xc0, 0xbe, 0x4b, 0x8b, 0x58, 0xcd, 0x17, 0x8c, 0x4a, 0x57, 0x4f, 0x5a, 0x2f, 0x41, 0x4b,
+	0x72, 0xff, 0x94, 0xa5, 0xd2, 0x7e, 0x79, 0xdb, 0x2c, 0xd9, 0x1d, 0x91, 0xd6, 0xcd, 0x66, 0x31,
+	0x86, 0x69, 0x5f, 0x30, 0xd9, 0xe7, 0x51, 0x60, 0xbf, 0x52, 0x89, 0xe1, 0x93, 0x4c, 0xef, 0x16,
+	0x26, 0xd6, 0x5d, 0x68, 0x49, 0xe6, 0x0b, 0x7c, 0xf1, 0xbd, 0x6d, 0xb3, 0x64, 0x7d, 0x44, 0x5a,
+	0x97, 0x9d, 0xb8, 0x99, 0x81, 0xf5, 0x00, 0x9a, 0x91, 0x77, 0xcc, 0x22, 0x69, 0xbf, 0x4a, 0xa6,
+	0x9b, 0x13, 0x30, 0xf1, 0x88, 0x26, 0x15, 0x52, 0x68, 0x4b, 0x8a, 0x27, 0xe2, 0x51, 0xc8, 0x63,
+	0x5c, 0xb2, 0x4c, 0xbd, 0x41, 0x62, 0x3f, 0x4b, 0xa1, 0x98, 0x9e, 0xb0, 0x76, 0xe0, 0xf2, 0x30,
+	0x09, 0x34, 0x7a, 0x29, 0xdb, 0x0d, 0xb2, 0x9d, 0x54, 0x63, 0x7c, 0x69, 0xaf, 0x15, 0x86, 0xd7,
+	0x54, 0x7c, 0xab, 0x5a, 0x44, 0x40, 0x49, 0x3b, 0xcd, 0xb6, 0x15, 0x02, 0x2a, 0x09, 0x11, 0x70,
+	0x20, 0x7b, 0xf6, 0x73, 0xa4, 0xc4, 0xa1, 0x75, 0x13, 0xea, 0x38, 0x67, 0x6f, 0x52, 0xd0, 0xba,
+	0xd9, 0xda, 0x52, 0x2f, 0x75, 0x69, 0x02, 0x5f, 0xd5, 0x67, 0x5e, 0x94, 0xf6, 0xed, 0xeb, 0xea,
+	0x55, 0x4a, 0xc2, 0x82, 0x57, 0xa3, 0xf7, 0x65, 0xcf, 0xbe, 0x41, 0x53, 0x85, 0xc2, 0xda, 0x85,
+	0x0e, 0x01, 0x4a, 0x8c, 0x45, 0xfd, 0x3c, 0xc5, 0xed, 0xb2, 0x7e, 0xf7, 0x81, 0xd6, 0xbb, 0x85,
+	0x85, 0xda, 0x1f, 0xb4, 0x06, 0x69, 0xdf, 0xcc, 0xf6, 0x87, 0x92, 0xad, 0xbb, 0xb0, 0x8e, 0x68,
+	0xe1, 0x0e, 0x4b, 0xa1, 0xbc, 0x45, 0xab, 0x9e, 0xd2, 0xa3, 0x6d, 0xcc, 0xc6, 0x55, 0xdb, 0x17,
+	0x95, 0xed, 0xa4, 0x7e, 0xf3, 0x21, 0xb4, 0x33, 0x84, 0xc7, 0xb8, 0x9c, 0xb2, 0x33, 0x7d, 0x44,
+	0xe1, 0xd0, 0xba, 0x0a, 0x8d, 0x91, 0x17, 0x0d, 0x99, 0x3e, 0xa3, 0x94, 0xf0, 0x46, 0xed, 0x75,
+	0x63, 0xf3, 0x5b, 0xd0, 0x2d, 0xa5, 0xfc, 0x22, 0x8f, 0x3a, 0xff, 0xaa, 0x41, 0x3b, 0x5b, 0x3e,
+	0x9a, 0x85, 0x71, 0xc0, 0xc6, 0xf4, 0x68, 0xc3, 0x55, 0x42, 0x76, 0xe6, 0xd5, 0x8a, 0x33, 0x6f,
+	0x3a, 0xe7, 0xe6, 0x92, 0x9c, 0xd7, 0x67, 0xe5, 0xbc, 0x51, 0xe4, 0xbc, 0x48, 0x69, 0x73, 0x7e,
+	0x4a, 0x5b, 0xd3, 0x29, 0x2d, 0x80, 0xbd, 0xbd, 0x1c, 0xd8, 0xb3, 0xc2, 0xea, 0xcc, 0x2b, 0xac,
+	0x72, 0xce, 0x61, 0x22, 0xe7, 0x53, 0xe7, 0x7c, 0x77, 0xf1, 0x39, 0xbf, 0x52, 0x3e, 0xe7, 0x9d,
+	0xbf, 0x98, 0xd0, 0x2d, 0x9d, 0xad, 0x68, 0xe7, 0xf7, 0x05, 0xe7, 0xa9, 0xce, 0x92, 0x96, 0x30,
+	0x32, 0x43, 0x1d, 0xeb, 0x86, 0x8b, 0x43, 0xc4, 0xcd, 0xa1, 0x64, 0x82, 0x22, 0xdc, 0x71, 0x69,
+	0x8c, 0x56, 0x3d, 0xcd, 0x42, 0x1a, 0x2e, 0x0e, 0x31, 0x73, 0x3d, 0xc1, 0x87, 0x89, 0x8e, 0xa9,
+	0x12, 0xac, 0x7b, 0xd0, 0x8d, 0xc2, 0x41, 0x98, 0x7e, 0xc0, 0x4f, 0x10, 0x19, 0x9b, 0x55, 0x60,
+	0xa3, 0x29, 0xb7, 0x6c, 0x61, 0xed, 0x02, 0x28, 0x31, 0x11, 0xdc, 0xb7, 0x5b, 0xb3, 0xec, 0x4b,
+	0x06, 0xd6, 0xcb, 0xd0, 0x21, 0xe9, 0x1d, 0x2e, 0x98, 0xdd, 0x9e, 0x65, 0x5d, 0xcc, 0x5b, 0xf7,
+	0x61, 0x85, 0x84, 0xf7, 0xd9, 0x20, 0xe2, 0xfe, 0xa9, 0xdd, 0x99, 0x65, 0x5f, 0x31, 0x21, 0x9e,
+	0x16, 0xfa, 0x4c, 0xe7, 0x82, 0xc6, 0x44, 0x15, 0x38, 0x8e, 0xde, 0x89, 0x3c, 0x29, 0x29, 0x0b,
+	0x1d, 0xb7, 0xac, 0x2a, 0x2c, 0x1e, 0xb1, 0x11, 0x8b, 0xec, 0x15, 0x4d, 0x26, 0x0a, 0x15, 0x5a,
+	0xf8, 0xc9, 0xf0, 0xad, 0x93, 0x93, 0x30, 0x0e, 0xd3, 0x33, 0x7b, 0x75, 0xdb, 0x44, 0x8b, 0x92,
+	0x0a, 0x2d, 0x38, 0x1f, 0x1c, 0xf9, 0x5c, 0xb0, 0xb7, 0x82, 0x4f, 0x88, 0x04, 0x34, 0xdc, 0xb2,
+	0xca, 0x79, 0x15, 0x9a, 0xca, 0x67, 0xf4, 0x52, 0xf2, 0x13, 0x95, 0x49, 0xd3, 0xa5, 0x31, 0xea,
+	0xfa, 0x9e, 0xc8, 0x36, 0x0d, 0x8d, 0x9d, 0x3f, 0x00, 0x74, 0x35, 0x42, 0x1f, 0x25, 0xcc, 0xff,
+	0x7a, 0x2c, 0x14, 0x59, 0x63, 0xbd, 0x60, 0x8d, 0xbb, 0x8a, 0x35, 0x36, 0x08, 0xd6, 0xae, 0x57,
+	0x8f, 0x03, 0xfc, 0xd8, 0x7c, 0xe6, 0xb8, 0x35, 0x9f, 0x39, 0xde, 0x5c, 0xcc, 0x1c, 0x9b, 0x17,
+	0x62, 0x8e, 0xad, 0x73, 0x31, 0xc7, 0xf6, 0x42, 0xe6, 0xd8, 0x99, 0x66, 0x8e, 0x77, 0x61, 0xbd,
+	0xcf, 0xbc, 0x80, 0x89, 0x27, 0x22, 0x1c, 0x1c, 0x0a, 0x76, 0x12, 0x8e, 0xa9, 0x5c, 0x3a, 0xee,
+	0x94, 0xfe, 0x1b, 0x66, 0x99, 0x55, 0xda, 0xb8, 0xba, 0x8c, 0x36, 0xae, 0x2d, 0xa6, 0x8d, 0x97,
+	0x97, 0xd1, 0xc6, 0xf5, 0xa7, 0xa1, 0x8d, 0x57, 0x2e, 0x4a, 0x1b, 0xad, 0x45, 0xb4, 0xf1, 0x99,
+	0x45, 0xb4, 0xf1, 0xea, 0x05, 0x68, 0xe3, 0xb3, 0xcb, 0x69, 0xe3, 0xc6, 0x1c, 0xda, 0x78, 0xed,
+	0x3c, 0xb4, 0xd1, 0x3e, 0x07, 0x6d, 0x7c, 0x6e, 0x19, 0x6d, 0xdc, 0x5c, 0x42, 0x1b, 0xaf, 0x9f,
+	0x93, 0x36, 0xde, 0x38, 0x3f, 0x6d, 0x7c, 0xfe, 0x42, 0xb4, 0x71, 0x7b, 0x19, 0x6d, 0x7c, 0x98,
+	0xd3, 0xc6, 0x17, 0xc8, 0x74, 0x6b, 0x06, 0x4e, 0xcc, 0xa0, 0x8e, 0xff, 0x0d, 0x5a, 0xf2, 0x73,
+	0x03, 0xba, 0x1f, 0x26, 0x3d, 0xe1, 0x05, 0xf3, 0xb1, 0xb2, 0x04, 0x1b, 0xb5, 0x2a, 0x6c, 0xcc,
+	0x02, 0x05, 0x73, 0x0e, 0x28, 0xdc, 0x82, 0xd5, 0x11, 0x13, 0xe1, 0xc9, 0x59, 0x96, 0x67, 0x75,
+	0xb7, 0xaf, 0x2a, 0x9d, 0x3f, 0x77, 0xe0, 0xf2, 0x7e, 0x10, 0xa6, 0x65, 0xfc, 0xd6, 0x58, 0x6d,
+	0x4c, 0x63, 0x75, 0x6d, 0x1a, 0xab, 0xcd, 0x02, 0xab, 0xef, 0x2b, 0xac, 0xae, 0x53, 0x0e, 0x6e,
+	0x66, 0x84, 0xa5, 0xfa, 0xf2, 0xf9, 0x78, 0xbd, 0x39, 0x1f, 0xaf, 0xaf, 0x2f, 0xc6, 0xeb, 0xc6,
+	0x85, 0xf0, 0xba, 0x39, 0x1f, 0xaf, 0x27, 0x50, 0xb9, 0x35, 0x8d, 0xca, 0x53, 0x38, 0xda, 0x7e,
+	0x5a, 0x1c, 0xed, 0x2c, 0xc3, 0x51, 0x58, 0x8c, 0xa3, 0xdd, 0x65, 0x38, 0xba, 0xf2, 0x34, 0x38,
+	0xba, 0x7a, 0x51, 0x1c, 0x5d, 0x5b, 0x84, 0xa3, 0x97, 0x17, 0xe1, 0xe8, 0xfa, 0x05, 0x70, 0xf4,
+	0xca, 0x72, 0x1c, 0xb5, 0xe6, 0xe0, 0xe8, 0x33, 0xe7, 0xc1, 0xd1, 0xab, 0xe7, 0xc0, 0xd1, 0x67,
+	0x97, 0xe1, 0xe8, 0xc6, 0x12, 0x1c, 0xbd, 0x76, 0x4e, 0x1c, 0xb5, 0xcf, 0x8f, 0xa3, 0xcf, 0x5d,
+	0x08, 0x47, 0x6f, 0x2c, 0xc3, 0xd1, 0x37, 0x72, 0x1c, 0x55, 0xd7, 0x48, 0x67, 0xce, 0x1e, 0xfe,
+	0x1f, 0xc1, 0xd2, 0xdf, 0x1b, 0xb0, 0x5a, 0xd9, 0x71, 0x74, 0x4b, 0xa1, 0x51, 0x76, 0xfb, 0x50,
+	0x12, 0x26, 0x0b, 0xe9, 0x6f, 0xe8, 0x45, 0x6f, 0x7b, 0xfe, 0x29, 0x3f, 0x39, 0xd1, 0xfc, 0x75,
+	0x42, 0x8b, 0x5b, 0x74, 0xe0, 0x8d, 0x33, 0x1b, 0x75, 0xf7, 0x2b, 0x69, 0xf4, 0xbc, 0xcb, 0x52,
+	0x11, 0x32, 0xa9, 0xaf, 0x29, 0x25, 0x0d, 0x7e, 0xff, 0xd3, 0x30, 0x0e, 0xf8, 0xa7, 0x84, 0x49,
+	0xa6, 0xab, 0x25, 0xe7, 0x67, 0x35, 0x68, 0xa8, 0xcd, 0x93, 0x95, 0xab, 0x51, 0x2a, 0x57, 0xbc,
+	0x1b, 0x89, 0x28, 0xe3, 0xc6, 0x43, 0x11, 0x59, 0x0e, 0xac, 0xb0, 0x71, 0xc2, 0x7c, 0x7d, 0xd5,
+	0x23, 0x4f, 0x1a, 0x6e, 0x45, 0x87, 0xa5, 0xe9, 0x05, 0x81, 0x60, 0x32, 0xbb, 0x84, 0x66, 0x22,
+	0xce, 0xf8, 0x7c, 0x30, 0xf0, 0xe2, 0x80, 0x78, 0x73, 0xc7, 0xcd, 0x44, 0x7c, 0xaf, 0x5e, 0xf1,
+	0xbb, 0x2c, 0xf2, 0xce, 0x08, 0x0b, 0x4d, 0xb7, 0xa2, 0xc3, 0xcd, 0x13, 0xc6, 0x29, 0x13, 0x23,
+	0x2f, 0x22, 0x04, 0x34, 0xdd, 0x5c, 0xc6, 0x37, 0xa7, 0xba, 0xda, 0xdb, 0x34, 0x95, 0x89, 0x78,
+	0x32, 0x9d, 0x78, 0x61, 0x34, 0x14, 0x2c, 0xaf, 0x42, 0xcd, 0x6a, 0xa7, 0xf4, 0xce, 0x1f, 0x0d,
+	0x68, 0xd0, 0x1e, 0xb6, 0x5e, 0x82, 0x76, 0x22, 0xd8, 0x11, 0xa1, 0xad, 0x51, 0xb9, 0xbc, 0xe2,
+	0xbc, 0x9b, 0x4f, 0x5a, 0x77, 0xa0, 0x93, 0x70, 0x99, 0x2a, 0xcb, 0xda, 0xb4, 0x65, 0x31, 0x6b,
+	0xbd, 0x08, 0x2d, 0x7a, 0x8c, 0xab, 0xcb, 0xfb, 0x84, 0x61, 0x36, 0x47, 0x9f, 0xa6, 0x67, 0x78,
+	0x62, 0xd7, 0xa7, 0xed, 0xf2, 0x49, 0xe7, 0x57, 0x06, 0xd4, 0x51, 0x35, 0x33, 0x75, 0xa5, 0x50,
+	0xd7, 0xaa, 0xa1, 0xd6, 0x49, 0x35, 0x8b, 0xa4, 0x6e, 0x40, 0x73, 0xc0, 0xd2, 0x3e, 0x0f, 0xb2,
+	0xa6, 0x81, 0x92, 0xca, 0x41, 0x6d, 0x54, 0x83, 0x7a, 0x03, 0x3a, 0x3c, 0x7e, 0x4f, 0x85, 0x4f,
+	0xf7, 0x0f, 0x0a, 0x85, 0xf3, 0x10, 0x9a, 0x0a, 0x1c, 0xe6, 0x91, 0x88, 0xac, 0x3c, 0x6a, 0x95,
+	0xf2, 0x70, 0x9e, 0x40, 0x53, 0x81, 0x0f, 0x7a, 0x24, 0xd5, 0x69, 0xa4, 0xb7, 0x8b, 0x92, 0x16,
+	0xac, 0xaa, 0xe4, 0xab, 0x59, 0xf1, 0xd5, 0xf9, 0xa9, 0x01, 0x4d, 0x05, 0x29, 0x33, 0xdd, 0xc1,
+	0xbb, 0x64, 0xf8, 0x23, 0x96, 0xdd, 0x1b, 0x71, 0x3c, 0xbb, 0x73, 0x67, 0x5e, 0xa0, 0x73, 0x57,
+	0x9f, 0xd9, 0xb9, 0x73, 0x1e, 0x02, 0x28, 0x4f, 0xe6, 0x32, 0xac, 0x0a, 0xa6, 0xac, 0x68, 0x4c,
+	0x71, 0xf6, 0xa1, 0x93, 0x83, 0xe2, 0xbc, 0x4b, 0x2c, 0x12, 0x1c, 0xbd, 0x51, 0x91, 0xbf, 0x58,
+	0x50, 0xa7, 0x0e, 0x84, 0x6e, 0x62, 0xe0, 0xd8, 0xf9, 0xdc, 0x80, 0x4e, 0x5e, 0xec, 0x2a, 0xeb,
+	0x03, 0x2e, 0x14, 0x24, 0xd5, 0x5d, 0x2d, 0x11, 0x94, 0xb0, 0xc1, 0x21, 0x13, 0x3e, 0x8b, 0x55,
+	0x49, 0xd7, 0xdc, 0x92, 0x06, 0xe7, 0xfd, 0x64, 0x98, 0xcd, 0xe3, 0xfb, 0x0d, 0xb7, 0xa4, 0xc1,
+	0xda, 0xf0, 0x93, 0xe1, 0x47, 0x0a, 0x6d, 0x54, 0x20, 0x0a, 0x45, 0x65, 0x13, 0x37, 0x26, 0x36,
+	0xf1, 0x06, 0x34, 0x3d, 0x1f, 0x63, 0x9b, 0xb5, 0xa4, 0x94, 0x54, 0xaa, 0x86, 0x56, 0xb9, 0x1a,
+	0x1c, 0x06, 0x9d, 0xfc, 0x74, 0x9e, 0x58, 0x8e, 0x99, 0x2f, 0x67, 0x1d, 0x4c, 0x3f, 0x19, 0xd2,
+	0x3a, 0x0c, 0x17, 0x87, 0x18, 0x9a, 0x24, 0x0c, 0xa4, 0x4e, 0x28, 0x8d, 0xc9, 0x2d, 0xfe, 0x11,
+	0x0b, 0x7b, 0xfd, 0x54, 0xa3, 0x67, 0x2e, 0x3b, 0xbf, 0x31, 0x00, 0x8a, 0xee, 0x56, 0xd6, 0x9c,
+	0x33, 0x8a, 0xe6, 0x9c, 0x05, 0x75, 0x1f, 0x99, 0x8f, 0xea, 0x21, 0xd1, 0xb8, 0xe4, 0xb3, 0x59,
+	0xa9, 0xe0, 0xe9, 0x46, 0x5e, 0x7d, 0x66, 0x23, 0xef, 0x16, 0xac, 0xb2, 0x71, 0x58, 0x32, 0x53,
+	0xc1, 0xaa, 0x2a, 0x9d, 0xbf, 0x1a, 0x79, 0x83, 0x03, 0x3d, 0x54, 0xd1, 0x55, 0xad, 0x45, 0xdd,
+	0x51, 0xcc, 0x65, 0xeb, 0x4e, 0xde, 0x1a, 0xac, 0xcd, 0x6b, 0xdc, 0x69, 0x03, 0x74, 0x5e, 0x30,
+	0x4f, 0xf2, 0x38, 0x73, 0x5e, 0x49, 0xb8, 0xc9, 0x06, 0x4c, 0x4a, 0xaf, 0xc7, 0x32, 0x64, 0xd7,
+	0x62, 0xa5, 0x8f, 0xd7, 0x98, 0xe8, 0xe3, 0x59, 0x50, 0x8f, 0x78, 0x4f, 0xd2, 0x0f, 0x6c, 0x1d,
+	0x97, 0xc6, 0x58, 0x24, 0x69, 0xbe, 0x34, 0x05, 0xe6, 0x85, 0xc2, 0xf9, 0xb5, 0x01, 0x2d, 0xcd,
+	0x7f, 0xd1, 0x17, 0x36, 0x4e, 0x42, 0x91, 0x2d, 0x48, 0x4b, 0xe4, 0x8b, 0x37, 0x3e, 0x2a, 0xb6,
+	0x6e, 0x26, 0x56, 0x4a, 0xcc, 0x9c, 0x28, 0x31, 0x07, 0x56, 0x06, 0xde, 0xf8, 0x09, 0x4f, 0xbd,
+	0x88, 0x1e, 0x55, 0xc1, 0xaf, 0xe8, 0xf0, 0xf9, 0x81, 0x37, 0x56, 0x04, 0x5f, 0xaf, 0x25, 0x93,
+	0x9d, 0x8f, 0xa1, 0x8e, 0xb1, 0x9a, 0xd8, 0x04, 0xc6, 0xd4, 0x26, 0x28, 0xaa, 0xb1, 0xb6, 0x60,
+	0x73, 0x99, 0x93, 0x9b, 0xcb, 0xf9, 0xbc, 0x06, 0xad, 0xef, 0x24, 0x83, 0x83, 0xf8, 0x84, 0x97,
+	0x6f, 0x5b, 0x46, 0xf5, 0xb6, 0x65, 0x41, 0xbd, 0xc7, 0x79, 0x76, 0xbc, 0xd2, 0x58, 0xdd, 0x84,
+	0xfc, 0xbe, 0x6e, 0x47, 0xd2, 0x98, 0xba, 0x96, 0x7c, 0xa4, 0x77, 0x13, 0x0e, 0xb3, 0xe2, 0x55,
+	0x17, 0x08, 0x1c, 0xe6, 0x2d, 0xda, 0xf6, 0x82, 0xde, 0xff, 0x90, 0x88, 0x3e, 0x1d, 0x9b, 0xa6,
+	0xab, 0x25, 0xd4, 0xfb, 0xaa, 0x03, 0x0a, 0xba, 0xa1, 0x4a, 0x92, 0xf5, 0x66, 0x99, 0x4b, 0x77,
+	0x89, 0xae, 0x3d, 0xaf, 0xdf, 0xaa, 0x57, 0x56, 0x70, 0x6a, 0xc5, 0xd4, 0x0a, 0xfb, 0xcd, 0x0f,
+	0x60, 0xad, 0x3a, 0x39, 0x83, 0x77, 0xdd, 0x2e, 0x63, 0xe4, 0x2c, 0xa2, 0x5e, 0x62, 0x62, 0x3f,
+	0x86, 0xd6, 0xa1, 0xe7, 0x9f, 0x62, 0x89, 0x22, 0x63, 0x56, 0xc3, 0x2c, 0x9c, 0x5a, 0x44, 0xd0,
+	0x4d, 0x31, 0xfb, 0xba, 0x90, 0x94, 0x80, 0x5a, 0xbf, 0x3f, 0x8c, 0x4f, 0x29, 0x4b, 0x2b, 0xae,
+	0x12, 0x70, 0xd5, 0x11, 0x8b, 0x7b, 0x69, 0x5f, 0x97, 0x8e, 0x96, 0x30, 0xfc, 0xa1, 0x7c, 0x7c,
+	0x4a, 0xe1, 0x6f, 0xbb, 0x34, 0x76, 0x3e, 0x86, 0xf5, 0x03, 0xd5, 0xea, 0xd2, 0x7b, 0xf4, 0x20,
+	0xb6, 0x6e, 0x43, 0x5d, 0x26, 0xcc, 0xb7, 0x8d, 0xea, 0xd5, 0xb0, 0xe0, 0xb0, 0x2e, 0xcd, 0x5b,
+	0x0e, 0xd4, 0xd1, 0x3d, 0xbb, 0x56, 0xbd, 0x14, 0x2a, 0x8f, 0x5d, 0x9a, 0x73, 0xbe, 0x0d, 0x57,
+	0xab, 0xef, 0x77, 0x99, 0x1c, 0x46, 0x69, 0xee, 0x8b, 0x51, 0xf8, 0x82, 0xab, 0x61, 0x42, 0x70,
+	0x91, 0x91, 0x55, 0x12, 0xd0, 0xc3, 0xec, 0xce, 0xbf, 0xc4, 0xc3, 0x52, 0x6b, 0xe0, 0x02, 0x1e,
+	0x8e, 0xe0, 0x6a, 0xf5, 0xfd, 0x17, 0xf5, 0xb0, 0x0c, 0x39, 0xe6, 0x34, 0xe4, 0xf0, 0x28, 0x3a,
+	0x46, 0x1f, 0xd4, 0x46, 0xc8, 0x65, 0xe7, 0x77, 0x06, 0x80, 0xfe, 0x22, 0x62, 0x08, 0x12, 0x24,
+	0x36, 0x4e, 0x73, 0x82, 0xc4, 0xc6, 0xe9, 0x9c, 0xcf, 0x55, 0x70, 0xc9, 0x9c, 0xc0, 0x25, 0xf5,
+	0xeb, 0x8a, 0x60, 0xde, 0xa0, 0xf8, 0x75, 0x05, 0x25, 0x74, 0x52, 0xaa, 0xaf, 0xe9, 0xed, 0x97,
+	0x89, 0x15, 0x40, 0x6e, 0x56, 0x01, 0xd9, 0xf9, 0x93, 0x01, 0xf0, 0x88, 0xf7, 0x1e, 0x27, 0x78,
+	0xc8, 0x11, 0xe8, 0xc6, 0xc3, 0xc1, 0x31, 0x13, 0xd9, 0x01, 0xa6, 0x24, 0xd4, 0x9f, 0xf0, 0x28,
+	0xe2, 0x9f, 0x92, 0xa7, 0x6d, 0x57, 0x4b, 0xea, 0xd6, 0x1e, 0x30, 0x21, 0x1e, 0xc7, 0xd1, 0x19,
+	0xf9, 0xda, 0x76, 0x4b, 0x1a, 0x5c, 0xa0, 0x0c, 0xf1, 0xbb, 0xaa, 0x50, 0x95, 0x80, 0xda, 0x61,
+	0x9c, 0x86, 0xd9, 0xe1, 0xab, 0x04, 0x02, 0x14, 0xc1, 0x12, 0x8d, 0x14, 0x34, 0xae, 0xb8, 0xde,
+	0x9a, 0x70, 0xfd, 0x87, 0xb0, 0xa6, 0xc3, 0xfb, 0xfd, 0x02, 0x92, 0x2e, 0xd0, 0x2e, 0x5a, 0x18,
+	0x66, 0x67, 0x04, 0x6d, 0x44, 0x5b, 0x02, 0xc1, 0xf3, 0x52, 0x36, 0x0b, 0xea, 0x03, 0x3c, 0x83,
+	0x35, 0xdf, 0xc1, 0x31, 0xd5, 0x0e, 0x0f, 0x08, 0xba, 0xea, 0xfa, 0x88, 0x50, 0x22, 0x46, 0xe1,
+	0x40, 0xbe, 0xab, 0xff, 0x0d, 0xa4, 0xed, 0x2a, 0xc1, 0xf9, 0xad, 0x01, 0xed, 0x0f, 0x89, 0xb2,
+	0x1d, 0xc4, 0x0b, 0xd0, 0xf7, 0x1b, 0x82, 0x0b, 0x3c, 0x9b, 0x02, 0x96, 0x44, 0xfc, 0x4c, 0x37,
+	0x62, 0x9a, 0x34, 0x57, 0xd1, 0x39, 0xaf, 0xc3, 0x8a, 0xf2, 0x50, 0x6f, 0xa4, 0xbc, 0x8a, 0x8d,
+	0x72, 0x15, 0x67, 0x6f, 0xaf, 0x95, 0xc0, 0xe8, 0x6f, 0x06, 0x34, 0xf7, 0xc7, 0xcc, 0x3f, 0xa0,
+	0x05, 0xc8, 0x3e, 0x8b, 0x32, 0x72, 0xad, 0x84, 0xac, 0x6d, 0x56, 0x2b, 0xda, 0x66, 0x3b, 0x8a,
+	0x55, 0x9a, 0x84, 0xe1, 0x1b, 0x39, 0x5d, 0xc0, 0x77, 0x4c, 0x74, 0xcb, 0xb2, 0x9f, 0xcc, 0xea,
+	0xa5, 0x9f, 0xcc, 0x66, 0xff, 0x40, 0x56, 0xea, 0xab, 0x35, 0x2b, 0x7d, 0xb5, 0xa7, 0xbd, 0xa7,
+	0x3b, 0xb7, 0x90, 0x9d, 0x31, 0x5f, 0x07, 0x84, 0xa8, 0x0b, 0x8e, 0xe8, 0xe1, 0x15, 0x57, 0x4b,
+	0x78, 0x59, 0x82, 0xc3, 0x61, 0x14, 0x15, 0x00, 0x34, 0x8b, 0x7b, 0x7f, 0xed, 0xbc, 0xe6, 0xf9,
+	0x68, 0x94, 0xf3, 0xb1, 0x09, 0x6d, 0xfc, 0x95, 0x4b, 0xf6, 0x59, 0xa0, 0xb3, 0x9a, 0xcb, 0xce,
+	0x4f, 0x0c, 0x68, 0x1e, 0x0e, 0x65, 0xff, 0x20, 0x9e, 0xc7, 0xec, 0x03, 0x99, 0xe6, 0x59, 0x91,
+	0x69, 0xe1, 0xa6, 0x39, 0xd3, 0xcd, 0xfa, 0x6c, 0x37, 0x1b, 0x33, 0xcb, 0xaf, 0x59, 0x2a, 0x90,
+	0x2f, 0x0c, 0x80, 0x27, 0x4c, 0x0c, 0xc2, 0xd8, 0x8b, 0x54, 0xfd, 0x67, 0x57, 0x2d, 0x5d, 0xff,
+	0x5a, 0xb4, 0x5e, 0xc9, 0x2e, 0x1b, 0xe5, 0x7f, 0x84, 0x28, 0x9e, 0x9c, 0x53, 0x1a, 0xe6, 0xac,
+	0xd2, 0xa8, 0xcf, 0x29, 0x8d, 0xc6, 0x7f, 0xa6, 0x34, 0x3e, 0x81, 0xb5, 0xcc, 0xaf, 0xa2, 0x3c,
+	0x64, 0x1a, 0xe0, 0x2d, 0x51, 0x97, 0x87, 0x92, 0xb4, 0x9e, 0x09, 0xa1, 0x2f, 0x5e, 0x5a, 0x2a,
+	0xf2, 0xa9, 0xb3, 0x5f, 0xdd, 0x5f, 0xf5, 0x22, 0x7c, 0x6f, 0x7f, 0xef, 0xb3, 0x7f, 0x6e, 0x5d,
+	0xfa, 0xec, 0xcb, 0x2d, 0xe3, 0x8b, 0x2f, 0xb7, 0x8c, 0x7f, 0x7c, 0xb9, 0x65, 0xfc, 0xe2, 0xab,
+	0xad, 0x4b, 0x5f, 0x7c, 0xb5, 0x75, 0xe9, 0xef, 0x5f, 0x6d, 0x5d, 0xfa, 0xc1, 0xee, 0x39, 0xff,
+	0x8b, 0xee, 0x4d, 0x8a, 0xe6, 0x71, 0x93, 0xfe, 0x91, 0xee, 0xff, 0xff, 0x3d, 0x00, 0x28, 0x7e,
+	0xa9, 0xea, 0x7d, 0x27, 0x00, 0x00,
+}
+
+func (m *Service) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Stat != nil {
-		{
-			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Bin)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	if m.Pid != 0 {
+		n += 1 + sovGpm(uint64(m.Pid))
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.StartTimestamp != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.StartTimestamp))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+		}
 	}
-	if m.UpdateTimestamp != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.UpdateTimestamp))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	if m.SysProcAttr != nil {
+		l = m.SysProcAttr.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.CreationTimestamp != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.CreationTimestamp))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	if m.Log != nil {
+		l = m.Log.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.InstallFlag != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.InstallFlag))
-		i--
-		dAtA[i] = 0x58
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
 	if m.AutoRestart != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
-		i--
-		dAtA[i] = 0x50
+		n += 1 + sovGpm(uint64(m.AutoRestart))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x4a
+	if m.InstallFlag != 0 {
+		n += 1 + sovGpm(uint64(m.InstallFlag))
 	}
-	if m.Log != nil {
-		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.LastExit != nil {
+		l = m.LastExit.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.StopSignal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.StopTimeout != 0 {
+		n += 1 + sovGpm(uint64(m.StopTimeout))
+	}
+	l = len(m.KillMode)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.LivenessProbe != nil {
+		l = m.LivenessProbe.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.ReadinessProbe != nil {
+		l = m.ReadinessProbe.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.SysProcAttr != nil {
-		{
-			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if m.Replicas != 0 {
+		n += 2 + sovGpm(uint64(m.Replicas))
+	}
+	if m.CreationTimestamp != 0 {
+		n += 2 + sovGpm(uint64(m.CreationTimestamp))
+	}
+	if m.UpdateTimestamp != 0 {
+		n += 2 + sovGpm(uint64(m.UpdateTimestamp))
+	}
+	if m.StartTimestamp != 0 {
+		n += 2 + sovGpm(uint64(m.StartTimestamp))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.Stat != nil {
+		l = m.Stat.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Health)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.HealthMsg)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+	if m.Resources != nil {
+		l = m.Resources.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 2 + sovGpm(uint64(m.Restarts))
+	}
+	if m.Hooks != nil {
+		l = m.Hooks.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.ConcurrencyPolicy)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.LastRunTimestamp != 0 {
+		n += 2 + sovGpm(uint64(m.LastRunTimestamp))
+	}
+	if m.NextRunTimestamp != 0 {
+		n += 2 + sovGpm(uint64(m.NextRunTimestamp))
+	}
+	l = len(m.PidFile)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.PidFileTimeout != 0 {
+		n += 2 + sovGpm(uint64(m.PidFileTimeout))
+	}
+	if m.PidCreateTime != 0 {
+		n += 2 + sovGpm(uint64(m.PidCreateTime))
+	}
+	l = len(m.PidExe)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.Reload != nil {
+		l = m.Reload.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.Sockets) > 0 {
+		for _, e := range m.Sockets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
 		}
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x2a
+	if m.Threshold != nil {
+		l = m.Threshold.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if m.Pid != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
-		i--
-		dAtA[i] = 0x20
+	l = len(m.EnvMode)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if len(m.EnvFiles) > 0 {
+		for _, s := range m.EnvFiles {
+			l = len(s)
+			n += 2 + l + sovGpm(uint64(l))
 		}
 	}
-	if len(m.Bin) > 0 {
-		i -= len(m.Bin)
-		copy(dAtA[i:], m.Bin)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
+		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGpm(uint64(mapEntrySize))
+		}
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *SysProcAttr) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Instance) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SysProcAttr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SysProcAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x2a
+	if m.Index != 0 {
+		n += 1 + sovGpm(uint64(m.Index))
 	}
-	if m.Gid != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Gid))
-		i--
-		dAtA[i] = 0x20
+	if m.Pid != 0 {
+		n += 1 + sovGpm(uint64(m.Pid))
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
+	if m.StartTimestamp != 0 {
+		n += 1 + sovGpm(uint64(m.StartTimestamp))
 	}
-	if m.Uid != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x10
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Chroot) > 0 {
-		i -= len(m.Chroot)
-		copy(dAtA[i:], m.Chroot)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chroot)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ServiceSpec) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.Health)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	l = len(m.HealthMsg)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.LastExit != nil {
+		l = m.LastExit.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Stat != nil {
+		l = m.Stat.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovGpm(uint64(m.Restarts))
+	}
+	if m.PidCreateTime != 0 {
+		n += 1 + sovGpm(uint64(m.PidCreateTime))
+	}
+	l = len(m.PidExe)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *ServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *SysProcAttr) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.InstallFlag != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.InstallFlag))
-		i--
-		dAtA[i] = 0x58
+	l = len(m.Chroot)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.HeaderTrimPrefix) > 0 {
-		i -= len(m.HeaderTrimPrefix)
-		copy(dAtA[i:], m.HeaderTrimPrefix)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.HeaderTrimPrefix)))
-		i--
-		dAtA[i] = 0x52
+	if m.Uid != 0 {
+		n += 1 + sovGpm(uint64(m.Uid))
 	}
-	if m.AutoRestart != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
-		i--
-		dAtA[i] = 0x48
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x42
+	if m.Gid != 0 {
+		n += 1 + sovGpm(uint64(m.Gid))
 	}
-	if m.Log != nil {
-		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.SysProcAttr != nil {
-		{
-			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	if m.LimitNofile != nil {
+		l = m.LimitNofile.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.LimitNproc != nil {
+		l = m.LimitNproc.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x22
+	if m.LimitCore != nil {
+		l = m.LimitCore.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.LimitMemlock != nil {
+		l = m.LimitMemlock.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Bin) > 0 {
-		i -= len(m.Bin)
-		copy(dAtA[i:], m.Bin)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
-		i--
-		dAtA[i] = 0x12
+	if m.Nice != 0 {
+		n += 1 + sovGpm(uint64(m.Nice))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.IoniceClass)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpgradeSpec) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.IoniceLevel != 0 {
+		n += 1 + sovGpm(uint64(m.IoniceLevel))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpgradeSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if len(m.CpuAffinity) > 0 {
+		l = 0
+		for _, e := range m.CpuAffinity {
+			l += sovGpm(uint64(e))
+		}
+		n += 1 + sovGpm(uint64(l)) + l
+	}
+	if m.OomScoreAdj != 0 {
+		n += 1 + sovGpm(uint64(m.OomScoreAdj))
+	}
+	return n
 }
 
-func (m *UpgradeSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *Rlimit) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.HeaderTrimPrefix) > 0 {
-		i -= len(m.HeaderTrimPrefix)
-		copy(dAtA[i:], m.HeaderTrimPrefix)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.HeaderTrimPrefix)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+	if m.Soft != 0 {
+		n += 1 + sovGpm(uint64(m.Soft))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.Hard != 0 {
+		n += 1 + sovGpm(uint64(m.Hard))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *EditServiceSpec) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ServiceSpec) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EditServiceSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EditServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.AutoRestart != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
-		i--
-		dAtA[i] = 0x38
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.Log != nil {
-		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	l = len(m.Bin)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.SysProcAttr != nil {
-		{
-			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x2a
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
 	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
 		}
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x1a
+	if m.SysProcAttr != nil {
+		l = m.SysProcAttr.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.Log != nil {
+		l = m.Log.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Bin) > 0 {
-		i -= len(m.Bin)
-		copy(dAtA[i:], m.Bin)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ProcLog) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.AutoRestart != 0 {
+		n += 1 + sovGpm(uint64(m.AutoRestart))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ProcLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ProcLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxSize != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.MaxSize))
-		i--
-		dAtA[i] = 0x10
+	l = len(m.HeaderTrimPrefix)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.Expire != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Expire))
-		i--
-		dAtA[i] = 0x8
+	if m.InstallFlag != 0 {
+		n += 1 + sovGpm(uint64(m.InstallFlag))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Stat) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Stat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Stat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MemPercent != 0 {
-		i -= 4
-		ebinary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MemPercent))))
-		i--
-		dAtA[i] = 0x1d
+	l = len(m.StopSignal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.Memory != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Memory))
-		i--
-		dAtA[i] = 0x10
+	if m.StopTimeout != 0 {
+		n += 1 + sovGpm(uint64(m.StopTimeout))
 	}
-	if m.CpuPercent != 0 {
-		i -= 8
-		ebinary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuPercent))))
-		i--
-		dAtA[i] = 0x9
+	l = len(m.KillMode)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GpmInfo) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.LivenessProbe != nil {
+		l = m.LivenessProbe.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GpmInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GpmInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpTime != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.UpTime))
-		i--
-		dAtA[i] = 0x48
+	if m.ReadinessProbe != nil {
+		l = m.ReadinessProbe.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if m.Stat != nil {
-		{
-			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Pid != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
-		i--
-		dAtA[i] = 0x38
+	if m.Replicas != 0 {
+		n += 2 + sovGpm(uint64(m.Replicas))
 	}
-	if len(m.Gov) > 0 {
-		i -= len(m.Gov)
-		copy(dAtA[i:], m.Gov)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Gov)))
-		i--
-		dAtA[i] = 0x32
+	if m.Resources != nil {
+		l = m.Resources.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if len(m.Arch) > 0 {
-		i -= len(m.Arch)
-		copy(dAtA[i:], m.Arch)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Arch)))
-		i--
-		dAtA[i] = 0x2a
+	if m.Hooks != nil {
+		l = m.Hooks.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if len(m.Goos) > 0 {
-		i -= len(m.Goos)
-		copy(dAtA[i:], m.Goos)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Goos)))
-		i--
-		dAtA[i] = 0x22
+	l = len(m.Type)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Package) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.ConcurrencyPolicy)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Package) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Package) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	l = len(m.PidFile)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.PidFileTimeout != 0 {
+		n += 2 + sovGpm(uint64(m.PidFileTimeout))
+	}
+	if m.Reload != nil {
+		l = m.Reload.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.Sockets) > 0 {
+		for _, e := range m.Sockets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.Length != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x20
+	if m.Threshold != nil {
+		l = m.Threshold.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if len(m.Chunk) > 0 {
-		i -= len(m.Chunk)
-		copy(dAtA[i:], m.Chunk)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
-		i--
-		dAtA[i] = 0x1a
+	l = len(m.EnvMode)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if m.Total != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
+	if len(m.EnvFiles) > 0 {
+		for _, s := range m.EnvFiles {
+			l = len(s)
+			n += 2 + l + sovGpm(uint64(l))
+		}
 	}
-	if len(m.Package) > 0 {
-		i -= len(m.Package)
-		copy(dAtA[i:], m.Package)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Package)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *InstallServiceIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGpm(uint64(mapEntrySize))
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InstallServiceIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return n
 }
 
-func (m *InstallServiceIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *UpgradeSpec) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Pack != nil {
-		{
-			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *InstallServiceResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.HeaderTrimPrefix)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InstallServiceResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if m.VerifyTimeout != 0 {
+		n += 1 + sovGpm(uint64(m.VerifyTimeout))
+	}
+	return n
 }
 
-func (m *InstallServiceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *EditServiceSpec) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+	l = len(m.Bin)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpgradeServiceIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpgradeServiceIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpgradeServiceIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pack != nil {
-		{
-			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGpm(dAtA, i, uint64(size))
+	if m.SysProcAttr != nil {
+		l = m.SysProcAttr.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.AutoRestart != 0 {
+		n += 1 + sovGpm(uint64(m.AutoRestart))
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.StopSignal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.StopTimeout != 0 {
+		n += 1 + sovGpm(uint64(m.StopTimeout))
+	}
+	l = len(m.KillMode)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.LivenessProbe != nil {
+		l = m.LivenessProbe.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.ReadinessProbe != nil {
+		l = m.ReadinessProbe.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpgradeServiceResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Replicas != 0 {
+		n += 1 + sovGpm(uint64(m.Replicas))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpgradeServiceResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpgradeServiceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+	if m.Resources != nil {
+		l = m.Resources.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Hooks != nil {
+		l = m.Hooks.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.ConcurrencyPolicy)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	l = len(m.PidFile)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if m.PidFileTimeout != 0 {
+		n += 2 + sovGpm(uint64(m.PidFileTimeout))
+	}
+	if m.Reload != nil {
+		l = m.Reload.XSize()
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.Sockets) > 0 {
+		for _, e := range m.Sockets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ServiceLog) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Threshold != nil {
+		l = m.Threshold.XSize()
+		n += 2 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	l = len(m.EnvMode)
+	if l > 0 {
+		n += 2 + l + sovGpm(uint64(l))
+	}
+	if len(m.EnvFiles) > 0 {
+		for _, s := range m.EnvFiles {
+			l = len(s)
+			n += 2 + l + sovGpm(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.XSize()
+			n += 2 + l + sovGpm(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGpm(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
 
-func (m *ServiceLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *RestartPolicy) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+	if m.InitialBackoff != 0 {
+		n += 1 + sovGpm(uint64(m.InitialBackoff))
 	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Text)))
-		i--
-		dAtA[i] = 0xa
+	if m.MaxBackoff != 0 {
+		n += 1 + sovGpm(uint64(m.MaxBackoff))
 	}
-	return len(dAtA) - i, nil
+	if m.MaxRetries != 0 {
+		n += 1 + sovGpm(uint64(m.MaxRetries))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGpm(uint64(m.Window))
+	}
+	return n
 }
 
-func (m *ServiceVersion) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Probe) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ServiceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.ExpectStatus != 0 {
+		n += 1 + sovGpm(uint64(m.ExpectStatus))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsDir {
-		i--
-		if m.IsDir {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.ModTime != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.ModTime))
-		i--
-		dAtA[i] = 0x20
+	if m.InitialDelay != 0 {
+		n += 1 + sovGpm(uint64(m.InitialDelay))
 	}
-	if len(m.Mode) > 0 {
-		i -= len(m.Mode)
-		copy(dAtA[i:], m.Mode)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Mode)))
-		i--
-		dAtA[i] = 0x1a
+	if m.Interval != 0 {
+		n += 1 + sovGpm(uint64(m.Interval))
 	}
-	if m.Size != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Size))
-		i--
-		dAtA[i] = 0x10
+	if m.Timeout != 0 {
+		n += 1 + sovGpm(uint64(m.Timeout))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.FailureThreshold != 0 {
+		n += 1 + sovGpm(uint64(m.FailureThreshold))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *UpdateIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Hooks) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	if m.PreStart != nil {
+		l = m.PreStart.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.PostStart != nil {
+		l = m.PostStart.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.PreStop != nil {
+		l = m.PreStop.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.PostStop != nil {
+		l = m.PostStop.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpdateIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *Hook) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.DeploySignal {
-		i--
-		if m.DeploySignal {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.Length != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x20
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Chunk) > 0 {
-		i -= len(m.Chunk)
-		copy(dAtA[i:], m.Chunk)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
-		i--
-		dAtA[i] = 0x1a
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if m.Total != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
+	if m.Timeout != 0 {
+		n += 1 + sovGpm(uint64(m.Timeout))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.OnFailure)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *UpdateResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Socket) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *UpdateResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *Reload) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0xa
+	if m.Timeout != 0 {
+		n += 1 + sovGpm(uint64(m.Timeout))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ExecIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Secret) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExecIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x2a
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Size != 0 {
+		n += 1 + sovGpm(uint64(m.Size))
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x12
+	if m.CreationTimestamp != 0 {
+		n += 1 + sovGpm(uint64(m.CreationTimestamp))
 	}
-	if len(m.Shell) > 0 {
-		i -= len(m.Shell)
-		copy(dAtA[i:], m.Shell)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Shell)))
-		i--
-		dAtA[i] = 0xa
+	if m.UpdateTimestamp != 0 {
+		n += 1 + sovGpm(uint64(m.UpdateTimestamp))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *ExecResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SecretSpec) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PullResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
+	return n
 }
 
-func (m *PullResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretRef) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Env)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
 }
 
-func (m *PullResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *Threshold) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Finished {
-		i--
-		if m.Finished {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	if m.Memory != 0 {
+		n += 1 + sovGpm(uint64(m.Memory))
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+	if m.MemPercent != 0 {
+		n += 5
 	}
-	if m.Length != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x20
+	if m.CpuPercent != 0 {
+		n += 9
 	}
-	if len(m.Chunk) > 0 {
-		i -= len(m.Chunk)
-		copy(dAtA[i:], m.Chunk)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
-		i--
-		dAtA[i] = 0x1a
+	if m.CpuWindow != 0 {
+		n += 1 + sovGpm(uint64(m.CpuWindow))
 	}
-	if m.Total != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
+	if m.Interval != 0 {
+		n += 1 + sovGpm(uint64(m.Interval))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PushIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	return dAtA[:n], nil
+	return n
 }
 
-func (m *PushIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Resources) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Memory != 0 {
+		n += 1 + sovGpm(uint64(m.Memory))
+	}
+	if m.Cpu != 0 {
+		n += 9
+	}
+	if m.Pids != 0 {
+		n += 1 + sovGpm(uint64(m.Pids))
+	}
+	if m.IoWeight != 0 {
+		n += 1 + sovGpm(uint64(m.IoWeight))
+	}
+	return n
 }
 
-func (m *PushIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *ExitStatus) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Length != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x28
+	if m.Pid != 0 {
+		n += 1 + sovGpm(uint64(m.Pid))
 	}
-	if len(m.Chunk) > 0 {
-		i -= len(m.Chunk)
-		copy(dAtA[i:], m.Chunk)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
-		i--
-		dAtA[i] = 0x22
+	if m.Code != 0 {
+		n += 1 + sovGpm(uint64(m.Code))
 	}
-	if m.Total != 0 {
-		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x18
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Dst) > 0 {
-		i -= len(m.Dst)
-		copy(dAtA[i:], m.Dst)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dst)))
-		i--
-		dAtA[i] = 0x12
+	if m.StartTimestamp != 0 {
+		n += 1 + sovGpm(uint64(m.StartTimestamp))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if m.ExitTimestamp != 0 {
+		n += 1 + sovGpm(uint64(m.ExitTimestamp))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *TerminalIn) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ServiceExit) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TerminalIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TerminalIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x22
+	if m.Instance != 0 {
+		n += 1 + sovGpm(uint64(m.Instance))
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
+	if m.Status != nil {
+		l = m.Status.XSize()
+		n += 1 + l + sovGpm(uint64(l))
 	}
-	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovGpm(uint64(m.Restarts))
+	}
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			l = len(s)
+			n += 1 + l + sovGpm(uint64(l))
 		}
 	}
-	if len(m.Command) > 0 {
-		i -= len(m.Command)
-		copy(dAtA[i:], m.Command)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Command)))
-		i--
-		dAtA[i] = 0xa
+	if m.Timestamp != 0 {
+		n += 1 + sovGpm(uint64(m.Timestamp))
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *TerminalResult) Marshal() (dAtA []byte, err error) {
-	size := m.XSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProcLog) XSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
+	var l int
+	_ = l
+	if m.Expire != 0 {
+		n += 1 + sovGpm(uint64(m.Expire))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovGpm(uint64(m.MaxSize))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovGpm(uint64(m.Interval))
+	}
+	if m.MaxTotalSize != 0 {
+		n += 1 + sovGpm(uint64(m.MaxTotalSize))
+	}
+	if m.MaxFiles != 0 {
+		n += 1 + sovGpm(uint64(m.MaxFiles))
+	}
+	return n
 }
 
-func (m *TerminalResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.XSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Stat) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CpuPercent != 0 {
+		n += 9
+	}
+	if m.Memory != 0 {
+		n += 1 + sovGpm(uint64(m.Memory))
+	}
+	if m.MemPercent != 0 {
+		n += 5
+	}
+	return n
 }
 
-func (m *TerminalResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *GpmInfo) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Goos)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Arch)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Gov)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovGpm(uint64(m.Pid))
+	}
+	if m.Stat != nil {
+		l = m.Stat.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.UpTime != 0 {
+		n += 1 + sovGpm(uint64(m.UpTime))
+	}
+	l = len(m.Cgroup)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.XSize()
+				l += 1 + sovGpm(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Package) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGpm(uint64(m.Total))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovGpm(uint64(m.Length))
+	}
 	if m.IsOk {
-		i--
-		if m.IsOk {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		n += 2
+	}
+	return n
+}
+
+func (m *InstallServiceIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Pack != nil {
+		l = m.Pack.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *InstallServiceResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsOk {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeServiceIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Pack != nil {
+		l = m.Pack.XSize()
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeServiceResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsOk {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Rollback)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceLog) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGpm(uint64(m.Timestamp))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Instance != 0 {
+		n += 1 + sovGpm(uint64(m.Instance))
+	}
+	return n
+}
+
+func (m *LogOptions) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovGpm(uint64(m.Number))
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.StderrOnly {
+		n += 2
+	}
+	if m.Since != 0 {
+		n += 1 + sovGpm(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovGpm(uint64(m.Until))
+	}
+	l = len(m.Grep)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Instance != 0 {
+		n += 1 + sovGpm(uint64(m.Instance))
+	}
+	return n
+}
+
+func (m *ServiceVersion) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGpm(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *FileInfo) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Size != 0 {
+		n += 1 + sovGpm(uint64(m.Size))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.ModTime != 0 {
+		n += 1 + sovGpm(uint64(m.ModTime))
+	}
+	if m.IsDir {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGpm(uint64(m.Total))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovGpm(uint64(m.Length))
+	}
+	if m.IsOk {
+		n += 2
+	}
+	if m.DeploySignal {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.IsOk {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExecIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Shell)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.EnvMode)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *PullResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGpm(uint64(m.Total))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovGpm(uint64(m.Length))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Finished {
+		n += 2
+	}
+	return n
+}
+
+func (m *PushIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGpm(uint64(m.Total))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovGpm(uint64(m.Length))
+	}
+	if m.IsOk {
+		n += 2
+	}
+	return n
+}
+
+func (m *TerminalIn) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGpm(uint64(len(k))) + 1 + len(v) + sovGpm(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGpm(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.EnvMode)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	return n
+}
+
+func (m *TerminalResult) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGpm(uint64(l))
+	}
+	if m.IsOk {
+		n += 2
+	}
+	return n
+}
+
+func sovGpm(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func sozGpm(x uint64) (n int) {
+	return sovGpm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.EnvFiles) > 0 {
+		for iNdEx := len(m.EnvFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnvFiles[iNdEx])
+			copy(dAtA[i:], m.EnvFiles[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.EnvMode) > 0 {
+		i -= len(m.EnvMode)
+		copy(dAtA[i:], m.EnvMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvMode)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Sockets) > 0 {
+		for iNdEx := len(m.Sockets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sockets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.Reload != nil {
+		{
+			size, err := m.Reload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.PidExe) > 0 {
+		i -= len(m.PidExe)
+		copy(dAtA[i:], m.PidExe)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.PidExe)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.PidCreateTime != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.PidCreateTime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.PidFileTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.PidFileTimeout))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PidFile) > 0 {
+		i -= len(m.PidFile)
+		copy(dAtA[i:], m.PidFile)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.PidFile)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.NextRunTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.NextRunTimestamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.LastRunTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.LastRunTimestamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ConcurrencyPolicy) > 0 {
+		i -= len(m.ConcurrencyPolicy)
+		copy(dAtA[i:], m.ConcurrencyPolicy)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Restarts != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Restarts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.Instances) > 0 {
+		for iNdEx := len(m.Instances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.HealthMsg) > 0 {
+		i -= len(m.HealthMsg)
+		copy(dAtA[i:], m.HealthMsg)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.HealthMsg)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Health) > 0 {
+		i -= len(m.Health)
+		copy(dAtA[i:], m.Health)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Health)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.Stat != nil {
+		{
+			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.UpdateTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.UpdateTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.CreationTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.CreationTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ReadinessProbe != nil {
+		{
+			size, err := m.ReadinessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.LivenessProbe != nil {
+		{
+			size, err := m.LivenessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.KillMode) > 0 {
+		i -= len(m.KillMode)
+		copy(dAtA[i:], m.KillMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.KillMode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.StopTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StopTimeout))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.StopSignal) > 0 {
+		i -= len(m.StopSignal)
+		copy(dAtA[i:], m.StopSignal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.StopSignal)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.LastExit != nil {
+		{
+			size, err := m.LastExit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.InstallFlag != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.InstallFlag))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AutoRestart != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SysProcAttr != nil {
+		{
+			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Pid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bin) > 0 {
+		i -= len(m.Bin)
+		copy(dAtA[i:], m.Bin)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Instance) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Instance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Instance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PidExe) > 0 {
+		i -= len(m.PidExe)
+		copy(dAtA[i:], m.PidExe)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.PidExe)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PidCreateTime != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.PidCreateTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Restarts != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Restarts))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Stat != nil {
+		{
+			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastExit != nil {
+		{
+			size, err := m.LastExit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.HealthMsg) > 0 {
+		i -= len(m.HealthMsg)
+		copy(dAtA[i:], m.HealthMsg)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.HealthMsg)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Health) > 0 {
+		i -= len(m.Health)
+		copy(dAtA[i:], m.Health)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Health)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SysProcAttr) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SysProcAttr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SysProcAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OomScoreAdj != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.OomScoreAdj))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.CpuAffinity) > 0 {
+		dAtA15 := make([]byte, len(m.CpuAffinity)*10)
+		var j14 int
+		for _, num1 := range m.CpuAffinity {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintGpm(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.IoniceLevel != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.IoniceLevel))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.IoniceClass) > 0 {
+		i -= len(m.IoniceClass)
+		copy(dAtA[i:], m.IoniceClass)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.IoniceClass)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Nice != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Nice))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LimitMemlock != nil {
+		{
+			size, err := m.LimitMemlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LimitCore != nil {
+		{
+			size, err := m.LimitCore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LimitNproc != nil {
+		{
+			size, err := m.LimitNproc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LimitNofile != nil {
+		{
+			size, err := m.LimitNofile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Gid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Gid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Uid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chroot) > 0 {
+		i -= len(m.Chroot)
+		copy(dAtA[i:], m.Chroot)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chroot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rlimit) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rlimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rlimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hard != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Hard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Soft != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Soft))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EnvFiles) > 0 {
+		for iNdEx := len(m.EnvFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnvFiles[iNdEx])
+			copy(dAtA[i:], m.EnvFiles[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.EnvMode) > 0 {
+		i -= len(m.EnvMode)
+		copy(dAtA[i:], m.EnvMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvMode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.Sockets) > 0 {
+		for iNdEx := len(m.Sockets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sockets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.Reload != nil {
+		{
+			size, err := m.Reload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.PidFileTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.PidFileTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.PidFile) > 0 {
+		i -= len(m.PidFile)
+		copy(dAtA[i:], m.PidFile)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.PidFile)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.ConcurrencyPolicy) > 0 {
+		i -= len(m.ConcurrencyPolicy)
+		copy(dAtA[i:], m.ConcurrencyPolicy)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.ReadinessProbe != nil {
+		{
+			size, err := m.ReadinessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.LivenessProbe != nil {
+		{
+			size, err := m.LivenessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.KillMode) > 0 {
+		i -= len(m.KillMode)
+		copy(dAtA[i:], m.KillMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.KillMode)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StopTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StopTimeout))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.StopSignal) > 0 {
+		i -= len(m.StopSignal)
+		copy(dAtA[i:], m.StopSignal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.StopSignal)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.InstallFlag != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.InstallFlag))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.HeaderTrimPrefix) > 0 {
+		i -= len(m.HeaderTrimPrefix)
+		copy(dAtA[i:], m.HeaderTrimPrefix)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.HeaderTrimPrefix)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AutoRestart != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SysProcAttr != nil {
+		{
+			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bin) > 0 {
+		i -= len(m.Bin)
+		copy(dAtA[i:], m.Bin)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeSpec) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifyTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.VerifyTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HeaderTrimPrefix) > 0 {
+		i -= len(m.HeaderTrimPrefix)
+		copy(dAtA[i:], m.HeaderTrimPrefix)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.HeaderTrimPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditServiceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditServiceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.EnvFiles) > 0 {
+		for iNdEx := len(m.EnvFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnvFiles[iNdEx])
+			copy(dAtA[i:], m.EnvFiles[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.EnvMode) > 0 {
+		i -= len(m.EnvMode)
+		copy(dAtA[i:], m.EnvMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvMode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Sockets) > 0 {
+		for iNdEx := len(m.Sockets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sockets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGpm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.Reload != nil {
+		{
+			size, err := m.Reload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.PidFileTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.PidFileTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.PidFile) > 0 {
+		i -= len(m.PidFile)
+		copy(dAtA[i:], m.PidFile)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.PidFile)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ConcurrencyPolicy) > 0 {
+		i -= len(m.ConcurrencyPolicy)
+		copy(dAtA[i:], m.ConcurrencyPolicy)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ReadinessProbe != nil {
+		{
+			size, err := m.ReadinessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.LivenessProbe != nil {
+		{
+			size, err := m.LivenessProbe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.KillMode) > 0 {
+		i -= len(m.KillMode)
+		copy(dAtA[i:], m.KillMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.KillMode)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StopTimeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StopTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.StopSignal) > 0 {
+		i -= len(m.StopSignal)
+		copy(dAtA[i:], m.StopSignal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.StopSignal)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AutoRestart != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.AutoRestart))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SysProcAttr != nil {
+		{
+			size, err := m.SysProcAttr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bin) > 0 {
+		i -= len(m.Bin)
+		copy(dAtA[i:], m.Bin)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Bin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBackoff != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.MaxBackoff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialBackoff != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.InitialBackoff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Probe) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Probe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Probe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureThreshold != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.FailureThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InitialDelay != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.InitialDelay))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpectStatus != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.ExpectStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hooks) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostStop != nil {
+		{
+			size, err := m.PostStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreStop != nil {
+		{
+			size, err := m.PreStop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostStart != nil {
+		{
+			size, err := m.PostStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PreStart != nil {
+		{
+			size, err := m.PreStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hook) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OnFailure) > 0 {
+		i -= len(m.OnFailure)
+		copy(dAtA[i:], m.OnFailure)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.OnFailure)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Socket) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Socket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Socket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reload) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signal) > 0 {
+		i -= len(m.Signal)
+		copy(dAtA[i:], m.Signal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Signal)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Secret) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.UpdateTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreationTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.CreationTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Size != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretSpec) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretRef) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Env) > 0 {
+		i -= len(m.Env)
+		copy(dAtA[i:], m.Env)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Env)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Threshold) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Threshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Threshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signal) > 0 {
+		i -= len(m.Signal)
+		copy(dAtA[i:], m.Signal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Signal)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CpuWindow != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.CpuWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CpuPercent != 0 {
+		i -= 8
+		ebinary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuPercent))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.MemPercent != 0 {
+		i -= 4
+		ebinary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MemPercent))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Memory != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Resources) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IoWeight != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.IoWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pids != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Pids))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cpu != 0 {
+		i -= 8
+		ebinary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Cpu))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Memory != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.ExitTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signal) > 0 {
+		i -= len(m.Signal)
+		copy(dAtA[i:], m.Signal)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Signal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceExit) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Logs[iNdEx])
+			copy(dAtA[i:], m.Logs[iNdEx])
+			i = encodeVarintGpm(dAtA, i, uint64(len(m.Logs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Restarts != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Restarts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Instance != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Instance))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcLog) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFiles != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.MaxFiles))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTotalSize != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.MaxTotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Expire != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Expire))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stat) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MemPercent != 0 {
+		i -= 4
+		ebinary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MemPercent))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Memory != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CpuPercent != 0 {
+		i -= 8
+		ebinary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuPercent))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GpmInfo) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GpmInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GpmInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for k := range m.Resources {
+			v := m.Resources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintGpm(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Cgroup) > 0 {
+		i -= len(m.Cgroup)
+		copy(dAtA[i:], m.Cgroup)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Cgroup)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.UpTime != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.UpTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Stat != nil {
+		{
+			size, err := m.Stat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Pid != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Pid))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Gov) > 0 {
+		i -= len(m.Gov)
+		copy(dAtA[i:], m.Gov)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Gov)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Arch) > 0 {
+		i -= len(m.Arch)
+		copy(dAtA[i:], m.Arch)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Arch)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Goos) > 0 {
+		i -= len(m.Goos)
+		copy(dAtA[i:], m.Goos)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Goos)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Package) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Package) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Package) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Length != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Total != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstallServiceIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstallServiceIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstallServiceIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pack != nil {
+		{
+			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstallServiceResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstallServiceResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstallServiceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pack != nil {
+		{
+			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGpm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rollback) > 0 {
+		i -= len(m.Rollback)
+		copy(dAtA[i:], m.Rollback)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Rollback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceLog) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Instance != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Instance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogOptions) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Instance != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Instance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Grep) > 0 {
+		i -= len(m.Grep)
+		copy(dAtA[i:], m.Grep)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Grep)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Until != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Since != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StderrOnly {
+		i--
+		if m.StderrOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceVersion) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDir {
+		i--
+		if m.IsDir {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ModTime != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.ModTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Size != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeploySignal {
+		i--
+		if m.DeploySignal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Length != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Total != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnvMode) > 0 {
+		i -= len(m.EnvMode)
+		copy(dAtA[i:], m.EnvMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvMode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Shell) > 0 {
+		i -= len(m.Shell)
+		copy(dAtA[i:], m.Shell)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Shell)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Length != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Total != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Length != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Total != 0 {
+		i = encodeVarintGpm(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dst) > 0 {
+		i -= len(m.Dst)
+		copy(dAtA[i:], m.Dst)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Dst)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminalIn) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminalIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnvMode) > 0 {
+		i -= len(m.EnvMode)
+		copy(dAtA[i:], m.EnvMode)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.EnvMode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGpm(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGpm(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGpm(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminalResult) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminalResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOk {
+		i--
+		if m.IsOk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Stderr) > 0 {
+		i -= len(m.Stderr)
+		copy(dAtA[i:], m.Stderr)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Stderr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stdout) > 0 {
+		i -= len(m.Stdout)
+		copy(dAtA[i:], m.Stdout)
+		i = encodeVarintGpm(dAtA, i, uint64(len(m.Stdout)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGpm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGpm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SysProcAttr == nil {
+				m.SysProcAttr = &SysProcAttr{}
+			}
+			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &ProcLog{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+			}
+			m.AutoRestart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestart |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallFlag", wireType)
+			}
+			m.InstallFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstallFlag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExit == nil {
+				m.LastExit = &ExitStatus{}
+			}
+			if err := m.LastExit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopSignal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTimeout", wireType)
+			}
+			m.StopTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KillMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessProbe == nil {
+				m.LivenessProbe = &Probe{}
+			}
+			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &Probe{}
+			}
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			m.CreationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimestamp", wireType)
+			}
+			m.UpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stat == nil {
+				m.Stat = &Stat{}
+			}
+			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Health = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instances = append(m.Instances, &Instance{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &Resources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &Hooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcurrencyPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunTimestamp", wireType)
+			}
+			m.LastRunTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTimestamp", wireType)
+			}
+			m.NextRunTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PidFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFileTimeout", wireType)
+			}
+			m.PidFileTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidFileTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidCreateTime", wireType)
+			}
+			m.PidCreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidCreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidExe", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PidExe = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reload == nil {
+				m.Reload = &Reload{}
+			}
+			if err := m.Reload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sockets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sockets = append(m.Sockets, &Socket{})
+			if err := m.Sockets[len(m.Sockets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &Threshold{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFiles = append(m.EnvFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretRef{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Instance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Instance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Instance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Health = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExit == nil {
+				m.LastExit = &ExitStatus{}
+			}
+			if err := m.LastExit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stat == nil {
+				m.Stat = &Stat{}
+			}
+			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidCreateTime", wireType)
+			}
+			m.PidCreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidCreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidExe", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PidExe = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SysProcAttr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SysProcAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chroot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chroot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			}
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitNofile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitNofile == nil {
+				m.LimitNofile = &Rlimit{}
+			}
+			if err := m.LimitNofile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitNproc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitNproc == nil {
+				m.LimitNproc = &Rlimit{}
+			}
+			if err := m.LimitNproc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitCore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitCore == nil {
+				m.LimitCore = &Rlimit{}
+			}
+			if err := m.LimitCore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitMemlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitMemlock == nil {
+				m.LimitMemlock = &Rlimit{}
+			}
+			if err := m.LimitMemlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+			}
+			m.Nice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nice |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoniceClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoniceClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoniceLevel", wireType)
+			}
+			m.IoniceLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IoniceLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CpuAffinity = append(m.CpuAffinity, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGpm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGpm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CpuAffinity) == 0 {
+					m.CpuAffinity = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CpuAffinity = append(m.CpuAffinity, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuAffinity", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OomScoreAdj", wireType)
+			}
+			m.OomScoreAdj = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OomScoreAdj |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rlimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rlimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rlimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soft", wireType)
+			}
+			m.Soft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Soft |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			m.Hard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hard |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SysProcAttr == nil {
+				m.SysProcAttr = &SysProcAttr{}
+			}
+			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &ProcLog{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+			}
+			m.AutoRestart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestart |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTrimPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderTrimPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallFlag", wireType)
+			}
+			m.InstallFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstallFlag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopSignal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTimeout", wireType)
+			}
+			m.StopTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KillMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessProbe == nil {
+				m.LivenessProbe = &Probe{}
+			}
+			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &Probe{}
+			}
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &Resources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &Hooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcurrencyPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PidFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFileTimeout", wireType)
+			}
+			m.PidFileTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidFileTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reload == nil {
+				m.Reload = &Reload{}
+			}
+			if err := m.Reload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sockets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sockets = append(m.Sockets, &Socket{})
+			if err := m.Sockets[len(m.Sockets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &Threshold{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFiles = append(m.EnvFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretRef{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTrimPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderTrimPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyTimeout", wireType)
+			}
+			m.VerifyTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifyTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditServiceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SysProcAttr == nil {
+				m.SysProcAttr = &SysProcAttr{}
+			}
+			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &ProcLog{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+			}
+			m.AutoRestart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestart |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopSignal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTimeout", wireType)
+			}
+			m.StopTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KillMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessProbe == nil {
+				m.LivenessProbe = &Probe{}
+			}
+			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &Probe{}
+			}
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &Resources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &Hooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcurrencyPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PidFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidFileTimeout", wireType)
+			}
+			m.PidFileTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidFileTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reload == nil {
+				m.Reload = &Reload{}
+			}
+			if err := m.Reload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sockets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sockets = append(m.Sockets, &Socket{})
+			if err := m.Sockets[len(m.Sockets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &Threshold{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFiles = append(m.EnvFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretRef{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
+			}
+			m.InitialBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBackoff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
+			}
+			m.MaxBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Probe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Probe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Probe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectStatus", wireType)
+			}
+			m.ExpectStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+			}
+			m.InitialDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			m.FailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreStart == nil {
+				m.PreStart = &Hook{}
+			}
+			if err := m.PreStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostStart == nil {
+				m.PostStart = &Hook{}
+			}
+			if err := m.PostStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreStop == nil {
+				m.PreStop = &Hook{}
+			}
+			if err := m.PreStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostStop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostStop == nil {
+				m.PostStop = &Hook{}
+			}
+			if err := m.PostStop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnFailure = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Socket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Socket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Socket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Stderr) > 0 {
-		i -= len(m.Stderr)
-		copy(dAtA[i:], m.Stderr)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Stderr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stdout) > 0 {
-		i -= len(m.Stdout)
-		copy(dAtA[i:], m.Stdout)
-		i = encodeVarintGpm(dAtA, i, uint64(len(m.Stdout)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGpm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGpm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Reload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3337,15 +13397,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3373,11 +13433,11 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Signal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3405,11 +13465,80 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bin = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3437,13 +13566,51 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			m.CreationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimestamp", wireType)
 			}
-			m.Pid = 0
+			m.UpdateTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3453,14 +13620,64 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pid |= int64(b&0x7F) << shift
+				m.UpdateTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3488,13 +13705,13 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3504,124 +13721,81 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGpm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGpm(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3631,33 +13805,29 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SysProcAttr == nil {
-				m.SysProcAttr = &SysProcAttr{}
-			}
-			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3667,31 +13837,27 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &ProcLog{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Env = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3719,13 +13885,63 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.File = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Threshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Threshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Threshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			m.AutoRestart = 0
+			m.Memory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3735,54 +13951,38 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AutoRestart |= int32(b&0x7F) << shift
+				m.Memory |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallFlag", wireType)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemPercent", wireType)
 			}
-			m.InstallFlag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InstallFlag |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			v = uint32(ebinary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MemPercent = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuPercent", wireType)
 			}
-			m.CreationTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 22:
+			v = uint64(ebinary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuPercent = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuWindow", wireType)
 			}
-			m.UpdateTimestamp = 0
+			m.CpuWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3792,16 +13992,16 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimestamp |= int64(b&0x7F) << shift
+				m.CpuWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.StartTimestamp = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3811,14 +14011,14 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTimestamp |= int64(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3846,11 +14046,11 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3878,13 +14078,63 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Signal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3894,28 +14144,60 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Memory |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stat == nil {
-				m.Stat = &Stat{}
+			v = uint64(ebinary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Cpu = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pids", wireType)
 			}
-			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Pids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pids |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoWeight", wireType)
+			}
+			m.IoWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IoWeight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -3937,7 +14219,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
+func (m *ExitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3960,17 +14242,17 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SysProcAttr: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SysProcAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chroot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			var stringLen uint64
+			m.Pid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -3980,29 +14262,16 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Pid |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chroot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Uid = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4012,14 +14281,14 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= int32(b&0x7F) << shift
+				m.Code |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4047,13 +14316,13 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Signal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
-			m.Gid = 0
+			m.StartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4063,16 +14332,16 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gid |= int32(b&0x7F) << shift
+				m.StartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.ExitTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4082,24 +14351,11 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExitTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -4121,7 +14377,7 @@ func (m *SysProcAttr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
+func (m *ServiceExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4144,17 +14400,17 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
 			}
-			var stringLen uint64
+			m.Instance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4164,29 +14420,16 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Instance |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4196,27 +14439,31 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bin = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &ExitStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4244,11 +14491,11 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4276,13 +14523,13 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
 			}
-			var msglen int
+			m.Restarts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4292,124 +14539,16 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restarts |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGpm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGpm(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Env[mapkey] = mapvalue
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4419,33 +14558,29 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SysProcAttr == nil {
-				m.SysProcAttr = &SysProcAttr{}
-			}
-			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Logs = append(m.Logs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4455,33 +14590,66 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGpm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &ProcLog{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
 			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+			}
+			m.Expire = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4491,29 +14659,35 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Expire |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.AutoRestart = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4523,16 +14697,16 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AutoRestart |= int32(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTrimPrefix", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxTotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4542,29 +14716,16 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTotalSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HeaderTrimPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallFlag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFiles", wireType)
 			}
-			m.InstallFlag = 0
+			m.MaxFiles = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4574,7 +14735,7 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstallFlag |= int32(b&0x7F) << shift
+				m.MaxFiles |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4600,7 +14761,7 @@ func (m *ServiceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeSpec) Unmarshal(dAtA []byte) error {
+func (m *Stat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4623,49 +14784,28 @@ func (m *UpgradeSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Stat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Stat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuPercent", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(ebinary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuPercent = float64(math.Float64frombits(v))
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var stringLen uint64
+			m.Memory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4675,56 +14815,22 @@ func (m *UpgradeSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Memory |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTrimPrefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemPercent", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeaderTrimPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint32(ebinary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MemPercent = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -4746,7 +14852,7 @@ func (m *UpgradeSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
+func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4769,15 +14875,15 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditServiceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: GpmInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GpmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4805,11 +14911,11 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bin = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Goos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4837,11 +14943,11 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Goos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4869,13 +14975,13 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -4885,124 +14991,29 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGpm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGpm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGpm(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGpm
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Env[mapkey] = mapvalue
+			m.Gov = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysProcAttr", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			var msglen int
+			m.Pid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5012,31 +15023,14 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Pid |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SysProcAttr == nil {
-				m.SysProcAttr = &SysProcAttr{}
-			}
-			if err := m.SysProcAttr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5063,18 +15057,18 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &ProcLog{}
+			if m.Stat == nil {
+				m.Stat = &Stat{}
 			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpTime", wireType)
 			}
-			m.AutoRestart = 0
+			m.UpTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5084,66 +15078,16 @@ func (m *EditServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AutoRestart |= int32(b&0x7F) << shift
+				m.UpTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGpm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProcLog) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGpm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProcLog: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcLog: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cgroup", wireType)
 			}
-			m.Expire = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5153,16 +15097,29 @@ func (m *ProcLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Expire |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
 			}
-			m.MaxSize = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cgroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5172,102 +15129,121 @@ func (m *ProcLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSize |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGpm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Stat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGpm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Stat: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stat: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuPercent", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(ebinary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CpuPercent = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			if m.Resources == nil {
+				m.Resources = make(map[string]*Resources)
 			}
-			m.Memory = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *Resources
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGpm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Memory |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGpm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGpm
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Resources{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGpm(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGpm
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemPercent", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(ebinary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.MemPercent = float32(math.Float32frombits(v))
+			m.Resources[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5289,7 +15265,7 @@ func (m *Stat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GpmInfo) Unmarshal(dAtA []byte) error {
+func (m *Package) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5312,15 +15288,15 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GpmInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Package: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GpmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Package: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,13 +15324,13 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Package = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Goos", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5364,29 +15340,16 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Goos = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5396,80 +15359,31 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Arch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
-			m.Gov = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
-			}
-			m.Pid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Pid |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5479,33 +15393,16 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stat == nil {
-				m.Stat = &Stat{}
-			}
-			if err := m.Stat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOk", wireType)
 			}
-			m.UpTime = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5515,11 +15412,12 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpTime |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsOk = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5541,7 +15439,7 @@ func (m *GpmInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Package) Unmarshal(dAtA []byte) error {
+func (m *InstallServiceIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5564,17 +15462,17 @@ func (m *Package) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Package: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstallServiceIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Package: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstallServiceIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5584,29 +15482,119 @@ func (m *Package) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &ServiceSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pack == nil {
+				m.Pack = &Package{}
+			}
+			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstallServiceResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpm
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Package = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstallServiceResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstallServiceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOk", wireType)
 			}
-			m.Total = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5616,16 +15604,17 @@ func (m *Package) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IsOk = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5635,65 +15624,24 @@ func (m *Package) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.Chunk == nil {
-				m.Chunk = []byte{}
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
-			}
-			m.Length = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Length |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOk", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGpm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsOk = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5715,7 +15663,7 @@ func (m *Package) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstallServiceIn) Unmarshal(dAtA []byte) error {
+func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5738,10 +15686,10 @@ func (m *InstallServiceIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstallServiceIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeServiceIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstallServiceIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeServiceIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5774,7 +15722,7 @@ func (m *InstallServiceIn) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Spec == nil {
-				m.Spec = &ServiceSpec{}
+				m.Spec = &UpgradeSpec{}
 			}
 			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5837,7 +15785,7 @@ func (m *InstallServiceIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstallServiceResult) Unmarshal(dAtA []byte) error {
+func (m *UpgradeServiceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5860,10 +15808,10 @@ func (m *InstallServiceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstallServiceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeServiceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstallServiceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeServiceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5918,6 +15866,70 @@ func (m *InstallServiceResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -5939,7 +15951,7 @@ func (m *InstallServiceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
+func (m *ServiceLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5958,21 +15970,104 @@ func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeServiceIn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeServiceIn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -5982,33 +16077,29 @@ func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &UpgradeSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -6018,28 +16109,43 @@ func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGpm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGpm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pack == nil {
-				m.Pack = &Package{}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
 			}
-			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Instance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instance |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -6061,7 +16167,7 @@ func (m *UpgradeServiceIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeServiceResult) Unmarshal(dAtA []byte) error {
+func (m *LogOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6084,17 +16190,17 @@ func (m *UpgradeServiceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeServiceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeServiceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var v int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -6104,17 +16210,16 @@ func (m *UpgradeServiceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOk = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -6124,79 +16229,37 @@ func (m *UpgradeServiceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGpm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGpm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceLog) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGpm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Follow = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StderrOnly", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceLog: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceLog: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			m.StderrOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			m.Since = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -6206,27 +16269,33 @@ func (m *ServiceLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Since |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGpm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGpm
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grep", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6254,13 +16323,13 @@ func (m *ServiceLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Grep = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
 			}
-			m.Timestamp = 0
+			m.Instance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGpm
@@ -6270,7 +16339,7 @@ func (m *ServiceLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.Instance |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7181,6 +17250,38 @@ func (m *ExecIn) Unmarshal(dAtA []byte) error {
 			}
 			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
@@ -7950,6 +18051,38 @@ func (m *TerminalIn) Unmarshal(dAtA []byte) error {
 			}
 			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpm(dAtA[iNdEx:])
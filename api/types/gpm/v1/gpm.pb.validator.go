@@ -20,19 +20,67 @@ func (m *Service) ValidateE(prefix string) error {
 	if len(m.Bin) == 0 {
 		errs = append(errs, fmt.Errorf("field '%sbin' is required", prefix))
 	}
+	if len(m.EnvMode) != 0 {
+		if !is.In([]string{"inherit", "clean"}, string(m.EnvMode)) {
+			errs = append(errs, fmt.Errorf("field '%senvMode' must in '[inherit,clean]'", prefix))
+		}
+	}
+	if len(m.KillMode) != 0 {
+		if !is.In([]string{"leader", "group"}, string(m.KillMode)) {
+			errs = append(errs, fmt.Errorf("field '%skillMode' must in '[leader,group]'", prefix))
+		}
+	}
+	if len(m.Type) != 0 {
+		if !is.In([]string{"simple", "oneshot", "cron", "forking"}, string(m.Type)) {
+			errs = append(errs, fmt.Errorf("field '%stype' must in '[simple,oneshot,cron,forking]'", prefix))
+		}
+	}
+	if len(m.ConcurrencyPolicy) != 0 {
+		if !is.In([]string{"allow", "forbid", "replace"}, string(m.ConcurrencyPolicy)) {
+			errs = append(errs, fmt.Errorf("field '%sconcurrencyPolicy' must in '[allow,forbid,replace]'", prefix))
+		}
+	}
 	if len(m.Status) != 0 {
-		if !is.In([]string{"init", "running", "stopped", "failed", "upgrading"}, string(m.Status)) {
-			errs = append(errs, fmt.Errorf("field '%sstatus' must in '[init,running,stopped,failed,upgrading]'", prefix))
+		if !is.In([]string{"init", "running", "stopped", "failed", "upgrading", "crashloop", "paused"}, string(m.Status)) {
+			errs = append(errs, fmt.Errorf("field '%sstatus' must in '[init,running,stopped,failed,upgrading,crashloop,paused]'", prefix))
+		}
+	}
+	if len(m.Health) != 0 {
+		if !is.In([]string{"unknown", "healthy", "unhealthy"}, string(m.Health)) {
+			errs = append(errs, fmt.Errorf("field '%shealth' must in '[unknown,healthy,unhealthy]'", prefix))
 		}
 	}
 	return is.MargeErr(errs...)
 }
 
+func (m *Instance) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Instance) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
 func (m *SysProcAttr) Validate() error {
 	return m.ValidateE("")
 }
 
 func (m *SysProcAttr) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.IoniceClass) != 0 {
+		if !is.In([]string{"realtime", "best-effort", "idle"}, string(m.IoniceClass)) {
+			errs = append(errs, fmt.Errorf("field '%sioniceClass' must in '[realtime,best-effort,idle]'", prefix))
+		}
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Rlimit) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Rlimit) ValidateE(prefix string) error {
 	errs := make([]error, 0)
 	return is.MargeErr(errs...)
 }
@@ -49,9 +97,32 @@ func (m *ServiceSpec) ValidateE(prefix string) error {
 	if len(m.Bin) == 0 {
 		errs = append(errs, fmt.Errorf("field '%sbin' is required", prefix))
 	}
+	if len(m.EnvMode) == 0 {
+		m.EnvMode = "inherit"
+	}
+	if len(m.EnvMode) != 0 {
+		if !is.In([]string{"inherit", "clean"}, string(m.EnvMode)) {
+			errs = append(errs, fmt.Errorf("field '%senvMode' must in '[inherit,clean]'", prefix))
+		}
+	}
 	if len(m.Version) == 0 {
 		errs = append(errs, fmt.Errorf("field '%sversion' is required", prefix))
 	}
+	if len(m.KillMode) != 0 {
+		if !is.In([]string{"leader", "group"}, string(m.KillMode)) {
+			errs = append(errs, fmt.Errorf("field '%skillMode' must in '[leader,group]'", prefix))
+		}
+	}
+	if len(m.Type) != 0 {
+		if !is.In([]string{"simple", "oneshot", "cron", "forking"}, string(m.Type)) {
+			errs = append(errs, fmt.Errorf("field '%stype' must in '[simple,oneshot,cron,forking]'", prefix))
+		}
+	}
+	if len(m.ConcurrencyPolicy) != 0 {
+		if !is.In([]string{"allow", "forbid", "replace"}, string(m.ConcurrencyPolicy)) {
+			errs = append(errs, fmt.Errorf("field '%sconcurrencyPolicy' must in '[allow,forbid,replace]'", prefix))
+		}
+	}
 	return is.MargeErr(errs...)
 }
 
@@ -76,6 +147,240 @@ func (m *EditServiceSpec) Validate() error {
 
 func (m *EditServiceSpec) ValidateE(prefix string) error {
 	errs := make([]error, 0)
+	if len(m.EnvMode) != 0 {
+		if !is.In([]string{"inherit", "clean"}, string(m.EnvMode)) {
+			errs = append(errs, fmt.Errorf("field '%senvMode' must in '[inherit,clean]'", prefix))
+		}
+	}
+	if len(m.KillMode) != 0 {
+		if !is.In([]string{"leader", "group"}, string(m.KillMode)) {
+			errs = append(errs, fmt.Errorf("field '%skillMode' must in '[leader,group]'", prefix))
+		}
+	}
+	if len(m.Type) != 0 {
+		if !is.In([]string{"simple", "oneshot", "cron", "forking"}, string(m.Type)) {
+			errs = append(errs, fmt.Errorf("field '%stype' must in '[simple,oneshot,cron,forking]'", prefix))
+		}
+	}
+	if len(m.ConcurrencyPolicy) != 0 {
+		if !is.In([]string{"allow", "forbid", "replace"}, string(m.ConcurrencyPolicy)) {
+			errs = append(errs, fmt.Errorf("field '%sconcurrencyPolicy' must in '[allow,forbid,replace]'", prefix))
+		}
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *RestartPolicy) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *RestartPolicy) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Policy) != 0 {
+		if !is.In([]string{"always", "onFailure", "never"}, string(m.Policy)) {
+			errs = append(errs, fmt.Errorf("field '%spolicy' must in '[always,onFailure,never]'", prefix))
+		}
+	}
+	if int64(m.MaxRetries) == 0 {
+		m.MaxRetries = 5
+	}
+	if int64(m.MaxRetries) != 0 {
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Probe) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Probe) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Type) == 0 {
+		errs = append(errs, fmt.Errorf("field '%stype' is required", prefix))
+	}
+	if len(m.Type) != 0 {
+		if !is.In([]string{"http", "tcp", "exec"}, string(m.Type)) {
+			errs = append(errs, fmt.Errorf("field '%stype' must in '[http,tcp,exec]'", prefix))
+		}
+	}
+	if int64(m.Interval) == 0 {
+		m.Interval = 10
+	}
+	if int64(m.Interval) != 0 {
+	}
+	if int64(m.Timeout) == 0 {
+		m.Timeout = 1
+	}
+	if int64(m.Timeout) != 0 {
+	}
+	if int64(m.FailureThreshold) == 0 {
+		m.FailureThreshold = 3
+	}
+	if int64(m.FailureThreshold) != 0 {
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Hooks) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Hooks) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *Hook) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Hook) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Type) == 0 {
+		errs = append(errs, fmt.Errorf("field '%stype' is required", prefix))
+	}
+	if len(m.Type) != 0 {
+		if !is.In([]string{"exec", "http"}, string(m.Type)) {
+			errs = append(errs, fmt.Errorf("field '%stype' must in '[exec,http]'", prefix))
+		}
+	}
+	if len(m.Method) == 0 {
+		m.Method = "GET"
+	}
+	if int64(m.Timeout) == 0 {
+		m.Timeout = 30
+	}
+	if int64(m.Timeout) != 0 {
+	}
+	if len(m.OnFailure) == 0 {
+		m.OnFailure = "abort"
+	}
+	if len(m.OnFailure) != 0 {
+		if !is.In([]string{"abort", "continue"}, string(m.OnFailure)) {
+			errs = append(errs, fmt.Errorf("field '%sonFailure' must in '[abort,continue]'", prefix))
+		}
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Socket) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Socket) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Address) == 0 {
+		errs = append(errs, fmt.Errorf("field '%saddress' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Reload) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Reload) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if int64(m.Timeout) == 0 {
+		m.Timeout = 30
+	}
+	if int64(m.Timeout) != 0 {
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Secret) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Secret) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *SecretSpec) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *SecretSpec) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *SecretRef) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *SecretRef) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Name) == 0 {
+		errs = append(errs, fmt.Errorf("field '%sname' is required", prefix))
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Threshold) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Threshold) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if int64(m.CpuWindow) == 0 {
+		m.CpuWindow = 60
+	}
+	if int64(m.CpuWindow) != 0 {
+	}
+	if int64(m.Interval) == 0 {
+		m.Interval = 10
+	}
+	if int64(m.Interval) != 0 {
+	}
+	if len(m.Action) == 0 {
+		m.Action = "event"
+	}
+	if len(m.Action) != 0 {
+		if !is.In([]string{"restart", "signal", "event"}, string(m.Action)) {
+			errs = append(errs, fmt.Errorf("field '%saction' must in '[restart,signal,event]'", prefix))
+		}
+	}
+	return is.MargeErr(errs...)
+}
+
+func (m *Resources) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *Resources) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ExitStatus) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ExitStatus) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
+func (m *ServiceExit) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *ServiceExit) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	if len(m.Reason) != 0 {
+		if !is.In([]string{"exit", "crash", "stop", "upgrade", "oom", "threshold"}, string(m.Reason)) {
+			errs = append(errs, fmt.Errorf("field '%sreason' must in '[exit,crash,stop,upgrade,oom,threshold]'", prefix))
+		}
+	}
 	return is.MargeErr(errs...)
 }
 
@@ -191,6 +496,15 @@ func (m *ServiceLog) ValidateE(prefix string) error {
 	return is.MargeErr(errs...)
 }
 
+func (m *LogOptions) Validate() error {
+	return m.ValidateE("")
+}
+
+func (m *LogOptions) ValidateE(prefix string) error {
+	errs := make([]error, 0)
+	return is.MargeErr(errs...)
+}
+
 func (m *ServiceVersion) Validate() error {
 	return m.ValidateE("")
 }
@@ -239,6 +553,14 @@ func (m *ExecIn) ValidateE(prefix string) error {
 	if len(m.Shell) == 0 {
 		errs = append(errs, fmt.Errorf("field '%sshell' is required", prefix))
 	}
+	if len(m.EnvMode) == 0 {
+		m.EnvMode = "inherit"
+	}
+	if len(m.EnvMode) != 0 {
+		if !is.In([]string{"inherit", "clean"}, string(m.EnvMode)) {
+			errs = append(errs, fmt.Errorf("field '%senvMode' must in '[inherit,clean]'", prefix))
+		}
+	}
 	return is.MargeErr(errs...)
 }
 
@@ -278,6 +600,14 @@ func (m *TerminalIn) ValidateE(prefix string) error {
 	if len(m.Command) == 0 {
 		errs = append(errs, fmt.Errorf("field '%scommand' is required", prefix))
 	}
+	if len(m.EnvMode) == 0 {
+		m.EnvMode = "inherit"
+	}
+	if len(m.EnvMode) != 0 {
+		if !is.In([]string{"inherit", "clean"}, string(m.EnvMode)) {
+			errs = append(errs, fmt.Errorf("field '%senvMode' must in '[inherit,clean]'", prefix))
+		}
+	}
 	return is.MargeErr(errs...)
 }
 
@@ -24,8 +24,13 @@ package main
 
 import (
 	"github.com/vine-io/gpm/pkg"
+	"github.com/vine-io/gpm/pkg/service"
 )
 
 func main() {
+	// gpmd 作为 shim 启动服务进程时直接执行服务命令
+	if service.IsShim() {
+		service.RunShim()
+	}
 	pkg.Run()
 }
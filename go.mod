@@ -1,6 +1,6 @@
 module github.com/vine-io/gpm
 
-go 1.18
+go 1.20
 
 require (
 	github.com/gin-gonic/gin v1.8.2
@@ -19,6 +19,7 @@ require (
 	github.com/vine-io/pkg/unit v0.1.0
 	github.com/vine-io/plugins/logger/zap v1.6.7
 	github.com/vine-io/vine v1.6.7
+	golang.org/x/sys v0.4.0
 	golang.org/x/text v0.6.0
 	google.golang.org/grpc v1.52.0
 	gopkg.in/yaml.v3 v3.0.1
@@ -76,7 +77,6 @@ require (
 	golang.org/x/crypto v0.5.0 // indirect
 	golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4 // indirect
 	golang.org/x/net v0.5.0 // indirect
-	golang.org/x/sys v0.4.0 // indirect
 	golang.org/x/term v0.4.0 // indirect
 	golang.org/x/tools v0.1.12 // indirect
 	google.golang.org/genproto v0.0.0-20221227171554-f9683d7f8bef // indirect
@@ -114,8 +114,8 @@ func (s *SimpleClient) StartService(ctx context.Context, name string, opts ...cl
 	return rsp.Service, nil
 }
 
-func (s *SimpleClient) StopService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
-	rsp, err := s.cc.StopService(ctx, &pb.StopServiceReq{Name: name}, opts...)
+func (s *SimpleClient) StopService(ctx context.Context, name string, dependents bool, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.StopService(ctx, &pb.StopServiceReq{Name: name, Dependents: dependents}, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +130,46 @@ func (s *SimpleClient) RestartService(ctx context.Context, name string, opts ...
 	return rsp.Service, nil
 }
 
+func (s *SimpleClient) RunService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.RunService(ctx, &pb.RunServiceReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Service, nil
+}
+
+func (s *SimpleClient) SignalService(ctx context.Context, name, signal string, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.SignalService(ctx, &pb.SignalServiceReq{Name: name, Signal: signal}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Service, nil
+}
+
+func (s *SimpleClient) ReloadService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.ReloadService(ctx, &pb.ReloadServiceReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Service, nil
+}
+
+func (s *SimpleClient) PauseService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.PauseService(ctx, &pb.PauseServiceReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Service, nil
+}
+
+func (s *SimpleClient) ResumeService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
+	rsp, err := s.cc.ResumeService(ctx, &pb.ResumeServiceReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Service, nil
+}
+
 func (s *SimpleClient) DeleteService(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Service, error) {
 	rsp, err := s.cc.DeleteService(ctx, &pb.DeleteServiceReq{Name: name}, opts...)
 	if err != nil {
@@ -138,11 +178,15 @@ func (s *SimpleClient) DeleteService(ctx context.Context, name string, opts ...c
 	return rsp.Service, nil
 }
 
-func (s *SimpleClient) WatchServiceLog(ctx context.Context, name string, n int64, f bool, opts ...client.CallOption) (*ServiceLogWatcher, error) {
+func (s *SimpleClient) WatchServiceLog(ctx context.Context, name string, lo *gpmv1.LogOptions, opts ...client.CallOption) (*ServiceLogWatcher, error) {
 	rsp, err := s.cc.WatchServiceLog(ctx, &pb.WatchServiceLogReq{
-		Name:   name,
-		Number: n,
-		Follow: f,
+		Name:       name,
+		Number:     lo.Number,
+		Follow:     lo.Follow,
+		StderrOnly: lo.StderrOnly,
+		Since:      lo.Since,
+		Until:      lo.Until,
+		Grep:       lo.Grep,
 	}, opts...)
 	if err != nil {
 		return nil, err
@@ -150,6 +194,19 @@ func (s *SimpleClient) WatchServiceLog(ctx context.Context, name string, n int64
 	return &ServiceLogWatcher{s: rsp}, nil
 }
 
+func (s *SimpleClient) WatchServicesLog(ctx context.Context, names []string, selector string, all bool, lo *gpmv1.LogOptions, opts ...client.CallOption) (*ServicesLogWatcher, error) {
+	rsp, err := s.cc.WatchServicesLog(ctx, &pb.WatchServicesLogReq{
+		Names:    names,
+		Selector: selector,
+		All:      all,
+		Options:  lo,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ServicesLogWatcher{s: rsp}, nil
+}
+
 func (s *SimpleClient) InstallService(ctx context.Context, spec *gpmv1.ServiceSpec, opts ...client.CallOption) (*InstallStream, error) {
 	stream, err := s.cc.InstallService(ctx, opts...)
 	if err != nil {
@@ -166,6 +223,14 @@ func (s *SimpleClient) ListServiceVersions(ctx context.Context, name string, opt
 	return rsp.Versions, nil
 }
 
+func (s *SimpleClient) ListServiceExits(ctx context.Context, name string, opts ...client.CallOption) ([]*gpmv1.ServiceExit, error) {
+	rsp, err := s.cc.ListServiceExits(ctx, &pb.ListServiceExitsReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Exits, nil
+}
+
 func (s *SimpleClient) UpgradeService(ctx context.Context, spec *gpmv1.UpgradeSpec, opts ...client.CallOption) (*UpgradeStream, error) {
 	stream, err := s.cc.UpgradeService(ctx, opts...)
 	if err != nil {
@@ -190,6 +255,30 @@ func (s *SimpleClient) ForgetService(ctx context.Context, name, revision string,
 	return nil
 }
 
+func (s *SimpleClient) CreateSecret(ctx context.Context, spec *gpmv1.SecretSpec, opts ...client.CallOption) (*gpmv1.Secret, error) {
+	rsp, err := s.cc.CreateSecret(ctx, &pb.CreateSecretReq{Spec: spec}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Secret, nil
+}
+
+func (s *SimpleClient) ListSecrets(ctx context.Context, opts ...client.CallOption) ([]*gpmv1.Secret, error) {
+	rsp, err := s.cc.ListSecrets(ctx, &pb.ListSecretsReq{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Secrets, nil
+}
+
+func (s *SimpleClient) DeleteSecret(ctx context.Context, name string, opts ...client.CallOption) (*gpmv1.Secret, error) {
+	rsp, err := s.cc.DeleteSecret(ctx, &pb.DeleteSecretReq{Name: name}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Secret, nil
+}
+
 func (s *SimpleClient) Ls(ctx context.Context, path string, opts ...client.CallOption) ([]*gpmv1.FileInfo, error) {
 	rsp, err := s.cc.Ls(ctx, &pb.LsReq{Path: path}, opts...)
 	if err != nil {
@@ -81,6 +81,26 @@ func (w *ServiceLogWatcher) Close() error {
 	return w.s.Close()
 }
 
+type ServicesLogWatcher struct {
+	s pb.GpmService_WatchServicesLogService
+}
+
+func (w *ServicesLogWatcher) Context() context.Context {
+	return w.s.Context()
+}
+
+func (w *ServicesLogWatcher) Next() (*gpmv1.ServiceLog, error) {
+	rsp, err := w.s.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Log, nil
+}
+
+func (w *ServicesLogWatcher) Close() error {
+	return w.s.Close()
+}
+
 type InstallStream struct {
 	s pb.GpmService_InstallServiceService
 
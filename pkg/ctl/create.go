@@ -53,10 +53,38 @@ func createService(c *cobra.Command, args []string) error {
 	env, _ := c.Flags().GetStringSlice("env")
 	spec.SysProcAttr.User, _ = c.Flags().GetString("user")
 	spec.SysProcAttr.Group, _ = c.Flags().GetString("group")
+	if _, err := getProcAttr(c, spec.SysProcAttr); err != nil {
+		return err
+	}
 	spec.Log.Expire, _ = c.Flags().GetInt32("log-expire")
 	spec.Log.MaxSize, _ = c.Flags().GetInt64("log-max-size")
+	spec.Log.Interval, _ = c.Flags().GetInt64("log-rotate-interval")
+	spec.Log.MaxTotalSize, _ = c.Flags().GetInt64("log-max-total-size")
+	spec.Log.MaxFiles, _ = c.Flags().GetInt32("log-max-files")
 	spec.Version, _ = c.Flags().GetString("version")
 	autoRestart, _ := c.Flags().GetBool("auto-restart")
+	spec.RestartPolicy = getRestartPolicy(c)
+	spec.StopSignal, _ = c.Flags().GetString("stop-signal")
+	spec.StopTimeout, _ = c.Flags().GetInt64("stop-timeout")
+	spec.KillMode, _ = c.Flags().GetString("kill-mode")
+	spec.LivenessProbe = getProbe(c, "liveness")
+	spec.ReadinessProbe = getProbe(c, "readiness")
+	spec.DependsOn, _ = c.Flags().GetStringSlice("depends-on")
+	spec.Replicas, _ = c.Flags().GetInt32("replicas")
+	spec.Resources = getResources(c)
+	spec.Hooks = getHooks(c)
+	spec.Reload = getReload(c)
+	spec.Sockets = getSockets(c)
+	spec.EnvMode, _ = c.Flags().GetString("env-mode")
+	spec.EnvFiles, _ = c.Flags().GetStringSlice("env-file")
+	spec.Threshold = getThreshold(c)
+	spec.Secrets = getSecrets(c)
+	spec.Labels = getLabels(c)
+	spec.Type, _ = c.Flags().GetString("type")
+	spec.Schedule, _ = c.Flags().GetString("schedule")
+	spec.ConcurrencyPolicy, _ = c.Flags().GetString("concurrency-policy")
+	spec.PidFile, _ = c.Flags().GetString("pid-file")
+	spec.PidFileTimeout, _ = c.Flags().GetInt64("pid-file-timeout")
 	if err := spec.Validate(); err != nil {
 		return err
 	}
@@ -95,12 +123,41 @@ func CreateServiceCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceP("args", "A", []string{}, "specify the args for service")
 	cmd.PersistentFlags().StringP("dir", "D", "", "specify the root directory for service")
 	cmd.PersistentFlags().StringP("env", "E", "", "specify the env for service")
+	addEnvFlags(cmd)
+	addSecretFlags(cmd)
+	addLabelFlags(cmd)
 	cmd.PersistentFlags().String("user", "", "specify the user for service")
 	cmd.PersistentFlags().String("group", "", "specify the group for service")
 	cmd.PersistentFlags().Int("log-expire", 15, "specify the expire for service log")
 	cmd.PersistentFlags().Int64("log-max-size", 1024*1024*10, "specify the max size for service log")
+	cmd.PersistentFlags().Int64("log-rotate-interval", 0, "specify the interval (seconds) to rotate service log, 0 means rotate by size only")
+	cmd.PersistentFlags().Int64("log-max-total-size", 0, "specify the max total size for all logs of service, 0 means unlimited")
+	cmd.PersistentFlags().Int32("log-max-files", 0, "specify the max number of rotated logs to keep, 0 means unlimited")
 	cmd.PersistentFlags().StringP("version", "V", "", "specify the version for service")
 	cmd.PersistentFlags().Bool("auto-restart", true, "Whether auto restart service when it crashing")
+	cmd.PersistentFlags().String("restart-policy", "", "specify the restart policy for service, example always, onFailure, never")
+	cmd.PersistentFlags().Int64("restart-backoff", 0, "specify the initial backoff seconds before restarting service")
+	cmd.PersistentFlags().Int64("restart-max-backoff", 0, "specify the max backoff seconds before restarting service")
+	cmd.PersistentFlags().Int32("restart-max-retries", 0, "specify the max restarts within restart window (default 5), -1 means unlimited")
+	cmd.PersistentFlags().Int64("restart-window", 0, "specify the window seconds for counting restarts")
+	cmd.PersistentFlags().String("stop-signal", "", "specify the signal for stopping service, example SIGTERM, SIGQUIT")
+	cmd.PersistentFlags().Int64("stop-timeout", 0, "specify the timeout seconds before killing service when stopping")
+	cmd.PersistentFlags().String("kill-mode", "", "specify the kill mode for stopping service, example leader, group")
+	addProbeFlags(cmd, "liveness")
+	addProbeFlags(cmd, "readiness")
+	cmd.PersistentFlags().StringSlice("depends-on", []string{}, "specify the services which this service depends on")
+	cmd.PersistentFlags().Int32("replicas", 0, "specify the number of instances for service")
+	addResourcesFlags(cmd)
+	addThresholdFlags(cmd)
+	addProcAttrFlags(cmd)
+	addHookFlags(cmd)
+	addReloadFlags(cmd)
+	cmd.PersistentFlags().StringSlice("listen", []string{}, "specify the addresses listened by gpmd and passed to service, example tcp://:8080, http=unix:///run/app.sock")
+	cmd.PersistentFlags().String("type", "", "specify the type of service, example simple, oneshot, cron, forking")
+	cmd.PersistentFlags().String("schedule", "", "specify the cron expression for cron service, example '*/5 * * * *', '@every 1h'")
+	cmd.PersistentFlags().String("concurrency-policy", "", "specify what to do when last run of cron service is still running, example allow, forbid, replace")
+	cmd.PersistentFlags().String("pid-file", "", "specify the pid file which forking service writes the daemon pid to")
+	cmd.PersistentFlags().Int64("pid-file-timeout", 0, "specify the timeout seconds for waiting the pid file of forking service")
 
 	return cmd
 }
@@ -47,6 +47,7 @@ func execBash(c *cobra.Command, args []string) error {
 	env, _ := c.Flags().GetStringSlice("env")
 	in.User, _ = c.Flags().GetString("user")
 	in.Group, _ = c.Flags().GetString("group")
+	in.EnvMode, _ = c.Flags().GetString("env-mode")
 	if err := in.Validate(); err != nil {
 		return err
 	}
@@ -84,6 +85,7 @@ func ExecBashCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceP("env", "E", []string{}, "specify the env for exec")
 	cmd.PersistentFlags().String("user", "", "specify the user for exec")
 	cmd.PersistentFlags().String("group", "", "specify the group for exec")
+	cmd.PersistentFlags().String("env-mode", "", "specify the source of environment variables, inherit: inherit the environment of gpmd, clean: only PATH, HOME, USER and LOGNAME")
 
 	return cmd
 }
@@ -33,6 +33,7 @@ import (
 	json "github.com/json-iterator/go"
 	tw "github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/client"
 	"github.com/vine-io/pkg/unit"
 	"gopkg.in/yaml.v3"
@@ -80,15 +81,84 @@ func getService(c *cobra.Command, args []string) error {
 			env = append(env, fmt.Sprintf("%s=%s", k, v))
 		}
 		t.Append([]string{"env", strings.Join(env, ",")})
+		if s.EnvMode != "" {
+			t.Append([]string{"EnvMode", s.EnvMode})
+		}
+		if len(s.EnvFiles) > 0 {
+			t.Append([]string{"EnvFiles", strings.Join(s.EnvFiles, ",")})
+		}
+		if len(s.Secrets) > 0 {
+			t.Append([]string{"Secrets", secretsString(s.Secrets)})
+		}
+		if len(s.Labels) > 0 {
+			t.Append([]string{"Labels", labelsString(s.Labels)})
+		}
 		if s.SysProcAttr != nil {
 			t.Append([]string{"User", fmt.Sprintf("user=%s, group=%s", s.SysProcAttr.User, s.SysProcAttr.Group)})
+			t.AppendBulk(procAttrStrings(s.SysProcAttr))
 		}
 		t.Append([]string{"Version", s.Version})
+		if s.Type != "" {
+			t.Append([]string{"Type", s.Type})
+		}
+		if s.Type == gpmv1.ServiceCron {
+			t.Append([]string{"Schedule", s.Schedule})
+			t.Append([]string{"ConcurrencyPolicy", s.ConcurrencyPolicy})
+		}
+		if s.PidFile != "" {
+			t.Append([]string{"PidFile", s.PidFile})
+		}
+		if len(s.DependsOn) > 0 {
+			t.Append([]string{"DependsOn", strings.Join(s.DependsOn, ",")})
+		}
+		if s.Replicas > 1 {
+			t.Append([]string{"Replicas", fmt.Sprintf("%d", s.Replicas)})
+		}
 		if s.AutoRestart > 0 {
 			t.Append([]string{"AutoRestart", "True"})
 		} else {
 			t.Append([]string{"AutoRestart", "False"})
 		}
+		if rp := s.RestartPolicy; rp != nil {
+			t.Append([]string{"RestartPolicy", fmt.Sprintf("policy=%s, backoff=%ds, maxBackoff=%ds, maxRetries=%d, window=%ds",
+				rp.Policy, rp.InitialBackoff, rp.MaxBackoff, rp.MaxRetries, rp.Window)})
+		}
+		if s.StopSignal != "" || s.StopTimeout > 0 || s.KillMode != "" {
+			t.Append([]string{"Stop", fmt.Sprintf("signal=%s, timeout=%ds, killMode=%s", s.StopSignal, s.StopTimeout, s.KillMode)})
+		}
+		if s.LivenessProbe != nil {
+			t.Append([]string{"LivenessProbe", probeString(s.LivenessProbe)})
+		}
+		if s.ReadinessProbe != nil {
+			t.Append([]string{"ReadinessProbe", probeString(s.ReadinessProbe)})
+		}
+		if hooks := s.Hooks; hooks != nil {
+			for _, item := range []struct {
+				name string
+				hook *gpmv1.Hook
+			}{
+				{"PreStartHook", hooks.PreStart},
+				{"PostStartHook", hooks.PostStart},
+				{"PreStopHook", hooks.PreStop},
+				{"PostStopHook", hooks.PostStop},
+			} {
+				if item.hook != nil {
+					t.Append([]string{item.name, hookString(item.hook)})
+				}
+			}
+		}
+		if len(s.Sockets) > 0 {
+			t.Append([]string{"Sockets", socketsString(s.Sockets)})
+		}
+		if s.Reload != nil {
+			t.Append([]string{"Reload", reloadString(s.Reload)})
+		}
+		if s.Resources != nil {
+			t.Append([]string{"Resources", resourcesString(s.Resources)})
+		}
+		if s.Threshold != nil {
+			t.Append([]string{"Threshold", thresholdString(s.Threshold)})
+		}
 		if s.Stat != nil {
 			t.Append([]string{"CPU", fmt.Sprintf("%.2f%%", s.Stat.CpuPercent)})
 			t.Append([]string{"Memory", fmt.Sprintf("%s/%.1f%%", unit.ConvAuto(int64(s.Stat.Memory), 2), s.Stat.MemPercent)})
@@ -96,11 +166,35 @@ func getService(c *cobra.Command, args []string) error {
 		if s.Log != nil {
 			t.Append([]string{"log expire", fmt.Sprintf("%d days", s.Log.Expire)})
 			t.Append([]string{"log chunk", fmt.Sprintf("%s", unit.ConvAuto(s.Log.MaxSize, 2))})
+			if s.Log.Interval > 0 {
+				t.Append([]string{"log rotate interval", (time.Duration(s.Log.Interval) * time.Second).String()})
+			}
+			if s.Log.MaxTotalSize > 0 {
+				t.Append([]string{"log total size", fmt.Sprintf("%s", unit.ConvAuto(s.Log.MaxTotalSize, 2))})
+			}
+			if s.Log.MaxFiles > 0 {
+				t.Append([]string{"log files", fmt.Sprintf("%d", s.Log.MaxFiles)})
+			}
 		}
 		t.Append([]string{"CreationTimestamp", time.Unix(s.CreationTimestamp, 0).String()})
 		t.Append([]string{"UpdateTimestamp", time.Unix(s.UpdateTimestamp, 0).String()})
 		t.Append([]string{"StartTimestamp", time.Unix(s.StartTimestamp, 0).String()})
 		t.Append([]string{"Status", s.Status})
+		if s.LastRunTimestamp > 0 {
+			t.Append([]string{"LastRunTimestamp", time.Unix(s.LastRunTimestamp, 0).String()})
+		}
+		if s.NextRunTimestamp > 0 {
+			t.Append([]string{"NextRunTimestamp", time.Unix(s.NextRunTimestamp, 0).String()})
+		}
+		if s.Restarts > 0 {
+			t.Append([]string{"Restarts", fmt.Sprintf("%d", s.Restarts)})
+		}
+		if s.Health != "" {
+			t.Append([]string{"Health", s.Health})
+		}
+		if s.HealthMsg != "" {
+			t.Append([]string{"HealthMessage", s.HealthMsg})
+		}
 		if s.Msg != "" {
 			t.Append([]string{"Message", s.Msg})
 		}
@@ -24,11 +24,15 @@ package ctl
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/internal"
 	"github.com/vine-io/gpm/pkg/internal/config"
+	"github.com/vine-io/pkg/unit"
 	vclient "github.com/vine-io/vine/core/client"
 )
 
@@ -48,6 +52,475 @@ func getCallOptions(c *cobra.Command) []vclient.CallOption {
 	}
 }
 
+func getRestartPolicy(c *cobra.Command) *gpmv1.RestartPolicy {
+	rp := &gpmv1.RestartPolicy{}
+	rp.Policy, _ = c.Flags().GetString("restart-policy")
+	rp.InitialBackoff, _ = c.Flags().GetInt64("restart-backoff")
+	rp.MaxBackoff, _ = c.Flags().GetInt64("restart-max-backoff")
+	rp.MaxRetries, _ = c.Flags().GetInt32("restart-max-retries")
+	rp.Window, _ = c.Flags().GetInt64("restart-window")
+	if rp.Policy == "" && rp.InitialBackoff == 0 && rp.MaxBackoff == 0 && rp.MaxRetries == 0 && rp.Window == 0 {
+		return nil
+	}
+	return rp
+}
+
+// addProbeFlags 添加健康探测参数, kind 为 liveness 或 readiness
+func addProbeFlags(cmd *cobra.Command, kind string) {
+	cmd.PersistentFlags().String(kind+"-http", "", fmt.Sprintf("specify the url for %s http probe, example http://127.0.0.1:8080/healthz", kind))
+	cmd.PersistentFlags().String(kind+"-tcp", "", fmt.Sprintf("specify the address for %s tcp probe, example 127.0.0.1:8080", kind))
+	cmd.PersistentFlags().String(kind+"-exec", "", fmt.Sprintf("specify the command for %s exec probe", kind))
+	cmd.PersistentFlags().Int32(kind+"-expect-status", 0, fmt.Sprintf("specify the expected status code for %s http probe", kind))
+	cmd.PersistentFlags().Int64(kind+"-initial-delay", 0, fmt.Sprintf("specify the seconds before %s probe starts", kind))
+	cmd.PersistentFlags().Int64(kind+"-interval", 0, fmt.Sprintf("specify the interval seconds for %s probe", kind))
+	cmd.PersistentFlags().Int64(kind+"-timeout", 0, fmt.Sprintf("specify the timeout seconds for %s probe", kind))
+	cmd.PersistentFlags().Int32(kind+"-failure-threshold", 0, fmt.Sprintf("specify the consecutive failures for %s probe", kind))
+}
+
+func getProbe(c *cobra.Command, kind string) *gpmv1.Probe {
+	probe := &gpmv1.Probe{}
+	httpURL, _ := c.Flags().GetString(kind + "-http")
+	tcpAddress, _ := c.Flags().GetString(kind + "-tcp")
+	command, _ := c.Flags().GetString(kind + "-exec")
+	switch {
+	case httpURL != "":
+		probe.Type = gpmv1.ProbeHTTP
+		probe.Url = httpURL
+	case tcpAddress != "":
+		probe.Type = gpmv1.ProbeTCP
+		probe.Address = tcpAddress
+	case command != "":
+		probe.Type = gpmv1.ProbeExec
+		probe.Command = strings.Fields(command)
+	default:
+		return nil
+	}
+	probe.ExpectStatus, _ = c.Flags().GetInt32(kind + "-expect-status")
+	probe.InitialDelay, _ = c.Flags().GetInt64(kind + "-initial-delay")
+	probe.Interval, _ = c.Flags().GetInt64(kind + "-interval")
+	probe.Timeout, _ = c.Flags().GetInt64(kind + "-timeout")
+	probe.FailureThreshold, _ = c.Flags().GetInt32(kind + "-failure-threshold")
+	return probe
+}
+
+// probeString 描述健康探测参数
+func probeString(probe *gpmv1.Probe) string {
+	target := probe.Url
+	switch probe.Type {
+	case gpmv1.ProbeTCP:
+		target = probe.Address
+	case gpmv1.ProbeExec:
+		target = strings.Join(probe.Command, " ")
+	}
+	return fmt.Sprintf("%s %s, delay=%ds, interval=%ds, timeout=%ds, threshold=%d",
+		probe.Type, target, probe.InitialDelay, probe.Interval, probe.Timeout, probe.FailureThreshold)
+}
+
+// addHookFlags 添加生命周期钩子参数
+func addHookFlags(cmd *cobra.Command) {
+	for _, kind := range []string{"pre-start", "post-start", "pre-stop", "post-stop"} {
+		cmd.PersistentFlags().String(kind+"-exec", "", fmt.Sprintf("specify the command for %s hook", kind))
+		cmd.PersistentFlags().String(kind+"-http", "", fmt.Sprintf("specify the url for %s http hook", kind))
+		cmd.PersistentFlags().String(kind+"-method", "", fmt.Sprintf("specify the method for %s http hook, default GET", kind))
+		cmd.PersistentFlags().Int64(kind+"-timeout", 0, fmt.Sprintf("specify the timeout seconds for %s hook", kind))
+		cmd.PersistentFlags().String(kind+"-on-failure", "", fmt.Sprintf("specify the behavior when %s hook fails, example abort, continue", kind))
+	}
+}
+
+func getHook(c *cobra.Command, kind string) *gpmv1.Hook {
+	hook := &gpmv1.Hook{}
+	command, _ := c.Flags().GetString(kind + "-exec")
+	httpURL, _ := c.Flags().GetString(kind + "-http")
+	switch {
+	case command != "":
+		hook.Type = gpmv1.HookExec
+		hook.Command = strings.Fields(command)
+	case httpURL != "":
+		hook.Type = gpmv1.HookHTTP
+		hook.Url = httpURL
+	default:
+		return nil
+	}
+	hook.Method, _ = c.Flags().GetString(kind + "-method")
+	hook.Timeout, _ = c.Flags().GetInt64(kind + "-timeout")
+	hook.OnFailure, _ = c.Flags().GetString(kind + "-on-failure")
+	return hook
+}
+
+// getHooks 读取生命周期钩子参数, 均未指定时返回 nil
+func getHooks(c *cobra.Command) *gpmv1.Hooks {
+	hooks := &gpmv1.Hooks{
+		PreStart:  getHook(c, "pre-start"),
+		PostStart: getHook(c, "post-start"),
+		PreStop:   getHook(c, "pre-stop"),
+		PostStop:  getHook(c, "post-stop"),
+	}
+	if hooks.PreStart == nil && hooks.PostStart == nil && hooks.PreStop == nil && hooks.PostStop == nil {
+		return nil
+	}
+	return hooks
+}
+
+// hookString 描述生命周期钩子参数
+func hookString(hook *gpmv1.Hook) string {
+	target := strings.Join(hook.Command, " ")
+	if hook.Type == gpmv1.HookHTTP {
+		target = hook.Method + " " + hook.Url
+	}
+	return fmt.Sprintf("%s %s, timeout=%ds, onFailure=%s", hook.Type, target, hook.Timeout, hook.OnFailure)
+}
+
+func addReloadFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("reload-signal", "", "specify the signal sent to service when reloading, example SIGHUP")
+	cmd.PersistentFlags().String("reload-exec", "", "specify the command for reloading service")
+	cmd.PersistentFlags().Int64("reload-timeout", 0, "specify the timeout seconds for reload command")
+}
+
+// getReload 读取重新加载参数, 均未指定时返回 nil
+func getReload(c *cobra.Command) *gpmv1.Reload {
+	reload := &gpmv1.Reload{}
+	reload.Signal, _ = c.Flags().GetString("reload-signal")
+	command, _ := c.Flags().GetString("reload-exec")
+	reload.Command = strings.Fields(command)
+	if reload.Signal == "" && len(reload.Command) == 0 {
+		return nil
+	}
+	reload.Timeout, _ = c.Flags().GetInt64("reload-timeout")
+	return reload
+}
+
+// reloadString 描述重新加载参数
+func reloadString(reload *gpmv1.Reload) string {
+	if reload.Signal != "" {
+		return "signal " + reload.Signal
+	}
+	return fmt.Sprintf("exec %s, timeout=%ds", strings.Join(reload.Command, " "), reload.Timeout)
+}
+
+// getSockets 读取监听套接字参数, 格式为 address 或 name=address
+func getSockets(c *cobra.Command) []*gpmv1.Socket {
+	items, _ := c.Flags().GetStringSlice("listen")
+	sockets := make([]*gpmv1.Socket, 0, len(items))
+	for _, item := range items {
+		socket := &gpmv1.Socket{Address: item}
+		if i := strings.Index(item, "="); i > 0 {
+			socket.Name, socket.Address = item[:i], item[i+1:]
+		}
+		sockets = append(sockets, socket)
+	}
+	return sockets
+}
+
+// socketsString 描述监听套接字参数
+func socketsString(sockets []*gpmv1.Socket) string {
+	items := make([]string, 0, len(sockets))
+	for _, socket := range sockets {
+		if socket.Name != "" {
+			items = append(items, socket.Name+"="+socket.Address)
+		} else {
+			items = append(items, socket.Address)
+		}
+	}
+	return strings.Join(items, ",")
+}
+
+func addEnvFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("env-mode", "", "specify the source of service environment variables, inherit: inherit the environment of gpmd, clean: only PATH, HOME, USER and LOGNAME")
+	cmd.PersistentFlags().StringSlice("env-file", []string{}, "specify the dotenv files read when service starts, prefix '-' ignores missing file")
+}
+
+func addSecretFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSlice("secret-env", []string{}, "specify the secret passed to service as env, format VAR=secret")
+	cmd.PersistentFlags().StringSlice("secret-file", []string{}, "specify the secret written to the file in service directory, format path=secret")
+}
+
+// getSecrets 读取服务引用的密钥
+func getSecrets(c *cobra.Command) []*gpmv1.SecretRef {
+	refs := make([]*gpmv1.SecretRef, 0)
+	envs, _ := c.Flags().GetStringSlice("secret-env")
+	for _, item := range envs {
+		env, name, _ := strings.Cut(item, "=")
+		refs = append(refs, &gpmv1.SecretRef{Name: name, Env: env})
+	}
+	files, _ := c.Flags().GetStringSlice("secret-file")
+	for _, item := range files {
+		file, name, _ := strings.Cut(item, "=")
+		refs = append(refs, &gpmv1.SecretRef{Name: name, File: file})
+	}
+	return refs
+}
+
+// secretsString 描述服务引用的密钥
+func secretsString(refs []*gpmv1.SecretRef) string {
+	items := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Env != "" {
+			items = append(items, fmt.Sprintf("env %s=%s", ref.Env, ref.Name))
+		} else {
+			items = append(items, fmt.Sprintf("file %s=%s", ref.File, ref.Name))
+		}
+	}
+	return strings.Join(items, ", ")
+}
+
+func addLabelFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSlice("label", []string{}, "specify the labels for service, format key=value")
+}
+
+// getLabels 读取服务标签, 未指定时返回 nil
+func getLabels(c *cobra.Command) map[string]string {
+	items, _ := c.Flags().GetStringSlice("label")
+	if len(items) == 0 {
+		return nil
+	}
+	labels := map[string]string{}
+	for _, item := range items {
+		key, value, _ := strings.Cut(item, "=")
+		labels[key] = value
+	}
+	return labels
+}
+
+// labelsString 按照名称顺序描述服务标签
+func labelsString(labels map[string]string) string {
+	items := make([]string, 0, len(labels))
+	for key, value := range labels {
+		items = append(items, key+"="+value)
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+func addThresholdFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Uint64("max-memory", 0, "specify the rss bytes threshold for service")
+	cmd.PersistentFlags().Float32("max-mem-percent", 0, "specify the memory percent threshold for service")
+	cmd.PersistentFlags().Float64("max-cpu-percent", 0, "specify the cpu percent threshold for service, which lasts for --cpu-window")
+	cmd.PersistentFlags().Int64("cpu-window", 0, "specify the seconds which cpu percent exceeds threshold before taking action")
+	cmd.PersistentFlags().Int64("threshold-interval", 0, "specify the interval seconds for checking thresholds")
+	cmd.PersistentFlags().String("threshold-action", "", "specify the action when service exceeds thresholds, example restart, signal, event")
+	cmd.PersistentFlags().String("threshold-signal", "", "specify the signal sent to service for threshold action signal, example SIGUSR1")
+}
+
+// getThreshold 读取资源占用阈值参数, 未指定阈值时返回 nil
+func getThreshold(c *cobra.Command) *gpmv1.Threshold {
+	t := &gpmv1.Threshold{}
+	t.Memory, _ = c.Flags().GetUint64("max-memory")
+	t.MemPercent, _ = c.Flags().GetFloat32("max-mem-percent")
+	t.CpuPercent, _ = c.Flags().GetFloat64("max-cpu-percent")
+	if t.Memory == 0 && t.MemPercent == 0 && t.CpuPercent == 0 {
+		return nil
+	}
+	t.CpuWindow, _ = c.Flags().GetInt64("cpu-window")
+	t.Interval, _ = c.Flags().GetInt64("threshold-interval")
+	t.Action, _ = c.Flags().GetString("threshold-action")
+	t.Signal, _ = c.Flags().GetString("threshold-signal")
+	return t
+}
+
+// thresholdString 描述资源占用阈值参数
+func thresholdString(t *gpmv1.Threshold) string {
+	items := make([]string, 0)
+	if t.Memory > 0 {
+		items = append(items, fmt.Sprintf("memory=%s", unit.ConvAuto(int64(t.Memory), 2)))
+	}
+	if t.MemPercent > 0 {
+		items = append(items, fmt.Sprintf("memPercent=%g%%", t.MemPercent))
+	}
+	if t.CpuPercent > 0 {
+		items = append(items, fmt.Sprintf("cpuPercent=%g%% for %ds", t.CpuPercent, t.CpuWindow))
+	}
+	items = append(items, fmt.Sprintf("interval=%ds", t.Interval))
+	action := t.Action
+	if t.Signal != "" {
+		action += " " + t.Signal
+	}
+	items = append(items, "action="+action)
+	return strings.Join(items, ", ")
+}
+
+func addResourcesFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Int64("memory-limit", 0, "specify the max memory bytes for service, linux only")
+	cmd.PersistentFlags().Float64("cpu-limit", 0, "specify the max cpu cores for service, example 0.5, linux only")
+	cmd.PersistentFlags().Int64("pids-limit", 0, "specify the max number of processes for service, linux only")
+	cmd.PersistentFlags().Int32("io-weight", 0, "specify the io weight (1-10000) for service, linux only")
+}
+
+// getResources 读取资源限制参数, 均未指定时返回 nil
+func getResources(c *cobra.Command) *gpmv1.Resources {
+	r := &gpmv1.Resources{}
+	r.Memory, _ = c.Flags().GetInt64("memory-limit")
+	r.Cpu, _ = c.Flags().GetFloat64("cpu-limit")
+	r.Pids, _ = c.Flags().GetInt64("pids-limit")
+	r.IoWeight, _ = c.Flags().GetInt32("io-weight")
+	if r.Memory == 0 && r.Cpu == 0 && r.Pids == 0 && r.IoWeight == 0 {
+		return nil
+	}
+	return r
+}
+
+// resourcesString 描述资源限制参数
+func resourcesString(r *gpmv1.Resources) string {
+	items := make([]string, 0)
+	if r.Memory > 0 {
+		items = append(items, fmt.Sprintf("memory=%s", unit.ConvAuto(r.Memory, 2)))
+	}
+	if r.Cpu > 0 {
+		items = append(items, fmt.Sprintf("cpu=%g", r.Cpu))
+	}
+	if r.Pids > 0 {
+		items = append(items, fmt.Sprintf("pids=%d", r.Pids))
+	}
+	if r.IoWeight > 0 {
+		items = append(items, fmt.Sprintf("ioWeight=%d", r.IoWeight))
+	}
+	return strings.Join(items, ", ")
+}
+
+func addProcAttrFlags(cmd *cobra.Command) {
+	for _, name := range []string{"nofile", "nproc", "core", "memlock"} {
+		cmd.PersistentFlags().String("limit-"+name, "", fmt.Sprintf("specify the %s rlimit for service, example 65536, 1024:4096, unlimited, linux only", name))
+	}
+	cmd.PersistentFlags().Int32("nice", 0, "specify the nice (-20-19) for service, linux only")
+	cmd.PersistentFlags().String("ionice-class", "", "specify the io scheduling class for service, example realtime, best-effort, idle, linux only")
+	cmd.PersistentFlags().Int32("ionice-level", 0, "specify the io scheduling level (0-7) for service, linux only")
+	cmd.PersistentFlags().String("cpu-affinity", "", "specify the cpus which service can run on, example 0-3,6, linux only")
+	cmd.PersistentFlags().Int32("oom-score-adj", 0, "specify the oom_score_adj (-1000-1000) for service, linux only")
+}
+
+// getProcAttr 读取进程资源限制和调度参数, 返回是否指定了参数
+func getProcAttr(c *cobra.Command, attr *gpmv1.SysProcAttr) (bool, error) {
+	var err error
+	set := false
+	limits := map[string]**gpmv1.Rlimit{
+		"nofile":  &attr.LimitNofile,
+		"nproc":   &attr.LimitNproc,
+		"core":    &attr.LimitCore,
+		"memlock": &attr.LimitMemlock,
+	}
+	for name, limit := range limits {
+		text, _ := c.Flags().GetString("limit-" + name)
+		if text == "" {
+			continue
+		}
+		if *limit, err = parseRlimit(text); err != nil {
+			return false, fmt.Errorf("invalid %s limit: %v", name, err)
+		}
+		set = true
+	}
+
+	attr.Nice, _ = c.Flags().GetInt32("nice")
+	attr.IoniceClass, _ = c.Flags().GetString("ionice-class")
+	attr.IoniceLevel, _ = c.Flags().GetInt32("ionice-level")
+	attr.OomScoreAdj, _ = c.Flags().GetInt32("oom-score-adj")
+	cpus, _ := c.Flags().GetString("cpu-affinity")
+	if cpus != "" {
+		if attr.CpuAffinity, err = parseCPUs(cpus); err != nil {
+			return false, fmt.Errorf("invalid cpu affinity: %v", err)
+		}
+	}
+	if attr.Nice != 0 || attr.IoniceClass != "" || attr.OomScoreAdj != 0 || len(attr.CpuAffinity) > 0 {
+		set = true
+	}
+
+	return set, nil
+}
+
+// parseRlimit 解析 soft[:hard] 格式的资源限制, unlimited 表示不限制
+func parseRlimit(text string) (*gpmv1.Rlimit, error) {
+	parse := func(s string) (int64, error) {
+		if s == "unlimited" || s == "infinity" {
+			return -1, nil
+		}
+		return strconv.ParseInt(s, 10, 64)
+	}
+
+	limit := &gpmv1.Rlimit{}
+	parts := strings.SplitN(text, ":", 2)
+	var err error
+	if limit.Soft, err = parse(parts[0]); err != nil {
+		return nil, err
+	}
+	if len(parts) > 1 {
+		if limit.Hard, err = parse(parts[1]); err != nil {
+			return nil, err
+		}
+	}
+	return limit, nil
+}
+
+// parseCPUs 解析 0-3,6 格式的 cpu 列表
+func parseCPUs(text string) ([]int32, error) {
+	cpus := make([]int32, 0)
+	for _, item := range strings.Split(text, ",") {
+		lo, hi, found := strings.Cut(strings.TrimSpace(item), "-")
+		start, err := strconv.ParseInt(lo, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if found {
+			if end, err = strconv.ParseInt(hi, 10, 32); err != nil {
+				return nil, err
+			}
+		}
+		for i := start; i <= end; i++ {
+			cpus = append(cpus, int32(i))
+		}
+	}
+	return cpus, nil
+}
+
+// procAttrStrings 描述进程资源限制和调度参数
+func procAttrStrings(attr *gpmv1.SysProcAttr) [][]string {
+	format := func(n int64) string {
+		if n == -1 {
+			return "unlimited"
+		}
+		return strconv.FormatInt(n, 10)
+	}
+
+	rows := make([][]string, 0)
+	limits := make([]string, 0)
+	for _, item := range []struct {
+		name  string
+		limit *gpmv1.Rlimit
+	}{
+		{"nofile", attr.LimitNofile},
+		{"nproc", attr.LimitNproc},
+		{"core", attr.LimitCore},
+		{"memlock", attr.LimitMemlock},
+	} {
+		if item.limit == nil {
+			continue
+		}
+		hard := item.limit.Hard
+		if hard == 0 {
+			hard = item.limit.Soft
+		}
+		limits = append(limits, fmt.Sprintf("%s=%s:%s", item.name, format(item.limit.Soft), format(hard)))
+	}
+	if len(limits) > 0 {
+		rows = append(rows, []string{"Rlimits", strings.Join(limits, ", ")})
+	}
+	if attr.Nice != 0 {
+		rows = append(rows, []string{"Nice", fmt.Sprintf("%d", attr.Nice)})
+	}
+	if attr.IoniceClass != "" {
+		rows = append(rows, []string{"Ionice", fmt.Sprintf("class=%s, level=%d", attr.IoniceClass, attr.IoniceLevel)})
+	}
+	if len(attr.CpuAffinity) > 0 {
+		cpus := make([]string, 0, len(attr.CpuAffinity))
+		for _, cpu := range attr.CpuAffinity {
+			cpus = append(cpus, fmt.Sprintf("%d", cpu))
+		}
+		rows = append(rows, []string{"CpuAffinity", strings.Join(cpus, ",")})
+	}
+	if attr.OomScoreAdj != 0 {
+		rows = append(rows, []string{"OomScoreAdj", fmt.Sprintf("%d", attr.OomScoreAdj)})
+	}
+	return rows
+}
+
 func GetVersion() string {
 	return internal.GetVersion()
 }
@@ -0,0 +1,123 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/client"
+)
+
+func historyService(c *cobra.Command, args []string) error {
+
+	name, _ := c.Flags().GetString("name")
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("missing name")
+	}
+	logs, _ := c.Flags().GetBool("logs")
+
+	opts := getCallOptions(c)
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+
+	list, err := cc.ListServiceExits(ctx, name, opts...)
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		fmt.Fprintf(outE, "service '%s' has no exit records\n", name)
+		return nil
+	}
+
+	tw := tablewriter.NewWriter(outE)
+	tw.SetHeader([]string{"Instance", "Pid", "Start", "Exit", "Reason", "Code", "Restarts", "Message"})
+	for _, item := range list {
+		status := item.Status
+		if status == nil {
+			status = &gpmv1.ExitStatus{}
+		}
+		code := fmt.Sprintf("%d", status.Code)
+		if status.Signal != "" {
+			code = status.Signal
+		}
+		exit := time.Unix(status.ExitTimestamp, 0).Format(time.RFC3339)
+		// 进程运行中的事件, 如资源占用超过阈值
+		if status.ExitTimestamp == 0 {
+			exit, code = "-", "-"
+		}
+
+		row := make([]string, 0)
+		row = append(row, fmt.Sprintf("%d", item.Instance))
+		row = append(row, fmt.Sprintf("%d", status.Pid))
+		row = append(row, time.Unix(status.StartTimestamp, 0).Format(time.RFC3339))
+		row = append(row, exit)
+		row = append(row, item.Reason)
+		row = append(row, code)
+		row = append(row, fmt.Sprintf("%d", item.Restarts))
+		row = append(row, item.Message)
+		tw.Append(row)
+	}
+	tw.Render()
+
+	if logs {
+		for _, item := range list {
+			if len(item.Logs) == 0 || item.Status == nil {
+				continue
+			}
+			at := item.Status.ExitTimestamp
+			if at == 0 {
+				at = item.Timestamp
+			}
+			fmt.Fprintf(outE, "\n==> instance %d, pid %d, %s at %s <==\n", item.Instance, item.Status.Pid,
+				item.Reason, time.Unix(at, 0).Format(time.RFC3339))
+			fmt.Fprintln(outE, strings.Join(item.Logs, "\n"))
+		}
+	}
+
+	return nil
+}
+
+func HistoryServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history [name]",
+		Short:   "list the exit and threshold history of service",
+		GroupID: "service",
+		RunE:    historyService,
+	}
+
+	cmd.PersistentFlags().StringP("name", "N", "", "specify the name for service")
+	cmd.PersistentFlags().BoolP("logs", "l", false, "show the last logs when process exited")
+
+	return cmd
+}
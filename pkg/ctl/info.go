@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"sort"
 	"time"
 
 	json "github.com/json-iterator/go"
@@ -77,6 +78,17 @@ func infoService(c *cobra.Command, args []string) error {
 			t.Append([]string{"Memory", fmt.Sprintf("%s/%.1f%%", unit.ConvAuto(int64(s.Stat.Memory), 2), s.Stat.MemPercent)})
 		}
 		t.Append([]string{"UpTime", (time.Duration(s.UpTime) * time.Second).String()})
+		if s.Cgroup != "" {
+			t.Append([]string{"Cgroup", s.Cgroup})
+		}
+		names := make([]string, 0, len(s.Resources))
+		for name := range s.Resources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			t.Append([]string{fmt.Sprintf("Resources[%s]", name), resourcesString(s.Resources[name])})
+		}
 		t.SetColumnColor(tw.Colors{tw.Bold}, tw.Colors{})
 		t.Render()
 	case "json":
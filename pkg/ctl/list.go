@@ -30,6 +30,7 @@ import (
 
 	twr "github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/client"
 	"github.com/vine-io/pkg/unit"
 )
@@ -49,34 +50,79 @@ func listService(c *cobra.Command, args []string) error {
 		return fmt.Errorf("no services")
 	}
 
+	// 存在 cron 服务时显示运行时间
+	hasCron := false
+	for _, item := range list {
+		if item.Type == gpmv1.ServiceCron {
+			hasCron = true
+		}
+	}
+
 	tw := twr.NewWriter(outE)
-	tw.SetHeader([]string{"Name", "Version", "User", "Pid", "CPU", "Memory", "Status", "Uptime"})
+	header := []string{"Name", "Version", "User", "Pid", "CPU", "Memory", "Status", "Health", "Uptime"}
+	if hasCron {
+		header = append(header, "LastRun", "NextRun")
+	}
+	tw.SetHeader(header)
 
 	for _, item := range list {
-		row := make([]string, 0)
-		row = append(row, item.Name)
-		row = append(row, item.Version)
+		user := " "
 		if item.SysProcAttr != nil {
-			row = append(row, fmt.Sprintf("%s:%s", item.SysProcAttr.User, item.SysProcAttr.Group))
-		} else {
-			row = append(row, " ")
+			user = fmt.Sprintf("%s:%s", item.SysProcAttr.User, item.SysProcAttr.Group)
+		}
+		if len(item.Instances) > 1 {
+			for _, instance := range item.Instances {
+				name := fmt.Sprintf("%s[%d]", item.Name, instance.Index)
+				row := listRow(name, item.Version, user, instance.Pid, instance.Stat, instance.Status, instance.Health, instance.StartTimestamp)
+				// 多实例的服务不是 cron 服务, 运行时间为空
+				if hasCron {
+					row = append(row, " ", " ")
+				}
+				tw.Append(row)
+			}
+			continue
+		}
+		row := listRow(item.Name, item.Version, user, item.Pid, item.Stat, item.Status, item.Health, item.StartTimestamp)
+		if hasCron {
+			row = append(row, formatTimestamp(item.LastRunTimestamp), formatTimestamp(item.NextRunTimestamp))
 		}
-		row = append(row, fmt.Sprintf("%d", item.Pid))
-		row = append(row, fmt.Sprintf("%.1f%%", item.Stat.CpuPercent))
-		row = append(row, fmt.Sprintf("%s/%.1f%%", unit.ConvAuto(int64(item.Stat.Memory), 1), item.Stat.MemPercent))
-		row = append(row, item.Status)
-		row = append(row, time.Now().Sub(time.Unix(item.StartTimestamp, 0)).String())
 		tw.Append(row)
 	}
 
-	tw.SetColumnColor(twr.Colors{}, twr.Colors{}, twr.Colors{}, twr.Colors{}, twr.Colors{},
-		twr.Colors{}, twr.Colors{twr.FgRedColor}, twr.Colors{})
+	colors := make([]twr.Colors, len(header))
+	colors[6] = twr.Colors{twr.FgRedColor}
+	tw.SetColumnColor(colors...)
 	tw.Render()
 	fmt.Fprintf(os.Stdout, "\nTotal: %d\n", total)
 
 	return nil
 }
 
+func listRow(name, version, user string, pid int64, stat *gpmv1.Stat, status, health string, start int64) []string {
+	if stat == nil {
+		stat = &gpmv1.Stat{}
+	}
+	row := make([]string, 0)
+	row = append(row, name)
+	row = append(row, version)
+	row = append(row, user)
+	row = append(row, fmt.Sprintf("%d", pid))
+	row = append(row, fmt.Sprintf("%.1f%%", stat.CpuPercent))
+	row = append(row, fmt.Sprintf("%s/%.1f%%", unit.ConvAuto(int64(stat.Memory), 1), stat.MemPercent))
+	row = append(row, status)
+	row = append(row, health)
+	row = append(row, time.Now().Sub(time.Unix(start, 0)).String())
+	return row
+}
+
+// formatTimestamp 格式化时间戳, 0 时显示为空
+func formatTimestamp(ts int64) string {
+	if ts == 0 {
+		return " "
+	}
+	return time.Unix(ts, 0).Format("2006-01-02 15:04:05")
+}
+
 func ListServicesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "list",
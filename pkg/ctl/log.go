@@ -26,10 +26,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/client"
 	"google.golang.org/grpc/status"
 )
@@ -37,17 +41,21 @@ import (
 func tailService(c *cobra.Command, args []string) error {
 	opts := getCallOptions(c)
 	name, _ := c.Flags().GetString("name")
-	number, _ := c.Flags().GetInt64("number")
-	follow, _ := c.Flags().GetBool("follow")
 	if len(name) == 0 {
 		return fmt.Errorf("missing name")
 	}
+	lo, err := getLogOptions(c)
+	if err != nil {
+		return err
+	}
+	lo.Instance, _ = c.Flags().GetInt32("instance")
 
 	cc := client.New()
 	ctx := context.Background()
 	outE := os.Stdout
+	errE := os.Stderr
 
-	s, err := cc.WatchServiceLog(ctx, name, number, follow, opts...)
+	s, err := cc.WatchServiceLog(ctx, name, lo, opts...)
 	if err != nil {
 		return err
 	}
@@ -58,7 +66,12 @@ func tailService(c *cobra.Command, args []string) error {
 			return errors.New(status.Convert(err).Message())
 		}
 		if err == io.EOF {
-			s, err = cc.WatchServiceLog(ctx, name, number, follow, opts...)
+			if !lo.Follow || (lo.Until > 0 && time.Now().Unix() > lo.Until) {
+				break
+			}
+			// 重新连接后从最后收到的日志开始监听
+			lo.Number = 0
+			s, err = cc.WatchServiceLog(ctx, name, lo, opts...)
 			if err != nil {
 				return err
 			}
@@ -67,15 +80,132 @@ func tailService(c *cobra.Command, args []string) error {
 		if b.Error != "" {
 			return errors.New(b.Error)
 		}
-		fmt.Fprintln(outE, b.Text)
+		if b.Timestamp > lo.Since {
+			lo.Since = b.Timestamp
+		}
+		if b.Stream == "stderr" {
+			fmt.Fprintln(errE, b.Text)
+		} else {
+			fmt.Fprintln(outE, b.Text)
+		}
+	}
+
+	return nil
+}
+
+func logsService(c *cobra.Command, args []string) error {
+	opts := getCallOptions(c)
+	selector, _ := c.Flags().GetString("selector")
+	all, _ := c.Flags().GetBool("all")
+	noColor, _ := c.Flags().GetBool("no-color")
+	if len(args) == 0 && selector == "" && !all {
+		return fmt.Errorf("missing services, specify names, --selector or --all")
+	}
+	lo, err := getLogOptions(c)
+	if err != nil {
+		return err
+	}
+
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+	errE := os.Stderr
+
+	s, err := cc.WatchServicesLog(ctx, args, selector, all, lo, opts...)
+	if err != nil {
+		return err
+	}
+
+	width := 0
+	for {
+		b, err := s.Next()
 		if err == io.EOF {
 			break
 		}
+		if err != nil {
+			return errors.New(status.Convert(err).Message())
+		}
+		if b.Error != "" {
+			return errors.New(b.Error)
+		}
+
+		// 其他实例的日志与日志文件的名称一致, 显示为 <服务名称>.<实例序号>
+		name := b.Service
+		if b.Instance > 0 {
+			name = fmt.Sprintf("%s.%d", b.Service, b.Instance)
+		}
+		if len(name) > width {
+			width = len(name)
+		}
+		prefix := fmt.Sprintf("%-*s |", width, name)
+		if !noColor {
+			prefix = serviceColor(name) + prefix + "\x1b[0m"
+		}
+		if b.Stream == "stderr" {
+			fmt.Fprintln(errE, prefix, b.Text)
+		} else {
+			fmt.Fprintln(outE, prefix, b.Text)
+		}
 	}
 
 	return nil
 }
 
+// serviceColor 根据服务实例的名称选择显示的颜色
+func serviceColor(name string) string {
+	colors := []int{32, 33, 34, 35, 36, 92, 93, 94, 95, 96}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("\x1b[%dm", colors[h.Sum32()%uint32(len(colors))])
+}
+
+func addLogFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Int64P("number", "n", 100, "specify the number of lines of service log, 0 means all lines")
+	cmd.PersistentFlags().BoolP("follow", "f", false, "whether watching service log")
+	cmd.PersistentFlags().Bool("stderr-only", false, "only show the stderr of service")
+	cmd.PersistentFlags().String("since", "", "show logs written after the time, e.g. 1h, 2006-01-02 15:04:05, RFC3339 or unix timestamp")
+	cmd.PersistentFlags().String("until", "", "show logs written before the time, same format as --since")
+	cmd.PersistentFlags().StringP("grep", "g", "", "only show logs matching the regular expression")
+}
+
+// getLogOptions 读取查询日志的参数
+func getLogOptions(c *cobra.Command) (*gpmv1.LogOptions, error) {
+	lo := &gpmv1.LogOptions{}
+	lo.Number, _ = c.Flags().GetInt64("number")
+	lo.Follow, _ = c.Flags().GetBool("follow")
+	lo.StderrOnly, _ = c.Flags().GetBool("stderr-only")
+	lo.Grep, _ = c.Flags().GetString("grep")
+
+	var err error
+	if lo.Since, err = getLogTime(c, "since"); err != nil {
+		return nil, err
+	}
+	if lo.Until, err = getLogTime(c, "until"); err != nil {
+		return nil, err
+	}
+	return lo, nil
+}
+
+// getLogTime 解析日志的时间范围, 支持相对当前的时长 (如 1h30m)、RFC3339、"2006-01-02 15:04:05"、"2006-01-02" 以及 unix 时间戳
+func getLogTime(c *cobra.Command, flag string) (int64, error) {
+	value, _ := c.Flags().GetString(flag)
+	if value == "" {
+		return 0, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d).Unix(), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil && n > 0 {
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid --%s: %s", flag, value)
+}
+
 func TailServiceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "tail",
@@ -85,8 +215,24 @@ func TailServiceCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringP("name", "N", "", "specify the name of service")
-	cmd.PersistentFlags().Int64P("number", "n", 1024, "specify the number of service log")
-	cmd.PersistentFlags().BoolP("follow", "f", false, "whether watching service log")
+	cmd.PersistentFlags().Int32P("instance", "i", 0, "specify the instance index of service")
+	addLogFlags(cmd)
+
+	return cmd
+}
+
+func LogsServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "logs [NAME...]",
+		Short:   "show the merged logs of multiple services",
+		GroupID: "service",
+		RunE:    logsService,
+	}
+
+	cmd.PersistentFlags().StringP("selector", "l", "", "specify the label selector of services, e.g. app=web,env!=dev")
+	cmd.PersistentFlags().Bool("all", false, "show the logs of all services")
+	cmd.PersistentFlags().Bool("no-color", false, "disable the color of service name")
+	addLogFlags(cmd)
 
 	return cmd
 }
@@ -52,7 +52,15 @@ func ExecCmd() error {
 		StopServiceCmd(),
 		DeleteServiceCmd(),
 		RestartServiceCmd(),
+		ReloadServiceCmd(),
+		SignalServiceCmd(),
+		PauseServiceCmd(),
+		ResumeServiceCmd(),
 		TailServiceCmd(),
+		LogsServiceCmd(),
+		HistoryServiceCmd(),
+		RunServiceCmd(),
+		SecretCmd(),
 
 		InstallServiceCmd(),
 		UpgradeServiceCmd(),
@@ -0,0 +1,174 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/client"
+	"github.com/vine-io/pkg/unit"
+)
+
+func createSecret(c *cobra.Command, args []string) error {
+
+	name, _ := c.Flags().GetString("name")
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("missing name")
+	}
+
+	value, _ := c.Flags().GetString("value")
+	file, _ := c.Flags().GetString("from-file")
+	spec := &gpmv1.SecretSpec{Name: name}
+	switch {
+	case value != "" && file != "":
+		return fmt.Errorf("--value and --from-file can not be used together")
+	case value != "":
+		spec.Value = []byte(value)
+	case file != "":
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		spec.Value = b
+	default:
+		// 从标准输入读取, 避免密钥出现在命令行历史中
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		spec.Value = b
+	}
+	if len(spec.Value) == 0 {
+		return fmt.Errorf("missing value")
+	}
+
+	opts := getCallOptions(c)
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+
+	s, err := cc.CreateSecret(ctx, spec, opts...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(outE, "save secret '%s' successfully!\n", s.Name)
+	return nil
+}
+
+func listSecrets(c *cobra.Command, args []string) error {
+
+	opts := getCallOptions(c)
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+
+	list, err := cc.ListSecrets(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	if len(list) > 0 {
+		tw := tablewriter.NewWriter(outE)
+		tw.SetHeader([]string{"Name", "Size", "Created", "Updated"})
+		for _, item := range list {
+			row := make([]string, 0)
+			row = append(row, item.Name)
+			row = append(row, unit.ConvAuto(item.Size, 2))
+			row = append(row, time.Unix(item.CreationTimestamp, 0).Format(time.RFC3339))
+			row = append(row, time.Unix(item.UpdateTimestamp, 0).Format(time.RFC3339))
+			tw.Append(row)
+		}
+		tw.Render()
+	}
+
+	return nil
+}
+
+func deleteSecret(c *cobra.Command, args []string) error {
+
+	name, _ := c.Flags().GetString("name")
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("missing name")
+	}
+
+	opts := getCallOptions(c)
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+
+	s, err := cc.DeleteSecret(ctx, name, opts...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(outE, "delete secret '%s' successfully!\n", s.Name)
+	return nil
+}
+
+func SecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "secret",
+		Short:   "manage the encrypted secrets used by services",
+		GroupID: "service",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: "create a secret or replace its value, read the value from stdin by default",
+		RunE:  createSecret,
+	}
+	createCmd.PersistentFlags().StringP("name", "N", "", "specify the name for secret")
+	createCmd.PersistentFlags().String("value", "", "specify the value for secret")
+	createCmd.PersistentFlags().String("from-file", "", "read the value for secret from file")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list all secrets without values",
+		RunE:  listSecrets,
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "delete a secret which is not used by any service",
+		RunE:  deleteSecret,
+	}
+	deleteCmd.PersistentFlags().StringP("name", "N", "", "specify the name for secret")
+
+	cmd.AddCommand(createCmd, listCmd, deleteCmd)
+
+	return cmd
+}
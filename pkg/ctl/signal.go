@@ -0,0 +1,77 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/vine-io/gpm/pkg/client"
+)
+
+func signalService(c *cobra.Command, args []string) error {
+
+	name, _ := c.Flags().GetString("name")
+	signal, _ := c.Flags().GetString("signal")
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if len(args) > 1 {
+		signal = args[1]
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("missing name")
+	}
+	if len(signal) == 0 {
+		return fmt.Errorf("missing signal")
+	}
+
+	opts := getCallOptions(c)
+	cc := client.New()
+	ctx := context.Background()
+	outE := os.Stdout
+
+	s, err := cc.SignalService(ctx, name, signal, opts...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(outE, "send %s to service '%s'\n", signal, s.Name)
+	return nil
+}
+
+func SignalServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signal [name] [signal]",
+		Short:   "send a signal to a service",
+		GroupID: "service",
+		RunE:    signalService,
+	}
+
+	cmd.PersistentFlags().StringP("name", "N", "", "specify the name of service")
+	cmd.PersistentFlags().StringP("signal", "s", "", "specify the signal, example HUP, SIGUSR1")
+
+	return cmd
+}
@@ -37,12 +37,14 @@ func stopService(c *cobra.Command, args []string) error {
 		return fmt.Errorf("missing name")
 	}
 
+	dependents, _ := c.Flags().GetBool("dependents")
+
 	opts := getCallOptions(c)
 	cc := client.New()
 	ctx := context.Background()
 	outE := os.Stdout
 
-	s, err := cc.StopService(ctx, name, opts...)
+	s, err := cc.StopService(ctx, name, dependents, opts...)
 	if err != nil {
 		return err
 	}
@@ -60,6 +62,7 @@ func StopServiceCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringP("name", "N", "", "specify the name of service")
+	cmd.PersistentFlags().Bool("dependents", false, "stop the services which depend on this service first")
 
 	return cmd
 }
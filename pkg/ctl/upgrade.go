@@ -29,11 +29,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	pbr "github.com/schollz/progressbar/v3"
 	"github.com/spf13/cobra"
 	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/client"
+	vclient "github.com/vine-io/vine/core/client"
 	"google.golang.org/grpc/status"
 )
 
@@ -54,8 +56,14 @@ func upgradeService(c *cobra.Command, args []string) error {
 		return fmt.Errorf("missing version")
 	}
 	spec.HeaderTrimPrefix, _ = c.Flags().GetString("header-prefix")
+	spec.VerifyTimeout, _ = c.Flags().GetInt64("verify-timeout")
 
 	opts := getCallOptions(c)
+	if spec.VerifyTimeout > 0 {
+		// 等待服务端验证新版本
+		requestTimeout, _ := c.Flags().GetDuration("request-timeout")
+		opts = append(opts, vclient.WithStreamTimeout(requestTimeout+time.Duration(spec.VerifyTimeout)*time.Second))
+	}
 	cc := client.New()
 	ctx := context.Background()
 	ech := make(chan error, 1)
@@ -128,6 +136,13 @@ func upgradeService(c *cobra.Command, args []string) error {
 				ech <- errors.New(status.Convert(err).Message())
 				return
 			}
+			if len(b.Message) != 0 {
+				fmt.Fprintf(outE, "%s\n", b.Message)
+			}
+			if len(b.Error) != 0 && len(b.Rollback) != 0 {
+				ech <- fmt.Errorf("%s, rollback to %s", b.Error, b.Rollback)
+				return
+			}
 			if len(b.Error) != 0 {
 				ech <- errors.New(b.Error)
 				return
@@ -162,6 +177,7 @@ func UpgradeServiceCmd() *cobra.Command {
 	cmd.PersistentFlags().StringP("name", "N", "", "specify the name for service")
 	cmd.PersistentFlags().StringP("version", "V", "", "specify the version for service")
 	cmd.PersistentFlags().String("header-prefix", "", "specify the version for gzip header")
+	cmd.PersistentFlags().Int64("verify-timeout", 0, "specify the seconds that new version must keep running and healthy, otherwise rollback")
 
 	return cmd
 }
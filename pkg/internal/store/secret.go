@@ -0,0 +1,347 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package store
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// 本机密钥的长度, 使用 AES-256-GCM 加密密钥的值
+const secretKeySize = 32
+
+var secretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9._-]*$`)
+
+// ValidSecretName 判断密钥名称是否合法, 名称不能包含路径分隔符, 也不能以 . 开头
+func ValidSecretName(name string) bool {
+	return secretNameRegexp.MatchString(name)
+}
+
+// secretFile 返回密钥文件的路径, 名称不合法时返回 ErrInvalidName
+func secretFile(name, ext string) (string, error) {
+	if !ValidSecretName(name) {
+		return "", fmt.Errorf("%w: secret '%s'", ErrInvalidName, name)
+	}
+	return filepath.Join(secretRoot(), name+ext), nil
+}
+
+// secretRoot 返回保存密钥的目录, 每个密钥保存为 <name>.yml (元数据) 和 <name>.enc (加密后的值)
+func secretRoot() string {
+	return filepath.Join(config.LoadRoot(), "secrets")
+}
+
+// loadSecretKey 读取本机密钥, create 为 true 时密钥不存在则生成
+func loadSecretKey(create bool) ([]byte, error) {
+	f := filepath.Join(config.LoadRoot(), "secret.key")
+	key, err := os.ReadFile(f)
+	if err == nil {
+		if len(key) != secretKeySize {
+			return nil, fmt.Errorf("invalid secret key %s", f)
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) || !create {
+		return nil, fmt.Errorf("read secret key: %v", err)
+	}
+
+	key = make([]byte, secretKeySize)
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(f, key, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// sealSecret 加密密钥的值, 密钥名称作为附加数据, 防止替换其他密钥的文件
+func sealSecret(key []byte, name string, value []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, value, []byte(name)), nil
+}
+
+// openSecret 解密密钥的值
+func openSecret(key []byte, name string, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("invalid secret data")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(name))
+}
+
+func (db *DB) FindAllSecrets(ctx context.Context) ([]*gpmv1.Secret, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+		outs = make([]*gpmv1.Secret, 0)
+	)
+
+	go func() {
+		db.secretMu.Lock()
+		defer db.secretMu.Unlock()
+
+		entries, err := os.ReadDir(secretRoot())
+		if err != nil && !os.IsNotExist(err) {
+			ech <- err
+			return
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".yml" {
+				continue
+			}
+			b, err := os.ReadFile(filepath.Join(secretRoot(), entry.Name()))
+			if err != nil {
+				continue
+			}
+			s := new(gpmv1.Secret)
+			if err = yaml.Unmarshal(b, &s); err != nil {
+				continue
+			}
+			outs = append(outs, s)
+		}
+		sort.Slice(outs, func(i, j int) bool { return outs[i].Name < outs[j].Name })
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return outs, nil
+	}
+}
+
+func (db *DB) FindSecret(ctx context.Context, name string) (*gpmv1.Secret, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+		out  = new(gpmv1.Secret)
+	)
+
+	go func() {
+		db.secretMu.Lock()
+		defer db.secretMu.Unlock()
+
+		f, err := secretFile(name, ".yml")
+		if err != nil {
+			ech <- err
+			return
+		}
+		b, err := os.ReadFile(f)
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = fmt.Errorf("%w: secret '%s'", ErrNotFound, name)
+			}
+			ech <- err
+			return
+		}
+		if err = yaml.Unmarshal(b, &out); err != nil {
+			ech <- err
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return out, nil
+	}
+}
+
+// ReadSecret 读取并解密密钥的值
+func (db *DB) ReadSecret(ctx context.Context, name string) ([]byte, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+		out  []byte
+	)
+
+	go func() {
+		db.secretMu.Lock()
+		defer db.secretMu.Unlock()
+
+		f, err := secretFile(name, ".enc")
+		if err != nil {
+			ech <- err
+			return
+		}
+		data, err := os.ReadFile(f)
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = fmt.Errorf("%w: secret '%s'", ErrNotFound, name)
+			}
+			ech <- err
+			return
+		}
+		key, err := loadSecretKey(false)
+		if err != nil {
+			ech <- err
+			return
+		}
+		out, err = openSecret(key, name, data)
+		if err != nil {
+			ech <- fmt.Errorf("decrypt secret '%s': %v", name, err)
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return out, nil
+	}
+}
+
+// SaveSecret 加密并保存密钥的值, 文件只有 gpmd 的用户可以读取
+func (db *DB) SaveSecret(ctx context.Context, s *gpmv1.Secret, value []byte) (*gpmv1.Secret, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+	)
+
+	go func() {
+		db.secretMu.Lock()
+		defer db.secretMu.Unlock()
+
+		if !ValidSecretName(s.Name) {
+			ech <- fmt.Errorf("%w: secret '%s'", ErrInvalidName, s.Name)
+			return
+		}
+		if err := os.MkdirAll(secretRoot(), 0700); err != nil {
+			ech <- err
+			return
+		}
+		key, err := loadSecretKey(true)
+		if err != nil {
+			ech <- err
+			return
+		}
+		data, err := sealSecret(key, s.Name, value)
+		if err != nil {
+			ech <- err
+			return
+		}
+		b, err := yaml.Marshal(s)
+		if err != nil {
+			ech <- err
+			return
+		}
+
+		// 先写入临时文件, 避免保存失败时破坏原来的值
+		for _, item := range []struct {
+			name string
+			data []byte
+		}{{s.Name + ".enc", data}, {s.Name + ".yml", b}} {
+			f := filepath.Join(secretRoot(), item.name)
+			if err = os.WriteFile(f+".tmp", item.data, 0600); err == nil {
+				err = os.Rename(f+".tmp", f)
+			}
+			if err != nil {
+				_ = os.Remove(f + ".tmp")
+				ech <- err
+				return
+			}
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return s, nil
+	}
+}
+
+func (db *DB) DeleteSecret(ctx context.Context, name string) error {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+	)
+
+	go func() {
+		db.secretMu.Lock()
+		defer db.secretMu.Unlock()
+
+		for _, ext := range []string{".yml", ".enc"} {
+			f, err := secretFile(name, ext)
+			if err != nil {
+				ech <- err
+				return
+			}
+			err = os.Remove(f)
+			if err != nil && !os.IsNotExist(err) {
+				ech <- err
+				return
+			}
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return e
+	case <-done:
+		return nil
+	}
+}
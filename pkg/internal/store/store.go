@@ -31,6 +31,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	json "github.com/json-iterator/go"
@@ -43,9 +44,16 @@ import (
 var (
 	ErrTimeout  = errors.New("db request resource timeout")
 	ErrNotFound = errors.New("resource not found")
+	// ErrInvalidName 资源名称不合法, 避免名称中的路径访问保存目录之外的文件
+	ErrInvalidName = errors.New("invalid resource name")
 )
 
-type DB struct{}
+type DB struct {
+	// exitMu 保护服务退出记录的读写
+	exitMu sync.Mutex
+	// secretMu 保护密钥的读写
+	secretMu sync.Mutex
+}
 
 func (db *DB) FindAllServices(ctx context.Context) ([]*gpmv1.Service, error) {
 	var (
@@ -303,3 +311,165 @@ func (db *DB) DeleteService(ctx context.Context, name string) error {
 		return nil
 	}
 }
+
+func (db *DB) FindInstance(ctx context.Context, name string, index int32) (*gpmv1.Instance, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+		out  = new(gpmv1.Instance)
+	)
+
+	go func() {
+		f := filepath.Join(config.LoadRoot(), "services", name, "instances", fmt.Sprintf("%d.yml", index))
+		b, err := os.ReadFile(f)
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = fmt.Errorf("%w: instance '%s-%d'", ErrNotFound, name, index)
+			}
+			ech <- err
+			return
+		}
+
+		if err = yaml.Unmarshal(b, &out); err != nil {
+			ech <- err
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return out, nil
+	}
+}
+
+func (db *DB) UpdateInstance(ctx context.Context, name string, in *gpmv1.Instance) error {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+	)
+
+	go func() {
+		b, err := yaml.Marshal(in)
+		if err != nil {
+			ech <- err
+			return
+		}
+		root := filepath.Join(config.LoadRoot(), "services", name, "instances")
+		_ = os.MkdirAll(root, os.ModePerm)
+		f := filepath.Join(root, fmt.Sprintf("%d.yml", in.Index))
+		if err = os.WriteFile(f, b, os.ModePerm); err != nil {
+			ech <- err
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return e
+	case <-done:
+		return nil
+	}
+}
+
+func (db *DB) DeleteInstance(ctx context.Context, name string, index int32) error {
+	var (
+		done = make(chan struct{}, 1)
+	)
+
+	go func() {
+		_ = os.Remove(filepath.Join(config.LoadRoot(), "services", name, "instances", fmt.Sprintf("%d.yml", index)))
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case <-done:
+		return nil
+	}
+}
+
+func (db *DB) ListServiceExits(ctx context.Context, name string) ([]*gpmv1.ServiceExit, error) {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+		outs = make([]*gpmv1.ServiceExit, 0)
+	)
+
+	go func() {
+		db.exitMu.Lock()
+		defer db.exitMu.Unlock()
+
+		b, err := os.ReadFile(filepath.Join(config.LoadRoot(), "services", name, "exits.yml"))
+		if err != nil {
+			if !os.IsNotExist(err) {
+				ech <- err
+				return
+			}
+			done <- struct{}{}
+			return
+		}
+
+		if err = yaml.Unmarshal(b, &outs); err != nil {
+			ech <- err
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return nil, e
+	case <-done:
+		return outs, nil
+	}
+}
+
+// AddServiceExit 保存服务的退出记录, 只保留最近的 limit 条
+func (db *DB) AddServiceExit(ctx context.Context, name string, exit *gpmv1.ServiceExit, limit int) error {
+	var (
+		done = make(chan struct{}, 1)
+		ech  = make(chan error, 1)
+	)
+
+	go func() {
+		db.exitMu.Lock()
+		defer db.exitMu.Unlock()
+
+		f := filepath.Join(config.LoadRoot(), "services", name, "exits.yml")
+		exits := make([]*gpmv1.ServiceExit, 0)
+		b, err := os.ReadFile(f)
+		if err == nil {
+			_ = yaml.Unmarshal(b, &exits)
+		}
+		exits = append(exits, exit)
+		if len(exits) > limit {
+			exits = exits[len(exits)-limit:]
+		}
+
+		b, err = yaml.Marshal(exits)
+		if err != nil {
+			ech <- err
+			return
+		}
+		if err = os.WriteFile(f, b, os.ModePerm); err != nil {
+			ech <- err
+			return
+		}
+
+		done <- struct{}{}
+	}()
+
+	select {
+	case e := <-ech:
+		return e
+	case <-done:
+		return nil
+	}
+}
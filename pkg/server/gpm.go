@@ -115,7 +115,7 @@ func (s *GpmServer) StopService(ctx context.Context, req *pb.StopServiceReq, rsp
 	if err = req.Validate(); err != nil {
 		return verrs.BadRequest(s.Name(), err.Error())
 	}
-	rsp.Service, err = s.manager.Stop(ctx, req.Name)
+	rsp.Service, err = s.manager.Stop(ctx, req.Name, req.Dependents)
 	return
 }
 
@@ -127,6 +127,46 @@ func (s *GpmServer) RestartService(ctx context.Context, req *pb.RestartServiceRe
 	return
 }
 
+func (s *GpmServer) RunService(ctx context.Context, req *pb.RunServiceReq, rsp *pb.RunServiceRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Service, err = s.manager.RunNow(ctx, req.Name)
+	return
+}
+
+func (s *GpmServer) SignalService(ctx context.Context, req *pb.SignalServiceReq, rsp *pb.SignalServiceRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Service, err = s.manager.Signal(ctx, req.Name, req.Signal)
+	return
+}
+
+func (s *GpmServer) ReloadService(ctx context.Context, req *pb.ReloadServiceReq, rsp *pb.ReloadServiceRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Service, err = s.manager.Reload(ctx, req.Name)
+	return
+}
+
+func (s *GpmServer) PauseService(ctx context.Context, req *pb.PauseServiceReq, rsp *pb.PauseServiceRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Service, err = s.manager.Pause(ctx, req.Name)
+	return
+}
+
+func (s *GpmServer) ResumeService(ctx context.Context, req *pb.ResumeServiceReq, rsp *pb.ResumeServiceRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Service, err = s.manager.Resume(ctx, req.Name)
+	return
+}
+
 func (s *GpmServer) DeleteService(ctx context.Context, req *pb.DeleteServiceReq, rsp *pb.DeleteServiceRsp) (err error) {
 	if err = req.Validate(); err != nil {
 		return verrs.BadRequest(s.Name(), err.Error())
@@ -139,7 +179,22 @@ func (s *GpmServer) WatchServiceLog(ctx context.Context, req *pb.WatchServiceLog
 	if err = req.Validate(); err != nil {
 		return verrs.BadRequest(s.Name(), err.Error())
 	}
-	return s.manager.TailLog(ctx, req.Name, req.Number, req.Follow, &simpleWatchLogSender{stream: stream})
+	opts := &gpmv1.LogOptions{
+		Number:     req.Number,
+		Follow:     req.Follow,
+		StderrOnly: req.StderrOnly,
+		Since:      req.Since,
+		Until:      req.Until,
+		Grep:       req.Grep,
+	}
+	return s.manager.TailLog(ctx, req.Name, opts, &simpleWatchLogSender{stream: stream})
+}
+
+func (s *GpmServer) WatchServicesLog(ctx context.Context, req *pb.WatchServicesLogReq, stream pb.GpmService_WatchServicesLogStream) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	return s.manager.TailLogs(ctx, req.Names, req.Selector, req.All, req.Options, &simpleWatchLogsSender{stream: stream})
 }
 
 func (s *GpmServer) InstallService(ctx context.Context, stream pb.GpmService_InstallServiceStream) error {
@@ -154,6 +209,14 @@ func (s *GpmServer) ListServiceVersions(ctx context.Context, req *pb.ListService
 	return
 }
 
+func (s *GpmServer) ListServiceExits(ctx context.Context, req *pb.ListServiceExitsReq, rsp *pb.ListServiceExitsRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Exits, err = s.manager.ListExits(ctx, req.Name)
+	return
+}
+
 func (s *GpmServer) UpgradeService(ctx context.Context, stream pb.GpmService_UpgradeServiceStream) error {
 	return s.manager.Upgrade(ctx, &simpleUpgradeStream{stream: stream})
 }
@@ -174,6 +237,27 @@ func (s *GpmServer) ForgetService(ctx context.Context, req *pb.ForgetServiceReq,
 	return
 }
 
+func (s *GpmServer) CreateSecret(ctx context.Context, req *pb.CreateSecretReq, rsp *pb.CreateSecretRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Secret, err = s.manager.CreateSecret(ctx, req.Spec)
+	return
+}
+
+func (s *GpmServer) ListSecrets(ctx context.Context, req *pb.ListSecretsReq, rsp *pb.ListSecretsRsp) (err error) {
+	rsp.Secrets, err = s.manager.ListSecrets(ctx)
+	return
+}
+
+func (s *GpmServer) DeleteSecret(ctx context.Context, req *pb.DeleteSecretReq, rsp *pb.DeleteSecretRsp) (err error) {
+	if err = req.Validate(); err != nil {
+		return verrs.BadRequest(s.Name(), err.Error())
+	}
+	rsp.Secret, err = s.manager.DeleteSecret(ctx, req.Name)
+	return
+}
+
 func (s *GpmServer) Ls(ctx context.Context, req *pb.LsReq, rsp *pb.LsRsp) (err error) {
 	if err = req.Validate(); err != nil {
 		return verrs.BadRequest(s.Name(), err.Error())
@@ -238,6 +322,18 @@ func (s *simpleWatchLogSender) Close() error {
 	return s.stream.Close()
 }
 
+type simpleWatchLogsSender struct {
+	stream pb.GpmService_WatchServicesLogStream
+}
+
+func (s *simpleWatchLogsSender) Send(msg interface{}) error {
+	return s.stream.Send(&pb.WatchServicesLogRsp{Log: msg.(*gpmv1.ServiceLog)})
+}
+
+func (s *simpleWatchLogsSender) Close() error {
+	return s.stream.Close()
+}
+
 type simpleInstallStream struct {
 	stream pb.GpmService_InstallServiceStream
 }
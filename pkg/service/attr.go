@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+// validateSysProcAttr 检查服务系统参数的取值范围
+func validateSysProcAttr(attr *gpmv1.SysProcAttr) error {
+	if attr == nil {
+		return nil
+	}
+	if err := attr.Validate(); err != nil {
+		return err
+	}
+
+	limits := map[string]*gpmv1.Rlimit{
+		"nofile":  attr.LimitNofile,
+		"nproc":   attr.LimitNproc,
+		"core":    attr.LimitCore,
+		"memlock": attr.LimitMemlock,
+	}
+	for name, limit := range limits {
+		if limit == nil {
+			continue
+		}
+		if limit.Soft < -1 || limit.Hard < -1 {
+			return fmt.Errorf("invalid %s limit %d:%d", name, limit.Soft, limit.Hard)
+		}
+		hard := rlimitHard(limit)
+		if hard != -1 && (limit.Soft == -1 || limit.Soft > hard) {
+			return fmt.Errorf("%s soft limit %d exceeds hard limit %d", name, limit.Soft, hard)
+		}
+	}
+	if attr.Nice < -20 || attr.Nice > 19 {
+		return fmt.Errorf("invalid nice %d, must be in -20-19", attr.Nice)
+	}
+	if attr.IoniceLevel < 0 || attr.IoniceLevel > 7 {
+		return fmt.Errorf("invalid ionice level %d, must be in 0-7", attr.IoniceLevel)
+	}
+	for _, cpu := range attr.CpuAffinity {
+		if cpu < 0 {
+			return fmt.Errorf("invalid cpu %d", cpu)
+		}
+	}
+	if attr.OomScoreAdj < -1000 || attr.OomScoreAdj > 1000 {
+		return fmt.Errorf("invalid oom score adj %d, must be in -1000-1000", attr.OomScoreAdj)
+	}
+	return nil
+}
+
+// rlimitHard 返回实际的硬限制
+func rlimitHard(limit *gpmv1.Rlimit) int64 {
+	if limit.Hard == 0 {
+		return limit.Soft
+	}
+	return limit.Hard
+}
+
+// mergeSysProcAttr 合并修改的服务系统参数
+func mergeSysProcAttr(dst, src *gpmv1.SysProcAttr) *gpmv1.SysProcAttr {
+	if dst == nil {
+		dst = &gpmv1.SysProcAttr{}
+	}
+	if src.Chroot != "" {
+		dst.Chroot = src.Chroot
+	}
+	// 修改用户后重新获取 uid 和 gid
+	if src.User != "" {
+		dst.User = src.User
+		dst.Uid = src.Uid
+	}
+	if src.Group != "" {
+		dst.Group = src.Group
+		dst.Gid = src.Gid
+	}
+	if src.LimitNofile != nil {
+		dst.LimitNofile = src.LimitNofile
+	}
+	if src.LimitNproc != nil {
+		dst.LimitNproc = src.LimitNproc
+	}
+	if src.LimitCore != nil {
+		dst.LimitCore = src.LimitCore
+	}
+	if src.LimitMemlock != nil {
+		dst.LimitMemlock = src.LimitMemlock
+	}
+	if src.Nice != 0 {
+		dst.Nice = src.Nice
+	}
+	if src.IoniceClass != "" {
+		dst.IoniceClass = src.IoniceClass
+		dst.IoniceLevel = src.IoniceLevel
+	}
+	if len(src.CpuAffinity) > 0 {
+		dst.CpuAffinity = src.CpuAffinity
+	}
+	if src.OomScoreAdj != 0 {
+		dst.OomScoreAdj = src.OomScoreAdj
+	}
+	return dst
+}
@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build linux
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ioprioClassShift = 13
+	ioprioWhoProcess = 1
+)
+
+var ioprioClasses = map[string]int{
+	gpmv1.IoniceRealtime:   1,
+	gpmv1.IoniceBestEffort: 2,
+	gpmv1.IoniceIdle:       3,
+}
+
+// needShim 判断服务是否设置了资源限制和调度参数, 这些参数由 shim 在执行服务命令前设置
+func needShim(attr *gpmv1.SysProcAttr) bool {
+	if attr == nil {
+		return false
+	}
+	return attr.LimitNofile != nil || attr.LimitNproc != nil || attr.LimitCore != nil || attr.LimitMemlock != nil ||
+		attr.Nice != 0 || attr.IoniceClass != "" || len(attr.CpuAffinity) > 0 || attr.OomScoreAdj != 0
+}
+
+// applyProcAttr 为进程设置资源限制和调度参数, shim 在执行服务命令前为自身设置, 服务进程从启动开始就受到限制.
+// nice, ionice 和 cpu 亲和性在 linux 中以线程为单位, 会设置进程当前所有的线程, 之后创建的线程和子进程继承这些参数
+func applyProcAttr(pid int, attr *gpmv1.SysProcAttr) error {
+	if attr == nil {
+		return nil
+	}
+
+	limits := []struct {
+		name     string
+		resource int
+		limit    *gpmv1.Rlimit
+	}{
+		{"nofile", unix.RLIMIT_NOFILE, attr.LimitNofile},
+		{"nproc", unix.RLIMIT_NPROC, attr.LimitNproc},
+		{"core", unix.RLIMIT_CORE, attr.LimitCore},
+		{"memlock", unix.RLIMIT_MEMLOCK, attr.LimitMemlock},
+	}
+	for _, item := range limits {
+		if item.limit == nil {
+			continue
+		}
+		rlimit := &unix.Rlimit{Cur: rlimitValue(item.limit.Soft), Max: rlimitValue(rlimitHard(item.limit))}
+		if err := unix.Prlimit(pid, item.resource, rlimit, nil); err != nil {
+			return fmt.Errorf("set %s limit: %v", item.name, err)
+		}
+	}
+
+	if attr.OomScoreAdj != 0 {
+		f := filepath.Join("/proc", strconv.Itoa(pid), "oom_score_adj")
+		if err := os.WriteFile(f, []byte(strconv.Itoa(int(attr.OomScoreAdj))), 0644); err != nil {
+			return fmt.Errorf("set oom score adj: %v", err)
+		}
+	}
+
+	if attr.Nice == 0 && attr.IoniceClass == "" && len(attr.CpuAffinity) == 0 {
+		return nil
+	}
+	for _, tid := range threads(pid) {
+		if attr.Nice != 0 {
+			if err := unix.Setpriority(unix.PRIO_PROCESS, tid, int(attr.Nice)); err != nil {
+				return fmt.Errorf("set nice: %v", err)
+			}
+		}
+		if attr.IoniceClass != "" {
+			level := int(attr.IoniceLevel)
+			if attr.IoniceClass == gpmv1.IoniceIdle {
+				level = 0
+			}
+			prio := ioprioClasses[attr.IoniceClass]<<ioprioClassShift | level
+			if _, _, errno := unix.Syscall(unix.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(tid), uintptr(prio)); errno != 0 {
+				return fmt.Errorf("set ionice: %v", errno)
+			}
+		}
+		if len(attr.CpuAffinity) > 0 {
+			set := &unix.CPUSet{}
+			for _, cpu := range attr.CpuAffinity {
+				set.Set(int(cpu))
+			}
+			if err := unix.SchedSetaffinity(tid, set); err != nil {
+				return fmt.Errorf("set cpu affinity: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func rlimitValue(n int64) uint64 {
+	if n == -1 {
+		return unix.RLIM_INFINITY
+	}
+	return uint64(n)
+}
+
+// threads 返回进程当前所有的线程 id
+func threads(pid int) []int {
+	entries, err := os.ReadDir(filepath.Join("/proc", strconv.Itoa(pid), "task"))
+	if err != nil {
+		return []int{pid}
+	}
+	tids := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		if tid, err := strconv.Atoi(entry.Name()); err == nil {
+			tids = append(tids, tid)
+		}
+	}
+	return tids
+}
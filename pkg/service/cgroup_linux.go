@@ -0,0 +1,466 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build linux
+
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/shirou/gopsutil/mem"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// cgroup v2 的挂载目录
+	cgroupMount = "/sys/fs/cgroup"
+	// cpu.max 的周期(微秒)
+	cgroupCPUPeriod = 100000
+)
+
+// cgroupControllers 为服务开启的 cgroup 控制器
+var cgroupControllers = []string{"memory", "cpu", "pids", "io"}
+
+// cgroupRoot gpmd 管理的 cgroup 目录, 为空时表示不支持 cgroup v2
+var cgroupRoot string
+
+// cgroupSelf gpmd 启动时所在的 cgroup 目录
+var cgroupSelf string
+
+// cgroupFD 内核是否支持通过 CgroupFD (CLONE_INTO_CGROUP) 在指定的 cgroup 中创建进程
+var cgroupFD bool
+
+// cgroupReady gpmd 管理的 cgroup 目录是否已经创建
+var cgroupReady struct {
+	sync.Mutex
+	done bool
+}
+
+// cgroupUsage 无法重新创建的 cgroup 在进程启动前的 cpu 累计时间 (微秒), 计算占用时减去
+var cgroupUsage = struct {
+	sync.Mutex
+	m map[string]int64
+}{m: map[string]int64{}}
+
+// initCgroup 检查 cgroup v2 并确定 gpmd 管理的 cgroup 目录, 目录在第一个设置了资源限制的服务启动时才创建,
+// 未设置资源限制时不修改任何 cgroup
+func initCgroup() error {
+	if _, err := os.Stat(filepath.Join(cgroupMount, "cgroup.controllers")); err != nil {
+		return fmt.Errorf("cgroup v2 not mounted at %s", cgroupMount)
+	}
+
+	b, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return err
+	}
+	var rel string
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "0::") {
+			rel = strings.TrimPrefix(line, "0::")
+		}
+	}
+	if rel == "" {
+		return errors.New("cgroup v2 path of gpmd not found")
+	}
+
+	cgroupSelf = filepath.Join(cgroupMount, rel)
+	root := cgroupSelf
+	switch {
+	case rel == "/":
+		// gpmd 位于根 cgroup 时 (如容器中), 使用单独的 gpm 目录, 不修改根 cgroup
+		root = filepath.Join(cgroupMount, "gpm")
+	case filepath.Base(root) == "gpmd":
+		// gpmd 由自身重启时 (如 gpm update), 新进程继承了 gpmd 子目录
+		root = filepath.Dir(root)
+	}
+
+	cgroupRoot = root
+	cgroupFD = kernelAtLeast(5, 7)
+	return nil
+}
+
+// setupCgroup 创建 gpmd 管理的 cgroup 目录.
+// 由于 cgroup v2 中有进程的 cgroup 不能开启子树控制器, gpmd 和已经启动的服务进程会先移动到 gpmd 子目录中,
+// 设置了资源限制的服务进程位于 services/<name>/<index> 中. 需要 gpmd 以 root 运行或者 cgroup 已委派给 gpmd (如 systemd 的 Delegate=yes)
+func setupCgroup() error {
+	cgroupReady.Lock()
+	defer cgroupReady.Unlock()
+	if cgroupReady.done {
+		return nil
+	}
+
+	root := cgroupRoot
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+	// 根 cgroup 不限制其中的进程, gpmd 不需要移动
+	if cgroupSelf != cgroupMount {
+		leaf := filepath.Join(root, "gpmd")
+		if err := os.MkdirAll(leaf, 0755); err != nil {
+			return err
+		}
+		procs, err := readCgroup(root, "cgroup.procs")
+		if err != nil {
+			return err
+		}
+		for _, pid := range strings.Fields(procs) {
+			// 移动时已经退出的进程忽略
+			if err = writeCgroup(leaf, "cgroup.procs", pid); err != nil && !errors.Is(err, syscall.ESRCH) {
+				return fmt.Errorf("move process %s to %s: %v", pid, leaf, err)
+			}
+		}
+		if err = enableControllers(root); err != nil {
+			return err
+		}
+	}
+	services := filepath.Join(root, "services")
+	if err := os.MkdirAll(services, 0755); err != nil {
+		return err
+	}
+	if err := enableControllers(services); err != nil {
+		return err
+	}
+
+	cgroupReady.done = true
+	return nil
+}
+
+// kernelAtLeast 判断内核版本是否不低于 major.minor
+func kernelAtLeast(major, minor int) bool {
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err != nil {
+		return false
+	}
+	release := unix.ByteSliceToString(uts.Release[:])
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	x, _ := strconv.Atoi(parts[0])
+	y, _ := strconv.Atoi(strings.TrimFunc(parts[1], func(r rune) bool { return r < '0' || r > '9' }))
+	return x > major || (x == major && y >= minor)
+}
+
+// enableControllers 开启 dir 下子目录可用的控制器
+func enableControllers(dir string) error {
+	b, err := os.ReadFile(filepath.Join(dir, "cgroup.controllers"))
+	if err != nil {
+		return err
+	}
+	available := strings.Fields(string(b))
+	items := make([]string, 0)
+	for _, controller := range cgroupControllers {
+		for _, item := range available {
+			if item == controller {
+				items = append(items, "+"+controller)
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return writeCgroup(dir, "cgroup.subtree_control", strings.Join(items, " "))
+}
+
+func writeCgroup(dir, name, value string) error {
+	return os.WriteFile(filepath.Join(dir, name), []byte(value), 0644)
+}
+
+func readCgroup(dir, name string) (string, error) {
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// cgroupDir 返回 gpmd 管理的 cgroup 目录
+func cgroupDir() string {
+	return cgroupRoot
+}
+
+// cgroupPath 返回服务实例所在的 cgroup 目录
+func cgroupPath(name string, index int32) string {
+	return filepath.Join(cgroupRoot, "services", name, strconv.Itoa(int(index)))
+}
+
+func validateResources(r *gpmv1.Resources) error {
+	if r == nil {
+		return nil
+	}
+	if r.Memory < 0 || r.Cpu < 0 || r.Pids < 0 {
+		return errors.New("resource limits must not be negative")
+	}
+	if r.IoWeight < 0 || r.IoWeight > 10000 {
+		return fmt.Errorf("invalid io weight %d, must be in 1-10000", r.IoWeight)
+	}
+	if cgroupRoot == "" && hasResourceLimits(r) {
+		return errors.New("resource limits need cgroup v2, which is not available for gpmd")
+	}
+	return nil
+}
+
+// joinCgroup 为设置了资源限制的服务实例创建 cgroup 并设置限制, 未设置资源限制的服务不使用 cgroup.
+// 内核支持时通过 CgroupFD 使进程直接在实例的 cgroup 中创建 (需要 linux 5.7 以上的内核), 返回的函数在进程启动后关闭 cgroup 目录;
+// 否则返回实例的 cgroup 目录, 由 shim 在执行服务命令前加入
+func joinCgroup(cmd *exec.Cmd, name string, index int32, r *gpmv1.Resources) (string, func(), error) {
+	release := func() {}
+	if cgroupRoot == "" || !hasResourceLimits(r) {
+		return "", release, nil
+	}
+	if err := setupCgroup(); err != nil {
+		return "", release, err
+	}
+
+	parent := filepath.Join(cgroupRoot, "services", name)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return "", release, err
+	}
+	if err := enableControllers(parent); err != nil {
+		return "", release, err
+	}
+	dir := cgroupPath(name, index)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", release, err
+	}
+
+	memory, pids := "max", "max"
+	if r.Memory > 0 {
+		memory = strconv.FormatInt(r.Memory, 10)
+	}
+	if r.Pids > 0 {
+		pids = strconv.FormatInt(r.Pids, 10)
+	}
+	cpu := fmt.Sprintf("max %d", cgroupCPUPeriod)
+	if r.Cpu > 0 {
+		cpu = fmt.Sprintf("%d %d", int64(r.Cpu*cgroupCPUPeriod), cgroupCPUPeriod)
+	}
+	weight := int32(100)
+	if r.IoWeight > 0 {
+		weight = r.IoWeight
+	}
+
+	limits := []struct {
+		file  string
+		value string
+		set   bool
+	}{
+		{"memory.max", memory, r.Memory > 0},
+		{"cpu.max", cpu, r.Cpu > 0},
+		{"pids.max", pids, r.Pids > 0},
+		{"io.weight", fmt.Sprintf("default %d", weight), r.IoWeight > 0},
+	}
+	for _, limit := range limits {
+		err := writeCgroup(dir, limit.file, limit.value)
+		// 未设置的限制, 控制器不可用时忽略
+		if err != nil && limit.set {
+			return "", release, fmt.Errorf("set %s: %v", limit.file, err)
+		}
+	}
+
+	if !cgroupFD {
+		return dir, release, nil
+	}
+	f, err := os.Open(dir)
+	if err != nil {
+		return "", release, err
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(f.Fd())
+
+	return "", func() { _ = f.Close() }, nil
+}
+
+// resetCgroup 在启动进程前重新创建服务实例的 cgroup, 使 cpu 累计时间从本次启动开始计算.
+// cgroup 中仍有进程时 (如交接套接字时的旧进程) 无法删除, 记录当前的累计时间
+func resetCgroup(name string, index int32) {
+	if cgroupRoot == "" {
+		return
+	}
+	dir := cgroupPath(name, index)
+
+	cgroupUsage.Lock()
+	defer cgroupUsage.Unlock()
+	delete(cgroupUsage.m, dir)
+	if err := os.Remove(dir); err == nil || os.IsNotExist(err) {
+		return
+	}
+	if usage, ok := cgroupCPUUsage(dir); ok {
+		cgroupUsage.m[dir] = usage
+	}
+}
+
+// removeCgroup 删除服务实例的 cgroup 目录, 目录中仍有进程时删除失败
+func removeCgroup(name string, index int32) {
+	if cgroupRoot == "" {
+		return
+	}
+	dir := cgroupPath(name, index)
+	cgroupUsage.Lock()
+	delete(cgroupUsage.m, dir)
+	cgroupUsage.Unlock()
+	_ = os.Remove(dir)
+}
+
+// removeServiceCgroup 删除服务所有实例的 cgroup 目录
+func removeServiceCgroup(name string) {
+	if cgroupRoot == "" {
+		return
+	}
+	dir := filepath.Join(cgroupRoot, "services", name)
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			_ = os.Remove(filepath.Join(dir, entry.Name()))
+		}
+	}
+	_ = os.Remove(dir)
+}
+
+// cgroupOOMKills 返回服务实例 cgroup 中因内存不足结束进程的次数, 不支持 cgroup 时返回 -1
+func cgroupOOMKills(name string, index int32) int64 {
+	if cgroupRoot == "" {
+		return -1
+	}
+	events, err := readCgroup(cgroupPath(name, index), "memory.events")
+	if err != nil {
+		return -1
+	}
+	for _, line := range strings.Split(events, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			n, _ := strconv.ParseInt(fields[1], 10, 64)
+			return n
+		}
+	}
+	return -1
+}
+
+// statCgroup 读取服务实例 cgroup 的资源占用, 包含实例所有的子进程. 不支持 cgroup 时返回 nil
+func statCgroup(name string, index int32, start int64) *gpmv1.Stat {
+	if cgroupRoot == "" {
+		return nil
+	}
+	dir := cgroupPath(name, index)
+
+	current, err := readCgroup(dir, "memory.current")
+	if err != nil {
+		return nil
+	}
+	stat := &gpmv1.Stat{}
+	stat.Memory, _ = strconv.ParseUint(current, 10, 64)
+	if m, _ := mem.VirtualMemory(); m != nil && m.Total > 0 {
+		stat.MemPercent = float32(float64(stat.Memory) / float64(m.Total) * 100)
+	}
+
+	usage, ok := cgroupCPUUsage(dir)
+	if !ok {
+		return stat
+	}
+	cgroupUsage.Lock()
+	usage -= cgroupUsage.m[dir]
+	cgroupUsage.Unlock()
+	// 与 gopsutil 一致, 计算启动以来的平均占用
+	elapsed := time.Since(time.Unix(start, 0)).Microseconds()
+	if elapsed > 0 && usage > 0 {
+		stat.CpuPercent = float64(usage) / float64(elapsed) * 100
+	}
+
+	return stat
+}
+
+// cgroupCPUUsage 读取 cgroup 的 cpu 累计时间 (微秒)
+func cgroupCPUUsage(dir string) (int64, bool) {
+	cpu, err := readCgroup(dir, "cpu.stat")
+	if err != nil {
+		log.Errorf("read cpu.stat of %s: %v", dir, err)
+		return 0, false
+	}
+	for _, line := range strings.Split(cpu, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "usage_usec" {
+			usage, _ := strconv.ParseInt(fields[1], 10, 64)
+			return usage, true
+		}
+	}
+	return 0, false
+}
+
+// freezeCgroup 通过 cgroup.freeze 冻结或解冻服务实例的所有进程, 不支持 cgroup 时返回错误
+func freezeCgroup(name string, index int32, frozen bool) error {
+	if cgroupRoot == "" {
+		return errors.New("cgroup v2 is not available")
+	}
+	dir := cgroupPath(name, index)
+	if procs, err := readCgroup(dir, "cgroup.procs"); err != nil || procs == "" {
+		return fmt.Errorf("no process in cgroup %s", dir)
+	}
+
+	value := "0"
+	if frozen {
+		value = "1"
+	}
+	if err := writeCgroup(dir, "cgroup.freeze", value); err != nil {
+		return err
+	}
+
+	// 等待 cgroup.events 中的 frozen 状态生效
+	after := time.After(time.Second * 5)
+	ticker := time.NewTicker(time.Millisecond * 50)
+	defer ticker.Stop()
+	for {
+		events, err := readCgroup(dir, "cgroup.events")
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Split(events, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 2 && fields[0] == "frozen" && fields[1] == value {
+				return nil
+			}
+		}
+
+		select {
+		case <-after:
+			return fmt.Errorf("wait cgroup %s frozen=%s timeout", dir, value)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !linux
+
+package service
+
+import (
+	"errors"
+	"os/exec"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+func initCgroup() error {
+	return errors.New("cgroup v2 only supports linux")
+}
+
+func cgroupDir() string {
+	return ""
+}
+
+func validateResources(r *gpmv1.Resources) error {
+	if hasResourceLimits(r) {
+		return errors.New("resource limits only support linux")
+	}
+	return nil
+}
+
+func joinCgroup(cmd *exec.Cmd, name string, index int32, r *gpmv1.Resources) (string, func(), error) {
+	return "", func() {}, nil
+}
+
+func resetCgroup(name string, index int32) {}
+
+func removeCgroup(name string, index int32) {}
+
+func removeServiceCgroup(name string) {}
+
+func cgroupOOMKills(name string, index int32) int64 {
+	return -1
+}
+
+func statCgroup(name string, index int32, start int64) *gpmv1.Stat {
+	return nil
+}
+
+func freezeCgroup(name string, index int32, frozen bool) error {
+	return errors.New("cgroup v2 only supports linux")
+}
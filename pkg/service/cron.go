@@ -0,0 +1,220 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField 单个 cron 字段的取值范围和别名
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+var cronAliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSchedule 解析后的 cron 表达式, 每个字段为允许取值的位图
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar, dowStar 表示日期和星期字段以 * 开头, 两者都有限制时满足其一即可
+	domStar, dowStar bool
+
+	// every 为 @every 指定的固定间隔
+	every time.Duration
+}
+
+// parseCron 解析标准的 5 段 cron 表达式, 支持 *, 范围, 步长, 列表, 月份和星期的英文缩写,
+// 以及 @hourly, @daily 等别名和 @every <duration>
+func parseCron(expr string) (*cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule '%s': %v", expr, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("invalid schedule '%s': interval must be at least 1s", expr)
+		}
+		return &cronSchedule{every: d}, nil
+	}
+	if alias, ok := cronAliases[strings.ToLower(expr)]; ok {
+		expr = alias
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("invalid schedule '%s': expected %d fields", expr, len(cronFields))
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule '%s': %v", expr, err)
+		}
+		bits[i] = b
+	}
+	// 星期中的 7 与 0 都表示星期日
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &cronSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: cronStar(parts[2]),
+		dowStar: cronStar(parts[4]),
+	}, nil
+}
+
+// cronStar 判断字段是否以 * 或 ? 开头, 与常见的 cron 实现一致, */2 这样的字段同样视为不限制日期或星期
+func cronStar(text string) bool {
+	return strings.HasPrefix(text, "*") || strings.HasPrefix(text, "?")
+}
+
+func parseCronField(text string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(text, ",") {
+		expr, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field '%s'", field.name, item)
+			}
+			expr, step = item[:i], n
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case expr == "*" || expr == "?":
+		case strings.Contains(expr, "-"):
+			lo, hi, _ := strings.Cut(expr, "-")
+			var err error
+			if start, err = cronValue(lo, field); err != nil {
+				return 0, err
+			}
+			if end, err = cronValue(hi, field); err != nil {
+				return 0, err
+			}
+		default:
+			n, err := cronValue(expr, field)
+			if err != nil {
+				return 0, err
+			}
+			start = n
+			// 5/10 表示从 5 开始每 10 个单位
+			if !strings.Contains(item, "/") {
+				end = n
+			}
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range in %s field '%s'", field.name, item)
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func cronValue(text string, field cronField) (int, error) {
+	if n, ok := field.names[strings.ToLower(text)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s'", field.name, text)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", field.name, n, field.min, field.max)
+	}
+	return n, nil
+}
+
+// next 返回 t 之后下一次运行的时间, 在 5 年内没有满足条件的时间时返回零值
+func (s *cronSchedule) next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Add(s.every).Truncate(time.Second)
+	}
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,97 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "* * * * *"},
+		{expr: "*/15 0-6,22-23 1-31/2 jan-mar mon-fri"},
+		{expr: "0 0 * * 7"},
+		{expr: "@daily"},
+		{expr: "@every 1m30s"},
+		{expr: "* * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "5-1 * * * *", wantErr: true},
+		{expr: "* * * foo *", wantErr: true},
+		{expr: "* * * * 8", wantErr: true},
+		{expr: "@every 500ms", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		_, err := parseCron(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	at := func(year int, month time.Month, day, hour, min, sec int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+	}
+
+	// 2024-01-01 是星期一
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"step", "*/15 * * * *", at(2024, 1, 1, 10, 7, 0), at(2024, 1, 1, 10, 15, 0)},
+		{"next minute", "* * * * *", at(2024, 1, 1, 10, 7, 30), at(2024, 1, 1, 10, 8, 0)},
+		{"weekday range", "0 9 * * 1-5", at(2024, 1, 6, 12, 0, 0), at(2024, 1, 8, 9, 0, 0)},
+		{"sunday as 7", "0 0 * * 7", at(2024, 1, 1, 0, 0, 0), at(2024, 1, 7, 0, 0, 0)},
+		{"sunday range", "0 0 * * 6-7", at(2024, 1, 1, 0, 0, 0), at(2024, 1, 6, 0, 0, 0)},
+		{"list", "0 0 1,15 * *", at(2024, 1, 2, 0, 0, 0), at(2024, 1, 15, 0, 0, 0)},
+		{"day step", "30 2 */2 * *", at(2024, 1, 2, 3, 0, 0), at(2024, 1, 3, 2, 30, 0)},
+		{"start step", "5/20 * * * *", at(2024, 1, 1, 10, 30, 0), at(2024, 1, 1, 10, 45, 0)},
+		{"month name", "0 0 1 mar *", at(2024, 1, 1, 0, 0, 0), at(2024, 3, 1, 0, 0, 0)},
+		{"dom or dow", "0 0 13 * 5", at(2024, 1, 1, 0, 0, 0), at(2024, 1, 5, 0, 0, 0)},
+		{"dom star step and dow", "0 0 */2 * 5", at(2024, 1, 6, 0, 0, 0), at(2024, 1, 19, 0, 0, 0)},
+		{"leap day", "0 0 29 2 *", at(2023, 3, 1, 0, 0, 0), at(2024, 2, 29, 0, 0, 0)},
+		{"alias", "@daily", at(2024, 1, 1, 10, 0, 0), at(2024, 1, 2, 0, 0, 0)},
+		{"every", "@every 90s", at(2024, 1, 1, 10, 0, 0), at(2024, 1, 1, 10, 1, 30)},
+		{"never", "0 0 30 2 *", at(2024, 1, 1, 0, 0, 0), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("parseCron(%q): %v", tt.expr, err)
+			}
+			if got := s.next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("next(%q, %s) = %s, want %s", tt.expr, tt.from, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	// 等待依赖服务就绪的超时时间
+	dependencyTimeout = time.Minute * 1
+)
+
+// checkDependencies 检查服务的依赖是否存在, 以及是否形成循环依赖
+func checkDependencies(list []*gpmv1.Service, name string, dependsOn []string) error {
+	graph := map[string][]string{}
+	for _, item := range list {
+		graph[item.Name] = item.DependsOn
+	}
+	for _, dep := range dependsOn {
+		if dep == name {
+			return fmt.Errorf("service %s can't depend on itself", name)
+		}
+		if _, ok := graph[dep]; !ok {
+			return fmt.Errorf("dependency %s not found", dep)
+		}
+	}
+	graph[name] = dependsOn
+
+	if _, cycle := sortDependencies(graph); len(cycle) > 0 {
+		return fmt.Errorf("circular dependency: %s", strings.Join(cycle, ", "))
+	}
+	return nil
+}
+
+// sortDependencies 按照依赖关系对服务排序, 被依赖的服务排在前面, 形成循环依赖的服务通过 cycle 返回
+func sortDependencies(graph map[string][]string) (ordered []string, cycle []string) {
+	indegree := map[string]int{}
+	dependents := map[string][]string{}
+	for name, deps := range graph {
+		indegree[name] += 0
+		for _, dep := range deps {
+			if _, ok := graph[dep]; !ok {
+				continue
+			}
+			indegree[name] += 1
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	queue := make([]string, 0)
+	for name, n := range indegree {
+		if n == 0 {
+			queue = append(queue, name)
+		}
+	}
+	sort.Strings(queue)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		ordered = append(ordered, name)
+
+		next := make([]string, 0)
+		for _, item := range dependents[name] {
+			indegree[item] -= 1
+			if indegree[item] == 0 {
+				next = append(next, item)
+			}
+		}
+		sort.Strings(next)
+		queue = append(queue, next...)
+	}
+
+	for name, n := range indegree {
+		if n > 0 {
+			cycle = append(cycle, name)
+		}
+	}
+	sort.Strings(cycle)
+
+	return ordered, cycle
+}
+
+// dependencies 返回服务依赖的所有服务, 按照启动顺序排列
+func (g *manager) dependencies(name string) []*Process {
+	g.RLock()
+	defer g.RUnlock()
+
+	graph := map[string][]string{}
+	var walk func(string)
+	walk = func(item string) {
+		p, ok := g.ps[item]
+		if !ok {
+			return
+		}
+		if _, ok = graph[item]; ok {
+			return
+		}
+		graph[item] = p.DependsOn
+		for _, dep := range p.DependsOn {
+			walk(dep)
+		}
+	}
+	walk(name)
+
+	ordered, _ := sortDependencies(graph)
+	outs := make([]*Process, 0)
+	for _, item := range ordered {
+		if item != name {
+			outs = append(outs, g.ps[item])
+		}
+	}
+	return outs
+}
+
+// dependents 返回依赖此服务的所有服务, 按照停止顺序排列
+func (g *manager) dependents(name string) []*Process {
+	g.RLock()
+	defer g.RUnlock()
+
+	graph := map[string][]string{}
+	for _, p := range g.ps {
+		graph[p.Name] = p.DependsOn
+	}
+
+	// 找出直接或间接依赖此服务的服务
+	marked := map[string]bool{name: true}
+	for changed := true; changed; {
+		changed = false
+		for item, deps := range graph {
+			if marked[item] {
+				continue
+			}
+			for _, dep := range deps {
+				if marked[dep] {
+					marked[item] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	ordered, _ := sortDependencies(graph)
+	outs := make([]*Process, 0)
+	for i := len(ordered) - 1; i >= 0; i-- {
+		item := ordered[i]
+		if item != name && marked[item] {
+			outs = append(outs, g.ps[item])
+		}
+	}
+	return outs
+}
+
+// waitDependencies 等待服务依赖的服务就绪
+func (g *manager) waitDependencies(ctx context.Context, p *Process) error {
+	for _, dep := range p.DependsOn {
+		g.RLock()
+		dp, ok := g.ps[dep]
+		g.RUnlock()
+		if !ok {
+			return fmt.Errorf("dependency %s not found", dep)
+		}
+
+		log.Infof("service %s waiting for dependency %s", p.Name, dep)
+		if err := waitReady(ctx, dp, dependencyTimeout); err != nil {
+			return fmt.Errorf("dependency %s not ready: %v", dep, err)
+		}
+	}
+	return nil
+}
+
+// waitReady 等待服务运行, 配置了健康探测时等待探测成功
+func waitReady(ctx context.Context, p *Process, timeout time.Duration) error {
+	after := time.After(timeout)
+	ticker := time.NewTicker(time.Millisecond * 500)
+	defer ticker.Stop()
+	for {
+		if p.ready() {
+			return nil
+		}
+		switch p.Status {
+		case gpmv1.StatusRunning, gpmv1.StatusUpgrading:
+		default:
+			return fmt.Errorf("service is %s", p.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-after:
+			return fmt.Errorf("timeout after %v", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// ready 判断服务是否就绪
+func (p *Process) ready() bool {
+	switch p.Type {
+	case gpmv1.ServiceCron:
+		// cron 服务启动定时任务即就绪
+		return p.Status == gpmv1.StatusRunning
+	case gpmv1.ServiceOneshot:
+		// oneshot 服务运行中或者成功运行结束即就绪
+		if p.Status == gpmv1.StatusStopped && exitSuccess(p.LastExit) {
+			return true
+		}
+	}
+	for _, r := range p.instances() {
+		if r.Status != gpmv1.StatusRunning || r.child() == nil {
+			return false
+		}
+		if (r.LivenessProbe != nil || r.ReadinessProbe != nil) && r.Health != gpmv1.HealthHealthy {
+			return false
+		}
+	}
+	return true
+}
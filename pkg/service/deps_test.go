@@ -0,0 +1,114 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+func TestSortDependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   map[string][]string
+		ordered string
+		cycle   string
+	}{
+		{name: "empty", graph: map[string][]string{}},
+		{
+			name:    "chain",
+			graph:   map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+			ordered: "c,b,a",
+		},
+		{
+			name:    "diamond",
+			graph:   map[string][]string{"web": {"app"}, "app": {"db", "cache"}, "db": nil, "cache": nil},
+			ordered: "cache,db,app,web",
+		},
+		{
+			name:    "missing dependency",
+			graph:   map[string][]string{"a": {"x"}, "b": nil},
+			ordered: "a,b",
+		},
+		{
+			name:    "cycle",
+			graph:   map[string][]string{"a": {"b"}, "b": {"a"}, "c": nil},
+			ordered: "c",
+			cycle:   "a,b",
+		},
+		{
+			name:  "depends on cycle",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}, "d": {"a"}},
+			cycle: "a,b,c,d",
+		},
+		{
+			name:  "self",
+			graph: map[string][]string{"a": {"a"}},
+			cycle: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, cycle := sortDependencies(tt.graph)
+			if got := strings.Join(ordered, ","); got != tt.ordered {
+				t.Errorf("ordered = %s, want %s", got, tt.ordered)
+			}
+			if got := strings.Join(cycle, ","); got != tt.cycle {
+				t.Errorf("cycle = %s, want %s", got, tt.cycle)
+			}
+		})
+	}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	list := []*gpmv1.Service{
+		{Name: "db"},
+		{Name: "app", DependsOn: []string{"db"}},
+		{Name: "web", DependsOn: []string{"app"}},
+	}
+
+	tests := []struct {
+		name      string
+		service   string
+		dependsOn []string
+		wantErr   bool
+	}{
+		{name: "new service", service: "worker", dependsOn: []string{"db", "app"}},
+		{name: "no dependency", service: "db"},
+		{name: "itself", service: "worker", dependsOn: []string{"worker"}, wantErr: true},
+		{name: "not found", service: "worker", dependsOn: []string{"cache"}, wantErr: true},
+		{name: "cycle", service: "db", dependsOn: []string{"web"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDependencies(list, tt.service, tt.dependsOn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkDependencies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+// 不继承环境变量时服务进程的 PATH
+const defaultPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// windows 下进程运行所需的环境变量, 不继承环境变量时同样传递
+var windowsEnvKeys = []string{"SystemRoot", "SystemDrive", "windir", "ComSpec", "PATHEXT", "Path", "TEMP", "TMP"}
+
+// validateEnv 检查环境变量参数
+func validateEnv(mode string, files []string) error {
+	if mode != "" && mode != gpmv1.EnvInherit && mode != gpmv1.EnvClean {
+		return fmt.Errorf("field 'envMode' must in '[inherit,clean]'")
+	}
+	for _, name := range files {
+		if strings.TrimPrefix(name, "-") == "" {
+			return fmt.Errorf("invalid env file '%s'", name)
+		}
+	}
+	return nil
+}
+
+// serviceEnv 返回服务进程的环境变量, 依次为 gpmd 的环境变量 (或者不继承时的基础环境变量), envFiles, env,
+// 后面的值覆盖前面的值, env 中的 ${VAR} 替换为之前的值, GPM_SERVICE_* 总是由 gpmd 设置
+func serviceEnv(s *gpmv1.Service) (map[string]string, error) {
+	env := map[string]string{}
+	if s.EnvMode == gpmv1.EnvClean {
+		baseEnv(env, s.SysProcAttr)
+	} else {
+		inheritEnv(env)
+	}
+	gpmEnv(env, s)
+
+	for _, name := range s.EnvFiles {
+		if err := loadEnvFile(env, s.Dir, name); err != nil {
+			return nil, err
+		}
+	}
+
+	// env 的值只引用之前的变量, 不依赖 map 的遍历顺序
+	values := make(map[string]string, len(s.Env))
+	for k, v := range s.Env {
+		values[k] = expandEnv(v, env)
+	}
+	for k, v := range values {
+		env[k] = v
+	}
+	gpmEnv(env, s)
+
+	return env, nil
+}
+
+// commandEnv 返回 gpm exec 和 gpm terminal 执行命令的环境变量, mode 为 clean 时不继承 gpmd 的环境变量
+func commandEnv(mode, username string, extra map[string]string) []string {
+	env := map[string]string{}
+	if mode == gpmv1.EnvClean {
+		baseEnv(env, &gpmv1.SysProcAttr{User: username})
+	} else {
+		inheritEnv(env)
+	}
+	for k, v := range extra {
+		env[k] = v
+	}
+	return environ(env)
+}
+
+// inheritEnv 设置 gpmd 的环境变量
+func inheritEnv(env map[string]string) {
+	for _, item := range os.Environ() {
+		if k, v, ok := strings.Cut(item, "="); ok && k != "" {
+			env[k] = v
+		}
+	}
+}
+
+// gpmEnv 设置 gpmd 注入的环境变量
+func gpmEnv(env map[string]string, s *gpmv1.Service) {
+	env["GPM_SERVICE_NAME"] = s.Name
+	env["GPM_SERVICE_VERSION"] = s.Version
+	env["GPM_SERVICE_DIR"] = s.Dir
+}
+
+// baseEnv 设置不继承环境变量时的基础环境变量
+func baseEnv(env map[string]string, attr *gpmv1.SysProcAttr) {
+	if runtime.GOOS == "windows" {
+		for _, k := range windowsEnvKeys {
+			if v, ok := os.LookupEnv(k); ok {
+				env[k] = v
+			}
+		}
+		return
+	}
+
+	env["PATH"] = defaultPath
+	var u *user.User
+	switch {
+	case attr != nil && attr.User != "":
+		u, _ = user.Lookup(attr.User)
+	case attr != nil && attr.Uid != 0:
+		u, _ = user.LookupId(strconv.Itoa(int(attr.Uid)))
+	default:
+		u, _ = user.Current()
+	}
+	if u != nil {
+		env["HOME"] = u.HomeDir
+		env["USER"] = u.Username
+		env["LOGNAME"] = u.Username
+	}
+}
+
+// loadEnvFile 读取 dotenv 格式的环境变量文件, 支持注释, export 前缀以及单双引号, 值中的 ${VAR} 替换为之前的值
+func loadEnvFile(env map[string]string, dir, name string) error {
+	optional := strings.HasPrefix(name, "-")
+	name = strings.TrimPrefix(name, "-")
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(dir, name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		if optional && os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read env file: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		k, v, ok := strings.Cut(line, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" || strings.ContainsAny(k, " \t") {
+			return fmt.Errorf("%s:%d: invalid line", name, n)
+		}
+
+		v = strings.TrimSpace(v)
+		switch {
+		case len(v) >= 2 && v[0] == '\'' && v[len(v)-1] == '\'':
+			// 单引号中的值不做替换
+			v = v[1 : len(v)-1]
+		case len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"':
+			v = expandEnv(unescape(v[1:len(v)-1]), env)
+		default:
+			if i := strings.Index(v, " #"); i >= 0 {
+				v = strings.TrimSpace(v[:i])
+			}
+			v = expandEnv(v, env)
+		}
+		env[k] = v
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("read env file %s: %v", name, err)
+	}
+	return nil
+}
+
+// unescape 处理双引号中的转义字符
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// expandEnv 将 s 中的 ${VAR} 替换为环境变量的值, 未定义的变量替换为空字符串, 不处理 $VAR 的形式
+func expandEnv(s string, env map[string]string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(s[i+2:], '}')
+		if j < 0 {
+			break
+		}
+		b.WriteString(s[:i])
+		b.WriteString(env[s[i+2:i+2+j]])
+		s = s[i+3+j:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+// expandArgs 替换命令参数中的 ${VAR}
+func expandArgs(args []string, env map[string]string) []string {
+	out := make([]string, 0, len(args))
+	for _, arg := range args {
+		out = append(out, expandEnv(arg, env))
+	}
+	return out
+}
+
+// environ 将环境变量转换为 exec.Cmd 使用的格式
+func environ(env map[string]string) []string {
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,110 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadEnvFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "comments and export",
+			content: "# comment\n\nexport A=1\n  B = 2  \n",
+			want:    map[string]string{"BASE": "base", "A": "1", "B": "2"},
+		},
+		{
+			name:    "unquoted",
+			content: "A=two words # comment\nB=a#b\nC=\n",
+			want:    map[string]string{"BASE": "base", "A": "two words", "B": "a#b", "C": ""},
+		},
+		{
+			name:    "single quotes",
+			content: `A='${BASE} \n # not comment'`,
+			want:    map[string]string{"BASE": "base", "A": `${BASE} \n # not comment`},
+		},
+		{
+			name:    "double quotes",
+			content: `A="${BASE}\t\"q\"\n # not comment"`,
+			want:    map[string]string{"BASE": "base", "A": "base\t\"q\"\n # not comment"},
+		},
+		{
+			name:    "expand",
+			content: "A=${BASE}-1\nB=${A}-${MISSING}-$A\nBASE=new\n",
+			want:    map[string]string{"BASE": "new", "A": "base-1", "B": "base-1--$A"},
+		},
+		{
+			name:    "unterminated quote",
+			content: `A="x`,
+			want:    map[string]string{"BASE": "base", "A": `"x`},
+		},
+		{name: "missing equal", content: "A\n", wantErr: true},
+		{name: "empty key", content: "=1\n", wantErr: true},
+		{name: "space in key", content: "A B=1\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, ".env"), []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			env := map[string]string{"BASE": "base"}
+			err := loadEnvFile(env, dir, ".env")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadEnvFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(env, tt.want) {
+				t.Errorf("loadEnvFile() = %q, want %q", env, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadEnvFileOptional(t *testing.T) {
+	dir := t.TempDir()
+	env := map[string]string{}
+	if err := loadEnvFile(env, dir, "-missing.env"); err != nil {
+		t.Errorf("optional env file: %v", err)
+	}
+	if err := loadEnvFile(env, dir, "missing.env"); err == nil {
+		t.Error("missing env file should return error")
+	}
+
+	name := filepath.Join(dir, "abs.env")
+	if err := os.WriteFile(name, []byte("A=1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadEnvFile(env, "/nonexistent", name); err != nil || env["A"] != "1" {
+		t.Errorf("absolute env file: %v, %v", err, env)
+	}
+}
@@ -0,0 +1,116 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	// 默认等待 pidFile 生成的时间
+	defaultPidFileTimeout = time.Second * 30
+)
+
+// validatePidFile 检查 forking 服务的 pidFile 参数
+func validatePidFile(kind, pidFile string) error {
+	if kind == gpmv1.ServiceForking && pidFile == "" {
+		return fmt.Errorf("forking service requires a pid file")
+	}
+	if kind != gpmv1.ServiceForking && pidFile != "" {
+		return fmt.Errorf("pid file only supports forking service")
+	}
+	return nil
+}
+
+// pidFilePath 返回 pidFile 的绝对路径
+func (p *Process) pidFilePath() string {
+	if filepath.IsAbs(p.PidFile) || p.Dir == "" {
+		return p.PidFile
+	}
+	return filepath.Join(p.Dir, p.PidFile)
+}
+
+// waitPidFile 等待启动进程写入 pidFile, 返回接管的守护进程
+func (p *Process) waitPidFile(c *child) (*child, error) {
+	name := p.pidFilePath()
+	timeout := defaultPidFileTimeout
+	if p.PidFileTimeout > 0 {
+		timeout = time.Duration(p.PidFileTimeout) * time.Second
+	}
+
+	after := time.After(timeout)
+	ticker := time.NewTicker(time.Millisecond * 100)
+	defer ticker.Stop()
+	var err error
+	for {
+		var pid int
+		pid, err = readPidFile(name)
+		if err == nil {
+			d, e := adoptChild(pid, c.start)
+			if e == nil {
+				log.Infof("service %s forked daemon pid: %d", p.Name, pid)
+				return d, nil
+			}
+			err = fmt.Errorf("pid %d in %s: %v", pid, name, e)
+		}
+
+		// 启动进程异常退出时不再等待
+		if c.isExited() && !exitSuccess(c.status) {
+			return nil, fmt.Errorf("process exited before writing pid file %s: %s", name, exitReason(c.status))
+		}
+
+		select {
+		case <-after:
+			if !c.isExited() {
+				_ = c.pr.Kill()
+				<-c.exited
+			}
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("pid file %s not found after %v", name, timeout)
+			}
+			return nil, fmt.Errorf("wait pid file %s after %v: %v", name, timeout, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// readPidFile 读取 pidFile 中的进程 id
+func readPidFile(name string) (int, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(string(bytes.TrimSpace(b)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("invalid pid file %s: %q", name, bytes.TrimSpace(b))
+	}
+	return pid, nil
+}
@@ -24,14 +24,15 @@ package service
 
 import (
 	"context"
-	"io"
+	"errors"
 	"os"
 	"path/filepath"
 	gruntime "runtime"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/hpcloud/tail"
+	"github.com/gogo/protobuf/proto"
 	"github.com/shirou/gopsutil/mem"
 	proc "github.com/shirou/gopsutil/process"
 	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
@@ -80,6 +81,12 @@ func (g *manager) Init() error {
 	if err = os.MkdirAll(filepath.Join(config.LoadRoot(), "services"), os.ModePerm); err != nil {
 		return err
 	}
+	if err = initCgroup(); err != nil {
+		log.Infof("resource limits disabled: %v", err)
+	} else {
+		log.Infof("manage services in cgroup %s", cgroupDir())
+	}
+
 	ctx := context.Background()
 	list, err := g.db.FindAllServices(ctx)
 	if err != nil {
@@ -87,18 +94,68 @@ func (g *manager) Init() error {
 	}
 
 	g.ps = map[string]*Process{}
+	graph := map[string][]string{}
 	for _, item := range list {
-		p := NewProcess(item, g.db)
-		if item.Status == gpmv1.StatusRunning {
-			_, _ = g.startService(ctx, p)
-		}
-		g.ps[item.Name] = p
+		g.ps[item.Name] = NewProcess(item, g.db)
+		graph[item.Name] = item.DependsOn
+	}
+
+	ordered, cycle := sortDependencies(graph)
+	if len(cycle) > 0 {
+		log.Errorf("circular dependency: %s", strings.Join(cycle, ", "))
+		ordered = append(ordered, cycle...)
 	}
+	g.boot(ctx, ordered)
 
 	g.up = time.Now()
 	return nil
 }
 
+// boot 按照依赖顺序启动 gpmd 退出前处于运行状态的服务, 每个服务等待依赖的服务就绪后启动
+func (g *manager) boot(ctx context.Context, ordered []string) {
+	for _, name := range ordered {
+		p := g.ps[name]
+		if p.Status == gpmv1.StatusPaused {
+			g.adoptPaused(p)
+			continue
+		}
+		if p.Status != gpmv1.StatusRunning {
+			continue
+		}
+		if len(p.DependsOn) == 0 {
+			_, _ = g.startService(ctx, p)
+			continue
+		}
+
+		go func(p *Process) {
+			if err := g.waitDependencies(ctx, p); err != nil {
+				log.Errorf("start service %s: %v", p.Name, err)
+				p.Status = gpmv1.StatusFailed
+				p.Msg = err.Error()
+				p.update()
+				return
+			}
+			_, _ = g.startService(ctx, p)
+		}(p)
+	}
+}
+
+// adoptPaused 接管 gpmd 退出前暂停的服务, 重新监听实例的进程, 服务保持暂停直到恢复
+func (g *manager) adoptPaused(p *Process) {
+	for _, r := range p.instances() {
+		if r.Status != gpmv1.StatusPaused {
+			continue
+		}
+		// 进程已经不存在的实例在 newInstance 中标记为停止, 这里避免启动新进程
+		if r.child() == nil && r.Type != gpmv1.ServiceCron {
+			continue
+		}
+		if _, err := r.Start(); err != nil {
+			log.Errorf("adopt paused service %s instance %d: %v", r.Name, r.index, err)
+		}
+	}
+}
+
 func (g *manager) Name() string {
 	return g.server.Options().Name
 }
@@ -125,10 +182,24 @@ func (g *manager) Info(ctx context.Context) (*gpmv1.GpmInfo, error) {
 	}
 	info.Stat = stat
 	info.UpTime = time.Now().Unix() - g.up.Unix()
+	info.Cgroup = cgroupDir()
+	info.Resources = map[string]*gpmv1.Resources{}
+	g.RLock()
+	for _, p := range g.ps {
+		if hasResourceLimits(p.Resources) {
+			info.Resources[p.Name] = p.Resources
+		}
+	}
+	g.RUnlock()
 
 	return info, nil
 }
 
+// hasResourceLimits 判断是否设置了资源限制
+func hasResourceLimits(r *gpmv1.Resources) bool {
+	return r != nil && (r.Memory != 0 || r.Cpu != 0 || r.Pids != 0 || r.IoWeight != 0)
+}
+
 func (g *manager) List(ctx context.Context) ([]*gpmv1.Service, int64, error) {
 	outs, err := g.db.FindAllServices(ctx)
 	if err != nil {
@@ -137,6 +208,7 @@ func (g *manager) List(ctx context.Context) ([]*gpmv1.Service, int64, error) {
 
 	for i := 0; i < len(outs); i++ {
 		statProcess(outs[i])
+		g.fillInstances(outs[i])
 	}
 
 	return outs, int64(len(outs)), nil
@@ -149,10 +221,32 @@ func (g *manager) Get(ctx context.Context, name string) (*gpmv1.Service, error)
 	}
 
 	statProcess(s)
+	g.fillInstances(s)
 
 	return s, nil
 }
 
+// fillInstances 填充服务所有实例的状态
+func (g *manager) fillInstances(s *gpmv1.Service) {
+	g.RLock()
+	p, ok := g.ps[s.Name]
+	g.RUnlock()
+	if !ok || s.Replicas <= 1 {
+		return
+	}
+
+	s.Instances = make([]*gpmv1.Instance, 0)
+	for _, r := range p.instances() {
+		instance := r.instance()
+		if r.index == 0 {
+			instance.Stat = s.Stat
+		} else {
+			instance.Stat = statInstance(r.Name, r.index, r.Pid, r.StartTimestamp)
+		}
+		s.Instances = append(s.Instances, instance)
+	}
+}
+
 func (g *manager) getService(ctx context.Context, name string) (*gpmv1.Service, error) {
 	s, err := g.db.FindService(ctx, name)
 	if err != nil {
@@ -166,18 +260,111 @@ func (g *manager) Create(ctx context.Context, spec *gpmv1.ServiceSpec) (*gpmv1.S
 	if v, _ := g.getService(ctx, spec.Name); v != nil {
 		return nil, verrs.Conflict(g.Name(), "service %s already exists", spec.Name)
 	}
+	if spec.RestartPolicy != nil {
+		if err := spec.RestartPolicy.ValidateE("restartPolicy."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.StopSignal != "" {
+		if _, err := parseSignal(spec.StopSignal); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.Replicas < 0 {
+		return nil, verrs.BadRequest(g.Name(), "invalid replicas %d", spec.Replicas)
+	}
+	if err := validateResources(spec.Resources); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateSysProcAttr(spec.SysProcAttr); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if len(spec.DependsOn) > 0 {
+		list, err := g.db.FindAllServices(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkDependencies(list, spec.Name, spec.DependsOn); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.LivenessProbe != nil {
+		if err := validateProbe(spec.LivenessProbe, "livenessProbe."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.ReadinessProbe != nil {
+		if err := validateProbe(spec.ReadinessProbe, "readinessProbe."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if err := validateHooks(spec.Hooks); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateJob(spec.Type, spec.Schedule, spec.ConcurrencyPolicy, spec.Replicas); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validatePidFile(spec.Type, spec.PidFile); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateReload(spec.Reload); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateSockets(spec.Type, spec.Sockets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateHandover(spec.Sockets, spec.ReadinessProbe); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateThreshold(spec.Type, spec.Threshold); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateEnv(spec.EnvMode, spec.EnvFiles); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateSecrets(spec.Secrets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := validateLabels(spec.Labels); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err := g.checkSecrets(ctx, spec.Secrets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
 
 	service := &gpmv1.Service{
-		Name:        spec.Name,
-		Bin:         spec.Bin,
-		Args:        spec.Args,
-		Dir:         spec.Dir,
-		Env:         spec.Env,
-		SysProcAttr: spec.SysProcAttr,
-		Log:         spec.Log,
-		Version:     spec.Version,
-		AutoRestart: spec.AutoRestart,
-		InstallFlag: spec.InstallFlag,
+		Name:              spec.Name,
+		Bin:               spec.Bin,
+		Args:              spec.Args,
+		Dir:               spec.Dir,
+		Env:               spec.Env,
+		EnvMode:           spec.EnvMode,
+		EnvFiles:          spec.EnvFiles,
+		SysProcAttr:       spec.SysProcAttr,
+		Log:               spec.Log,
+		Version:           spec.Version,
+		AutoRestart:       spec.AutoRestart,
+		InstallFlag:       spec.InstallFlag,
+		RestartPolicy:     spec.RestartPolicy,
+		StopSignal:        spec.StopSignal,
+		StopTimeout:       spec.StopTimeout,
+		KillMode:          spec.KillMode,
+		LivenessProbe:     spec.LivenessProbe,
+		ReadinessProbe:    spec.ReadinessProbe,
+		DependsOn:         spec.DependsOn,
+		Replicas:          spec.Replicas,
+		Resources:         spec.Resources,
+		Hooks:             spec.Hooks,
+		Type:              spec.Type,
+		Schedule:          spec.Schedule,
+		ConcurrencyPolicy: spec.ConcurrencyPolicy,
+		PidFile:           spec.PidFile,
+		PidFileTimeout:    spec.PidFileTimeout,
+		Reload:            spec.Reload,
+		Sockets:           spec.Sockets,
+		Threshold:         spec.Threshold,
+		Secrets:           spec.Secrets,
+		Labels:            spec.Labels,
 	}
 
 	err := fillService(service)
@@ -187,6 +374,15 @@ func (g *manager) Create(ctx context.Context, spec *gpmv1.ServiceSpec) (*gpmv1.S
 
 	service.Status = gpmv1.StatusInit
 	service.CreationTimestamp = time.Now().Unix()
+	if service.Replicas == 0 {
+		service.Replicas = 1
+	}
+	if service.Type == "" {
+		service.Type = gpmv1.ServiceSimple
+	}
+	if service.Type == gpmv1.ServiceCron && service.ConcurrencyPolicy == "" {
+		service.ConcurrencyPolicy = gpmv1.ConcurrencyAllow
+	}
 	if service.Version == "" {
 		service.Version = "v0.0.1"
 	}
@@ -208,23 +404,84 @@ func (g *manager) Edit(ctx context.Context, name string, spec *gpmv1.EditService
 	if err != nil {
 		return nil, err
 	}
+	// 先合并再校验, 避免校验时填充的默认值覆盖已有的参数
+	var restartPolicy *gpmv1.RestartPolicy
+	if spec.RestartPolicy != nil {
+		restartPolicy = mergeRestartPolicy(service.RestartPolicy, spec.RestartPolicy)
+		if err = restartPolicy.ValidateE("restartPolicy."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.StopSignal != "" {
+		if _, err = parseSignal(spec.StopSignal); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if len(spec.DependsOn) > 0 {
+		list, err := g.db.FindAllServices(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkDependencies(list, name, spec.DependsOn); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.LivenessProbe != nil {
+		if err = validateProbe(spec.LivenessProbe, "livenessProbe."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if spec.ReadinessProbe != nil {
+		if err = validateProbe(spec.ReadinessProbe, "readinessProbe."); err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+	}
+	if err = validateHooks(spec.Hooks); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateReload(spec.Reload); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateEnv(spec.EnvMode, spec.EnvFiles); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateSecrets(spec.Secrets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateLabels(spec.Labels); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = g.checkSecrets(ctx, spec.Secrets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+
+	if spec.Replicas < 0 {
+		return nil, verrs.BadRequest(g.Name(), "invalid replicas %d", spec.Replicas)
+	}
+	if err = validateResources(spec.Resources); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateSysProcAttr(spec.SysProcAttr); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
 
 	g.RLock()
 	p, ok := g.ps[name]
 	g.RUnlock()
 
-	var isRunning bool
-	if ok && p.Status == gpmv1.StatusRunning {
-		isRunning = true
-		g.stopService(ctx, p)
-	}
-
+	before := proto.Clone(service).(*gpmv1.Service)
 	if spec.Bin != "" {
 		service.Bin = spec.Bin
 	}
 	if len(spec.Env) > 0 {
 		service.Env = spec.Env
 	}
+	if spec.EnvMode != "" {
+		service.EnvMode = spec.EnvMode
+	}
+	if len(spec.EnvFiles) > 0 {
+		service.EnvFiles = spec.EnvFiles
+	}
 	if spec.Dir != "" {
 		service.Dir = spec.Dir
 	}
@@ -236,9 +493,18 @@ func (g *manager) Edit(ctx context.Context, name string, spec *gpmv1.EditService
 		if spec.Log.MaxSize > 0 {
 			service.Log.MaxSize = spec.Log.MaxSize
 		}
+		if spec.Log.Interval > 0 {
+			service.Log.Interval = spec.Log.Interval
+		}
+		if spec.Log.MaxTotalSize > 0 {
+			service.Log.MaxTotalSize = spec.Log.MaxTotalSize
+		}
+		if spec.Log.MaxFiles > 0 {
+			service.Log.MaxFiles = spec.Log.MaxFiles
+		}
 	}
 	if spec.SysProcAttr != nil {
-		service.SysProcAttr = spec.SysProcAttr
+		service.SysProcAttr = mergeSysProcAttr(service.SysProcAttr, spec.SysProcAttr)
 	}
 	if len(spec.Args) > 0 {
 		service.Args = spec.Args
@@ -246,12 +512,120 @@ func (g *manager) Edit(ctx context.Context, name string, spec *gpmv1.EditService
 	if spec.AutoRestart != 0 {
 		service.AutoRestart = spec.AutoRestart
 	}
+	if restartPolicy != nil {
+		service.RestartPolicy = restartPolicy
+	}
+	if spec.StopSignal != "" {
+		service.StopSignal = spec.StopSignal
+	}
+	if spec.StopTimeout > 0 {
+		service.StopTimeout = spec.StopTimeout
+	}
+	if spec.KillMode != "" {
+		service.KillMode = spec.KillMode
+	}
+	if spec.LivenessProbe != nil {
+		service.LivenessProbe = spec.LivenessProbe
+	}
+	if spec.ReadinessProbe != nil {
+		service.ReadinessProbe = spec.ReadinessProbe
+	}
+	if len(spec.DependsOn) > 0 {
+		service.DependsOn = spec.DependsOn
+	}
+
+	if spec.Replicas > 0 {
+		service.Replicas = spec.Replicas
+	}
+	if spec.Resources != nil {
+		service.Resources = spec.Resources
+	}
+	if spec.Hooks != nil {
+		service.Hooks = mergeHooks(service.Hooks, spec.Hooks)
+	}
+	if spec.Reload != nil {
+		service.Reload = spec.Reload
+	}
+	if len(spec.Sockets) > 0 {
+		service.Sockets = spec.Sockets
+	}
+	if spec.Threshold != nil {
+		service.Threshold = spec.Threshold
+	}
+	if len(spec.Secrets) > 0 {
+		service.Secrets = spec.Secrets
+	}
+	if len(spec.Labels) > 0 {
+		service.Labels = spec.Labels
+	}
+	if spec.Type != "" && spec.Type != service.Type {
+		service.Type = spec.Type
+		// 修改服务类型时清除原类型的参数
+		if service.Type != gpmv1.ServiceCron {
+			service.Schedule = ""
+			service.ConcurrencyPolicy = ""
+			service.NextRunTimestamp = 0
+		} else if service.ConcurrencyPolicy == "" {
+			service.ConcurrencyPolicy = gpmv1.ConcurrencyAllow
+		}
+		if service.Type != gpmv1.ServiceForking {
+			service.PidFile = ""
+			service.PidFileTimeout = 0
+		}
+	}
+	if spec.Schedule != "" {
+		service.Schedule = spec.Schedule
+	}
+	if spec.ConcurrencyPolicy != "" {
+		service.ConcurrencyPolicy = spec.ConcurrencyPolicy
+	}
+	if spec.PidFile != "" {
+		service.PidFile = spec.PidFile
+	}
+	if spec.PidFileTimeout > 0 {
+		service.PidFileTimeout = spec.PidFileTimeout
+	}
+	if err = validateJob(service.Type, service.Schedule, service.ConcurrencyPolicy, service.Replicas); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validatePidFile(service.Type, service.PidFile); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateSockets(service.Type, service.Sockets); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateHandover(service.Sockets, service.ReadinessProbe); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+	if err = validateThreshold(service.Type, service.Threshold); err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
 
 	err = fillService(service)
 	if err != nil {
 		return nil, err
 	}
 
+	// 只修改实例数量时, 不重启已经运行的实例
+	after := proto.Clone(service).(*gpmv1.Service)
+	after.Replicas = before.Replicas
+	if ok && proto.Equal(before, after) {
+		if service.Replicas != p.Replicas {
+			g.scale(ctx, p, service.Replicas)
+			p.Replicas = service.Replicas
+			p.update()
+		}
+		return p.Service, nil
+	}
+
+	var isRunning bool
+	if ok && (p.Status == gpmv1.StatusRunning || p.Status == gpmv1.StatusPaused) {
+		isRunning = true
+		if _, err = g.stopService(ctx, p, gpmv1.ExitStop); err != nil && isHookError(err) {
+			return nil, err
+		}
+	}
+
 	service, err = g.db.UpdateService(ctx, service)
 	if err != nil {
 		return nil, err
@@ -279,6 +653,23 @@ func (g *manager) Start(ctx context.Context, name string) (*gpmv1.Service, error
 	p := g.ps[s.Name]
 	g.RUnlock()
 
+	// 按照依赖顺序启动未运行的依赖服务
+	for _, dp := range g.dependencies(s.Name) {
+		if dp.active() {
+			continue
+		}
+		if err = g.waitDependencies(ctx, dp); err != nil {
+			return nil, verrs.InternalServerError(g.Name(), "start service %s: %v", dp.Name, err)
+		}
+		log.Infof("start service %s, dependency of %s", dp.Name, s.Name)
+		if _, err = g.startService(ctx, dp); err != nil {
+			return nil, err
+		}
+	}
+	if err = g.waitDependencies(ctx, p); err != nil {
+		return nil, verrs.InternalServerError(g.Name(), "start service %s: %v", s.Name, err)
+	}
+
 	s, err = g.startService(ctx, p)
 	if err != nil {
 		return nil, err
@@ -288,7 +679,17 @@ func (g *manager) Start(ctx context.Context, name string) (*gpmv1.Service, error
 }
 
 func (g *manager) startService(ctx context.Context, p *Process) (*gpmv1.Service, error) {
-	pid, err := p.Start()
+	// gpmd 重启后接管的进程已经在运行, 不再执行启动钩子
+	adopted := p.child() != nil
+	var err error
+	if !adopted {
+		p.rotateLog()
+		err = runHook(ctx, p, hookPreStart)
+	}
+	var pid int32
+	if err == nil {
+		pid, err = p.Start()
+	}
 
 	s := p.Service
 	now := time.Now()
@@ -299,6 +700,7 @@ func (g *manager) startService(ctx context.Context, p *Process) (*gpmv1.Service,
 	} else {
 		s.Pid = int64(pid)
 		s.Status = gpmv1.StatusRunning
+		s.Msg = ""
 	}
 
 	var e error
@@ -310,31 +712,133 @@ func (g *manager) startService(ctx context.Context, p *Process) (*gpmv1.Service,
 		return nil, e
 	}
 
+	for _, r := range p.instances()[1:] {
+		g.startReplica(r)
+	}
+
 	g.Lock()
 	g.ps[s.Name] = p
 	g.Unlock()
 
+	if !adopted {
+		if err = runHook(ctx, p, hookPostStart); err != nil {
+			_ = g.stopInstances(p, gpmv1.ExitStop)
+			s.Status = gpmv1.StatusFailed
+			s.Msg = err.Error()
+			_, _ = g.db.UpdateService(ctx, s)
+			return nil, err
+		}
+	}
+
 	return s, nil
 }
 
-func (g *manager) Stop(ctx context.Context, name string) (*gpmv1.Service, error) {
+// startReplica 启动服务的其他实例
+func (g *manager) startReplica(r *Process) {
+	if r.child() == nil {
+		r.rotateLog()
+	}
+	pid, err := r.Start()
+	if err != nil {
+		log.Errorf("start service %s instance %d: %v", r.Name, r.index, err)
+		r.Status = gpmv1.StatusFailed
+		r.Msg = err.Error()
+	} else {
+		r.Pid = int64(pid)
+		r.Status = gpmv1.StatusRunning
+		r.Msg = ""
+	}
+	r.update()
+}
+
+// stopReplica 停止服务的其他实例
+func (g *manager) stopReplica(r *Process, reason string) {
+	if err := r.Stop(reason); err != nil && !errors.Is(err, ErrProcessNotFound) {
+		log.Errorf("stop service %s instance %d: %v", r.Name, r.index, err)
+		return
+	}
+	r.Status = gpmv1.StatusStopped
+	r.update()
+}
+
+// scale 调整服务的实例数量, 不影响已经运行的实例
+func (g *manager) scale(ctx context.Context, p *Process, replicas int32) {
+	instances := p.instances()
+	isRunning := p.Status == gpmv1.StatusRunning
+	for i := int32(len(instances)); i < replicas; i++ {
+		r := newInstance(instanceService(p.Service, i, g.db), g.db, i)
+		log.Infof("scale up service %s instance %d", p.Name, i)
+		if isRunning {
+			g.startReplica(r)
+		}
+		instances = append(instances, r)
+	}
+	for i := int32(len(instances)) - 1; i >= replicas && i > 0; i-- {
+		r := instances[i]
+		log.Infof("scale down service %s instance %d", p.Name, i)
+		g.stopReplica(r, gpmv1.ExitStop)
+		removeCgroup(p.Name, i)
+		_ = g.db.DeleteInstance(ctx, p.Name, i)
+		instances = instances[:i]
+	}
+
+	p.mu.Lock()
+	p.replicas = instances[1:]
+	p.mu.Unlock()
+}
+
+func (g *manager) Stop(ctx context.Context, name string, dependents bool) (*gpmv1.Service, error) {
 	s, err := g.getService(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
+	if dependents {
+		for _, dp := range g.dependents(s.Name) {
+			if !dp.active() {
+				continue
+			}
+			log.Infof("stop service %s, depends on %s", dp.Name, s.Name)
+			if _, err = g.stopService(ctx, dp, gpmv1.ExitStop); err != nil {
+				return nil, err
+			}
+			closeSockets(dp.Name)
+		}
+	}
+
 	g.RLock()
 	p := g.ps[s.Name]
 	g.RUnlock()
 
-	return g.stopService(ctx, p)
+	s, err = g.stopService(ctx, p, gpmv1.ExitStop)
+	if err != nil {
+		return nil, err
+	}
+	// 停止的服务不再保持监听
+	closeSockets(s.Name)
+
+	return s, nil
 }
 
-func (g *manager) stopService(ctx context.Context, p *Process) (*gpmv1.Service, error) {
+func (g *manager) stopService(ctx context.Context, p *Process, reason string) (*gpmv1.Service, error) {
+	// 暂停的进程无法处理停止信号, 先恢复运行
+	if err := g.resumeInstances(p); err != nil {
+		log.Errorf("resume service %s: %v", p.Name, err)
+	}
 
-	err := p.Stop()
+	running := p.active()
+	if running {
+		if err := runHook(ctx, p, hookPreStop); err != nil {
+			return nil, err
+		}
+	}
+
+	err := g.stopInstances(p, reason)
 	if err != nil {
-		return nil, err
+		// 等待重启或 crashloop 状态的服务没有运行中的进程
+		if !errors.Is(err, ErrProcessNotFound) || p.Status == gpmv1.StatusStopped || p.Status == gpmv1.StatusInit {
+			return nil, err
+		}
 	}
 
 	s := p.Service
@@ -350,9 +854,24 @@ func (g *manager) stopService(ctx context.Context, p *Process) (*gpmv1.Service,
 	g.ps[s.Name] = p
 	g.Unlock()
 
+	if running {
+		if err = runHook(ctx, p, hookPostStop); err != nil {
+			return nil, err
+		}
+	}
+
 	return s, nil
 }
 
+// stopInstances 停止服务的所有实例
+func (g *manager) stopInstances(p *Process, reason string) error {
+	for _, r := range p.instances()[1:] {
+		g.stopReplica(r, reason)
+	}
+
+	return p.Stop(reason)
+}
+
 func (g *manager) Restart(ctx context.Context, name string) (*gpmv1.Service, error) {
 	s, err := g.getService(ctx, name)
 	if err != nil {
@@ -363,7 +882,13 @@ func (g *manager) Restart(ctx context.Context, name string) (*gpmv1.Service, err
 	p := g.ps[s.Name]
 	g.RUnlock()
 
-	g.stopService(ctx, p)
+	if g.canHandover(p) {
+		return g.handover(ctx, p, gpmv1.ExitStop)
+	}
+
+	if _, err = g.stopService(ctx, p, gpmv1.ExitStop); err != nil && isHookError(err) {
+		return nil, err
+	}
 
 	return g.startService(ctx, p)
 }
@@ -374,13 +899,13 @@ func (g *manager) Delete(ctx context.Context, name string) (*gpmv1.Service, erro
 		return nil, err
 	}
 
-	if s.Status == gpmv1.StatusRunning {
+	if s.Status == gpmv1.StatusRunning || s.Status == gpmv1.StatusPaused {
 
 		g.RLock()
 		p := g.ps[s.Name]
 		g.RUnlock()
 
-		if _, err = g.stopService(ctx, p); err != nil {
+		if _, err = g.stopService(ctx, p, gpmv1.ExitStop); err != nil {
 			return nil, err
 		}
 	}
@@ -393,6 +918,9 @@ func (g *manager) Delete(ctx context.Context, name string) (*gpmv1.Service, erro
 	if err != nil {
 		return nil, err
 	}
+	removeServiceCgroup(s.Name)
+	closeSockets(s.Name)
+	closeLogPipes(s.Name)
 
 	if s.InstallFlag == 1 {
 		log.Infof("remove %s directory", s.Name)
@@ -405,55 +933,43 @@ func (g *manager) Delete(ctx context.Context, name string) (*gpmv1.Service, erro
 	return s, nil
 }
 
-func (g *manager) TailLog(ctx context.Context, name string, number int64, follow bool, sender IOWriter) error {
-	f := filepath.Join(config.LoadRoot(), "logs", name, name+".log")
+func (g *manager) TailLog(ctx context.Context, name string, opts *gpmv1.LogOptions, sender IOWriter) error {
+	q, err := newLogQuery(opts)
+	if err != nil {
+		return verrs.BadRequest(g.Name(), err.Error())
+	}
+
+	index := q.Instance
+	if index < 0 {
+		return verrs.BadRequest(g.Name(), "invalid instance %d", index)
+	}
+	f := filepath.Join(config.LoadRoot(), "logs", name, instanceLogName(name, index))
 	stat, _ := os.Stat(f)
 	if stat == nil {
-		return verrs.NotFound(g.Name(), "service '%s' log not exists", name)
+		return verrs.NotFound(g.Name(), "service '%s' instance %d log not exists", name, index)
 	}
 
-	cfg := tail.Config{
-		Poll: true,
+	d := newLogDecoder()
+	records, pos, err := q.history(name, index, d, !q.Follow)
+	if err != nil {
+		return verrs.InternalServerError(g.Name(), "read service '%s' log: %v", name, err)
 	}
-
-	if number > 0 {
-		total := stat.Size()
-		if number > total {
-			cfg.Location = &tail.SeekInfo{Offset: 0, Whence: io.SeekStart}
-		} else {
-			cfg.Location = &tail.SeekInfo{Offset: -1 * number, Whence: io.SeekEnd}
-		}
+	defer pos.close()
+	now := time.Now()
+	for _, rec := range records {
+		_ = sender.Send(newServiceLog(name, index, rec, now))
 	}
-
-	if follow {
-		cfg.ReOpen = true
-		cfg.Follow = true
+	if !q.following() {
+		return nil
 	}
 
-	t, err := tail.TailFile(f, cfg)
+	err = q.follow(ctx, name, index, pos, d, func(rec *logRecord, t time.Time) {
+		_ = sender.Send(newServiceLog(name, index, rec, t))
+	})
 	if err != nil {
-		return err
-	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			t.Stop()
-			return nil
-		case line, ok := <-t.Lines:
-			if !ok {
-				continue
-			}
-			l := &gpmv1.ServiceLog{
-				Text:      line.Text,
-				Timestamp: line.Time.Unix(),
-			}
-			if line.Err != nil {
-				l.Error = line.Err.Error()
-			}
-			_ = sender.Send(l)
-		}
+		return verrs.InternalServerError(g.Name(), "read service '%s' log: %v", name, err)
 	}
+	return nil
 }
 
 func (g *manager) String() string {
@@ -120,10 +120,7 @@ func execSysProcAttr(cmd *exec.Cmd, in *gpmv1.ExecIn) {
 		}
 	}
 
-	cmd.Env = os.Environ()
-	for k, v := range in.Env {
-		cmd.Env = append(cmd.Env, k+"="+v)
-	}
+	cmd.Env = commandEnv(in.EnvMode, in.User, in.Env)
 	cmd.SysProcAttr = sysAttr
 	cmd.Dir = in.Dir
 }
@@ -159,10 +156,7 @@ func startExec(ctx context.Context, in *gpmv1.ExecIn) *exec.Cmd {
 	}
 
 	cmd.SysProcAttr = sysAttr
-	cmd.Env = append(cmd.Env, os.Environ()...)
-	for k, v := range in.Env {
-		cmd.Env = append(cmd.Env, k+"="+v)
-	}
+	cmd.Env = commandEnv(in.EnvMode, in.User, in.Env)
 	home, err := os.UserHomeDir()
 	if err == nil {
 		cmd.Dir = home
@@ -190,10 +184,7 @@ func startTerminal(ctx context.Context, in *gpmv1.TerminalIn) *exec.Cmd {
 	}
 
 	cmd.SysProcAttr = sysAttr
-	cmd.Env = append(cmd.Env, os.Environ()...)
-	for k, v := range in.Env {
-		cmd.Env = append(cmd.Env, k+"="+v)
-	}
+	cmd.Env = commandEnv(in.EnvMode, in.User, in.Env)
 	home, err := os.UserHomeDir()
 	if err == nil {
 		cmd.Dir = home
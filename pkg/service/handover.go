@@ -0,0 +1,216 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	// 新进程启动后等待的时间, 之后再停止旧进程
+	handoverDelay = time.Second * 1
+	// 等待新进程就绪的超时时间
+	handoverTimeout = time.Minute * 1
+)
+
+// canHandover 判断服务能否在不停止监听的情况下替换进程: 服务使用 gpmd 监听的套接字并且套接字仍在监听.
+// gpmd 重启后套接字只保留在旧进程中, 无法重新监听相同的地址, 只能先停止旧进程
+func (g *manager) canHandover(p *Process) bool {
+	if len(p.Sockets) == 0 || p.Status != gpmv1.StatusRunning || p.child() == nil {
+		return false
+	}
+	if sharedProbe(p.ReadinessProbe, p.Sockets) {
+		log.Infof("service %s can't handover sockets: readiness probe uses the shared socket", p.Name)
+		return false
+	}
+	if _, err := listenSockets(p.Name, p.Sockets); err != nil {
+		log.Infof("service %s can't handover sockets: %v", p.Name, err)
+		return false
+	}
+	return true
+}
+
+// handover 使用相同的套接字启动新进程, 新进程就绪后再停止旧进程, 新进程启动失败时旧进程继续运行
+func (g *manager) handover(ctx context.Context, p *Process, reason string) (*gpmv1.Service, error) {
+	if err := runHook(ctx, p, hookPreStart); err != nil {
+		return nil, err
+	}
+
+	instances := p.instances()
+	spawned := make([]*child, 0, len(instances))
+	abort := func(err error) (*gpmv1.Service, error) {
+		for i, c := range spawned {
+			_ = instances[i].terminate(c)
+		}
+		return nil, err
+	}
+	for _, r := range instances {
+		log.Infof("handover service %s instance %d", r.Name, r.index)
+		r.rotateLog()
+		c, err := r.spawn()
+		if err != nil {
+			return abort(fmt.Errorf("start new process: %v", err))
+		}
+		spawned = append(spawned, c)
+		if err = r.waitHandover(ctx, c); err != nil {
+			return abort(fmt.Errorf("new process %d not ready: %v", c.pid, err))
+		}
+	}
+
+	if err := runHook(ctx, p, hookPreStop); err != nil {
+		return abort(err)
+	}
+	for i, r := range instances {
+		r.swap(spawned[i], reason)
+	}
+	herr := runHook(ctx, p, hookPostStop)
+
+	s := p.Service
+	s.Status = gpmv1.StatusRunning
+	s.Msg = ""
+	s.UpdateTimestamp = time.Now().Unix()
+	s, err := g.db.UpdateService(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = runHook(ctx, p, hookPostStart); err != nil {
+		return nil, err
+	}
+	if herr != nil {
+		return nil, herr
+	}
+	return s, nil
+}
+
+// validateHandover 检查交接套接字时使用的就绪探测
+func validateHandover(sockets []*gpmv1.Socket, probe *gpmv1.Probe) error {
+	if sharedProbe(probe, sockets) {
+		return errors.New("field 'readinessProbe' can't use the listen address, the old process still serves it during handover, use an exec probe or another address of the process")
+	}
+	return nil
+}
+
+// sharedProbe 判断就绪探测的地址是否为服务监听的套接字. 交接套接字时旧进程仍在相同的套接字上提供服务,
+// 探测成功不能说明新进程已经就绪
+func sharedProbe(probe *gpmv1.Probe, sockets []*gpmv1.Socket) bool {
+	if probe == nil || len(sockets) == 0 {
+		return false
+	}
+	var port string
+	switch probe.Type {
+	case gpmv1.ProbeHTTP:
+		u, err := url.Parse(probe.Url)
+		if err != nil {
+			return false
+		}
+		port = u.Port()
+		if port == "" {
+			port = "80"
+			if u.Scheme == "https" {
+				port = "443"
+			}
+		}
+	case gpmv1.ProbeTCP:
+		_, port, _ = net.SplitHostPort(probe.Address)
+	}
+	if port == "" {
+		return false
+	}
+
+	for _, item := range sockets {
+		network, addr, err := parseSocket(item.Address)
+		if err != nil || !strings.HasPrefix(network, "tcp") {
+			continue
+		}
+		if _, p, err := net.SplitHostPort(addr); err == nil && p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// waitHandover 等待新进程就绪, 配置了就绪探测时等待探测成功
+func (p *Process) waitHandover(ctx context.Context, c *child) error {
+	delay := handoverDelay
+	probe := p.ReadinessProbe
+	if probe != nil && probe.InitialDelay > 0 {
+		delay = time.Duration(probe.InitialDelay) * time.Second
+	}
+
+	after := time.After(handoverTimeout)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-after:
+			return fmt.Errorf("timeout after %v", handoverTimeout)
+		case <-c.exited:
+			return fmt.Errorf("process exited: %s", exitReason(c.status))
+		case <-timer.C:
+		}
+
+		if probe == nil {
+			return nil
+		}
+		pctx, cancel := context.WithTimeout(ctx, time.Duration(probe.Timeout)*time.Second)
+		err := runProbe(pctx, p, probe, c.pid)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		log.Infof("service %s(%d) readiness probe: %v", p.Name, c.pid, err)
+		timer.Reset(time.Duration(probe.Interval) * time.Second)
+	}
+}
+
+// swap 停止旧进程并监听新进程
+func (p *Process) swap(c *child, reason string) {
+	// 停止监听旧进程, 包括等待重启的实例
+	p.closeDone()
+	if old := p.child(); old != nil {
+		if err := p.terminate(old); err != nil {
+			log.Errorf("stop service %s(%d): %v", p.Name, old.pid, err)
+		}
+		p.reaped(old, reason)
+	}
+
+	p.setChild(c)
+	p.StartTimestamp = time.Now().Unix()
+	p.Restarts = 0
+	p.Status = gpmv1.StatusRunning
+	p.Msg = ""
+	_, _ = p.Start()
+	p.update()
+}
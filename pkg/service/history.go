@@ -0,0 +1,157 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	// 每个服务保存的退出记录数量
+	maxServiceExits = 20
+	// 退出记录中保存的日志行数
+	exitLogLines = 50
+	// 读取日志时最多读取的字节数
+	exitLogBytes = 64 * 1024
+)
+
+func (g *manager) ListExits(ctx context.Context, name string) ([]*gpmv1.ServiceExit, error) {
+	if _, err := g.getService(ctx, name); err != nil {
+		return nil, err
+	}
+
+	return g.db.ListServiceExits(ctx, name)
+}
+
+// exitKind 判断进程退出的原因
+func (p *Process) exitKind(c *child, status *gpmv1.ExitStatus) string {
+	if c.kind != "" {
+		return c.kind
+	}
+	if c.oomKills >= 0 {
+		if n := cgroupOOMKills(p.Name, p.index); n > c.oomKills {
+			return gpmv1.ExitOOM
+		}
+	}
+	if exitSuccess(status) {
+		return gpmv1.ExitNormal
+	}
+	return gpmv1.ExitCrash
+}
+
+// recordExit 保存进程的退出记录和退出时最后的日志
+func (p *Process) recordExit(c *child, reason, message string) {
+	if c.status == nil {
+		return
+	}
+
+	// 服务进程退出前的输出可能还在管道中
+	syncLogPipe(p.Name, p.index)
+	exit := &gpmv1.ServiceExit{
+		Instance:  p.index,
+		Status:    c.status,
+		Reason:    reason,
+		Message:   message,
+		Restarts:  p.Restarts,
+		Logs:      tailLines(filepath.Join(config.LoadRoot(), "logs", p.Name, p.logName()), exitLogLines),
+		Timestamp: time.Now().Unix(),
+	}
+	if err := p.db.AddServiceExit(context.TODO(), p.Name, exit, maxServiceExits); err != nil {
+		log.Errorf("save service %s exit: %v", p.Name, err)
+	}
+}
+
+// recordEvent 保存进程运行中发生的事件, 如资源占用超过阈值, 记录中的 status 只有进程信息
+func (p *Process) recordEvent(c *child, reason, message string) {
+	syncLogPipe(p.Name, p.index)
+	event := &gpmv1.ServiceExit{
+		Instance: p.index,
+		Status: &gpmv1.ExitStatus{
+			Pid:            int64(c.pid),
+			Code:           -1,
+			StartTimestamp: c.start.Unix(),
+		},
+		Reason:    reason,
+		Message:   message,
+		Restarts:  p.Restarts,
+		Logs:      tailLines(filepath.Join(config.LoadRoot(), "logs", p.Name, p.logName()), exitLogLines),
+		Timestamp: time.Now().Unix(),
+	}
+	if err := p.db.AddServiceExit(context.TODO(), p.Name, event, maxServiceExits); err != nil {
+		log.Errorf("save service %s event: %v", p.Name, err)
+	}
+}
+
+// tailLines 读取文件最后的 n 行
+func tailLines(name string, n int) []string {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil
+	}
+	offset := stat.Size() - exitLogBytes
+	if offset < 0 {
+		offset = 0
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		return nil
+	}
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil
+	}
+
+	b = bytes.TrimRight(b, "\n")
+	if len(b) == 0 {
+		return nil
+	}
+	lines := strings.Split(string(b), "\n")
+	// 第一行可能不完整
+	if offset > 0 && len(lines) > 1 {
+		lines = lines[1:]
+	}
+	records := decodeLogLines(lines)
+	if len(records) > n {
+		records = records[len(records)-n:]
+	}
+	lines = make([]string, 0, len(records))
+	for _, rec := range records {
+		lines = append(lines, rec.text)
+	}
+	return lines
+}
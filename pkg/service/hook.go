@@ -0,0 +1,232 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	hookPreStart  = "preStart"
+	hookPostStart = "postStart"
+	hookPreStop   = "preStop"
+	hookPostStop  = "postStop"
+
+	// cmdWaitDelay 命令退出或者超时后等待输出关闭的时间, 避免后台进程持有输出时一直等待
+	cmdWaitDelay = time.Second * 5
+)
+
+// hookError 钩子执行失败并且失败处理方式为 abort
+type hookError struct {
+	kind string
+	err  error
+}
+
+func (e *hookError) Error() string {
+	return fmt.Sprintf("%s hook: %v", e.kind, e.err)
+}
+
+func (e *hookError) Unwrap() error {
+	return e.err
+}
+
+// isHookError 判断错误是否由钩子失败引起
+func isHookError(err error) bool {
+	var e *hookError
+	return errors.As(err, &e)
+}
+
+func validateHooks(hooks *gpmv1.Hooks) error {
+	if hooks == nil {
+		return nil
+	}
+
+	items := map[string]*gpmv1.Hook{
+		hookPreStart:  hooks.PreStart,
+		hookPostStart: hooks.PostStart,
+		hookPreStop:   hooks.PreStop,
+		hookPostStop:  hooks.PostStop,
+	}
+	for kind, hook := range items {
+		if hook == nil {
+			continue
+		}
+		prefix := "hooks." + kind + "."
+		if err := hook.ValidateE(prefix); err != nil {
+			return err
+		}
+		switch hook.Type {
+		case gpmv1.HookExec:
+			if len(hook.Command) == 0 {
+				return fmt.Errorf("field '%scommand' is required", prefix)
+			}
+		case gpmv1.HookHTTP:
+			if hook.Url == "" {
+				return fmt.Errorf("field '%surl' is required", prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeHooks 合并修改的生命周期钩子
+func mergeHooks(dst, src *gpmv1.Hooks) *gpmv1.Hooks {
+	if dst == nil {
+		dst = &gpmv1.Hooks{}
+	}
+	if src.PreStart != nil {
+		dst.PreStart = src.PreStart
+	}
+	if src.PostStart != nil {
+		dst.PostStart = src.PostStart
+	}
+	if src.PreStop != nil {
+		dst.PreStop = src.PreStop
+	}
+	if src.PostStop != nil {
+		dst.PostStop = src.PostStop
+	}
+	return dst
+}
+
+// getHook 返回服务指定类型的钩子
+func getHook(s *gpmv1.Service, kind string) *gpmv1.Hook {
+	if s.Hooks == nil {
+		return nil
+	}
+	switch kind {
+	case hookPreStart:
+		return s.Hooks.PreStart
+	case hookPostStart:
+		return s.Hooks.PostStart
+	case hookPreStop:
+		return s.Hooks.PreStop
+	case hookPostStop:
+		return s.Hooks.PostStop
+	}
+	return nil
+}
+
+// runHook 执行服务的生命周期钩子, 输出写入服务日志. 钩子失败并且失败处理方式为 abort 时返回错误
+func runHook(ctx context.Context, p *Process, kind string) error {
+	s := p.Service
+	hook := getHook(s, kind)
+	if hook == nil {
+		return nil
+	}
+
+	var out io.Writer = io.Discard
+	if lw := serviceLog(s); lw != nil {
+		out = lw
+	}
+
+	timeout := time.Duration(hook.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = time.Second * 30
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.Infof("run service %s %s hook", s.Name, kind)
+	fmt.Fprintf(out, "[gpm] %s run %s hook\n", time.Now().Format(time.RFC3339), kind)
+	var err error
+	switch hook.Type {
+	case gpmv1.HookExec:
+		err = execHook(ctx, p, kind, hook, out)
+	case gpmv1.HookHTTP:
+		err = httpHook(ctx, hook, out)
+	default:
+		err = fmt.Errorf("unknown hook type %s", hook.Type)
+	}
+	if err == nil {
+		fmt.Fprintf(out, "[gpm] %s %s hook done\n", time.Now().Format(time.RFC3339), kind)
+		return nil
+	}
+
+	fmt.Fprintf(out, "[gpm] %s %s hook failed: %v\n", time.Now().Format(time.RFC3339), kind, err)
+	log.Errorf("service %s %s hook: %v", s.Name, kind, err)
+	if hook.OnFailure == gpmv1.HookContinue {
+		return nil
+	}
+	return &hookError{kind: kind, err: err}
+}
+
+// execHook 在服务目录下以服务的用户, 环境变量和密钥执行命令
+func execHook(ctx context.Context, p *Process, kind string, hook *gpmv1.Hook, out io.Writer) error {
+	s := p.Service
+	env, err := serviceEnv(s)
+	if err != nil {
+		return err
+	}
+	if err = p.injectSecrets(env); err != nil {
+		return err
+	}
+	env["GPM_HOOK"] = kind
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Env = environ(env)
+	cmd.Dir = s.Dir
+	if s.SysProcAttr != nil {
+		injectSysProcAttr(cmd, s.SysProcAttr)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.WaitDelay = cmdWaitDelay
+
+	return cmd.Run()
+}
+
+func httpHook(ctx context.Context, hook *gpmv1.Hook, out io.Writer) error {
+	method := strings.ToUpper(hook.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, hook.Url, nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(rsp.Body, 4096))
+	fmt.Fprintf(out, "%s %s: %s\n", method, hook.Url, rsp.Status)
+	if len(body) > 0 {
+		fmt.Fprintf(out, "%s\n", strings.TrimRight(string(body), "\n"))
+	}
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s %s: status %d", method, hook.Url, rsp.StatusCode)
+	}
+	return nil
+}
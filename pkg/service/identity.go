@@ -0,0 +1,83 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	proc "github.com/shirou/gopsutil/process"
+)
+
+const (
+	// 比较进程创建时间时允许的误差 (毫秒)
+	createTimeTolerance = 1000
+)
+
+// identify 读取进程的创建时间 (毫秒) 和可执行文件路径, 无法读取时返回零值
+func identify(pid int) (int64, string) {
+	pr, err := proc.NewProcess(int32(pid))
+	if err != nil {
+		return 0, ""
+	}
+	createTime, _ := pr.CreateTime()
+	exe, _ := pr.Exe()
+	return createTime, trimDeleted(exe)
+}
+
+// trimDeleted 去掉可执行文件被替换后路径中的 " (deleted)" 后缀
+func trimDeleted(exe string) string {
+	return strings.TrimSuffix(exe, " (deleted)")
+}
+
+// verifyIdentity 确认 pid 对应的进程是 gpmd 启动的服务进程, 防止接管系统重启后复用了相同 pid 的其他进程
+func verifyIdentity(pid int, createTime int64, exe string, start int64) error {
+	if exists, err := proc.PidExists(int32(pid)); err != nil || !exists {
+		return ErrProcessNotFound
+	}
+	actualTime, actualExe := identify(pid)
+	if actualTime == 0 {
+		return fmt.Errorf("can't read create time of process %d", pid)
+	}
+
+	if createTime == 0 {
+		// 旧版本没有保存进程信息, 进程不能晚于服务启动时间创建
+		if start > 0 && actualTime > start*1000+createTimeTolerance {
+			return fmt.Errorf("process created at %s after service started at %s",
+				time.UnixMilli(actualTime).Format(time.RFC3339), time.Unix(start, 0).Format(time.RFC3339))
+		}
+		return nil
+	}
+
+	diff := actualTime - createTime
+	if diff < -createTimeTolerance || diff > createTimeTolerance {
+		return fmt.Errorf("process created at %s, expected %s",
+			time.UnixMilli(actualTime).Format(time.RFC3339), time.UnixMilli(createTime).Format(time.RFC3339))
+	}
+	if exe != "" && actualExe != "" && actualExe != exe {
+		return fmt.Errorf("process executable is %s, expected %s", actualExe, exe)
+	}
+
+	return nil
+}
@@ -15,16 +15,26 @@ type GenerateManager interface {
 	Create(context.Context, *gpmv1.ServiceSpec) (*gpmv1.Service, error)
 	Edit(context.Context, string, *gpmv1.EditServiceSpec) (*gpmv1.Service, error)
 	Start(context.Context, string) (*gpmv1.Service, error)
-	Stop(context.Context, string) (*gpmv1.Service, error)
+	Stop(context.Context, string, bool) (*gpmv1.Service, error)
 	Restart(context.Context, string) (*gpmv1.Service, error)
 	Delete(context.Context, string) (*gpmv1.Service, error)
-	TailLog(context.Context, string, int64, bool, IOWriter) error
+	TailLog(context.Context, string, *gpmv1.LogOptions, IOWriter) error
+	TailLogs(context.Context, []string, string, bool, *gpmv1.LogOptions, IOWriter) error
 
 	Install(context.Context, IOStream) error
 	ListVersions(context.Context, string) ([]*gpmv1.ServiceVersion, error)
+	ListExits(context.Context, string) ([]*gpmv1.ServiceExit, error)
+	RunNow(context.Context, string) (*gpmv1.Service, error)
+	Signal(context.Context, string, string) (*gpmv1.Service, error)
+	Reload(context.Context, string) (*gpmv1.Service, error)
+	Pause(context.Context, string) (*gpmv1.Service, error)
+	Resume(context.Context, string) (*gpmv1.Service, error)
 	Upgrade(context.Context, IOStream) error
 	Rollback(context.Context, string, string) error
 	Forget(context.Context, string, string) error
+	CreateSecret(context.Context, *gpmv1.SecretSpec) (*gpmv1.Secret, error)
+	ListSecrets(context.Context) ([]*gpmv1.Secret, error)
+	DeleteSecret(context.Context, string) (*gpmv1.Secret, error)
 }
 
 type GenerateFTP interface {
@@ -0,0 +1,289 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	verrs "github.com/vine-io/vine/lib/errors"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+var (
+	ErrJobRunning = errors.New("last run is still running")
+)
+
+// validateJob 检查服务类型相关的参数
+func validateJob(kind, schedule, policy string, replicas int32) error {
+	if kind == gpmv1.ServiceCron {
+		if schedule == "" {
+			return errors.New("cron service requires a schedule")
+		}
+		if _, err := parseCron(schedule); err != nil {
+			return err
+		}
+	} else if schedule != "" {
+		return fmt.Errorf("schedule only supports cron service")
+	}
+	if policy != "" && kind != gpmv1.ServiceCron {
+		return fmt.Errorf("concurrency policy only supports cron service")
+	}
+	if replicas > 1 && kind != "" && kind != gpmv1.ServiceSimple {
+		return fmt.Errorf("%s service does not support replicas", kind)
+	}
+	return nil
+}
+
+// RunNow 立即运行一次 cron 或 oneshot 服务
+func (g *manager) RunNow(ctx context.Context, name string) (*gpmv1.Service, error) {
+	s, err := g.getService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g.RLock()
+	p := g.ps[s.Name]
+	g.RUnlock()
+
+	switch p.Type {
+	case gpmv1.ServiceCron:
+		if _, err = p.runJob(); err != nil {
+			if errors.Is(err, ErrJobRunning) {
+				return nil, verrs.Conflict(g.Name(), "service %s: %v", name, err)
+			}
+			return nil, verrs.InternalServerError(g.Name(), "run service %s: %v", name, err)
+		}
+		return p.Service, nil
+	case gpmv1.ServiceOneshot:
+		if p.child() != nil {
+			return nil, verrs.Conflict(g.Name(), "service %s is running", name)
+		}
+		return g.Start(ctx, name)
+	default:
+		return nil, verrs.BadRequest(g.Name(), "service %s is not a cron or oneshot service", name)
+	}
+}
+
+// active 判断服务是否在运行, cron 服务启动定时任务后即视为运行中
+func (p *Process) active() bool {
+	if p.Type == gpmv1.ServiceCron {
+		return p.Status == gpmv1.StatusRunning || p.child() != nil
+	}
+	return p.child() != nil
+}
+
+// startCron 启动 cron 服务的定时任务, gpmd 重启前运行中的进程继续由 cron 服务管理
+func (p *Process) startCron() (int32, error) {
+	sched, err := parseCron(p.Schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	done := make(chan struct{}, 1)
+	done <- struct{}{}
+	p.done = done
+	p.NextRunTimestamp = sched.next(time.Now()).Unix()
+
+	var pid int32
+	if c := p.child(); c != nil && !c.isExited() {
+		p.mu.Lock()
+		p.jobs = append(p.jobs, c)
+		p.mu.Unlock()
+		p.jobWg.Add(1)
+		go p.waitJob(c)
+		pid = int32(c.pid)
+	}
+
+	go p.scheduling(done, sched)
+	if p.Log != nil {
+		go p.rotating(done)
+	}
+
+	return pid, nil
+}
+
+// scheduling 按照 cron 表达式定时运行服务
+func (p *Process) scheduling(done chan struct{}, sched *cronSchedule) {
+	log.Infof("start service %s scheduling: %s", p.Name, p.Schedule)
+	for {
+		next := sched.next(time.Now())
+		if next.IsZero() {
+			log.Errorf("service %s schedule '%s' has no next run", p.Name, p.Schedule)
+			return
+		}
+		p.NextRunTimestamp = next.Unix()
+		p.update()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case _, ok := <-done:
+			timer.Stop()
+			if !ok {
+				p.NextRunTimestamp = 0
+				log.Infof("stop service %s scheduling", p.Name)
+				return
+			}
+		case <-timer.C:
+			if p.Status == gpmv1.StatusPaused {
+				log.Infof("service %s is paused, skip run", p.Name)
+				continue
+			}
+			if _, err := p.runJob(); err != nil {
+				log.Errorf("run service %s: %v", p.Name, err)
+			}
+		}
+	}
+}
+
+// runJob 运行一次 cron 服务, 根据并发策略处理上一次未结束的运行
+func (p *Process) runJob() (int32, error) {
+	p.mu.RLock()
+	jobs := append([]*child{}, p.jobs...)
+	p.mu.RUnlock()
+
+	if len(jobs) > 0 {
+		switch p.ConcurrencyPolicy {
+		case gpmv1.ConcurrencyForbid:
+			p.Msg = fmt.Sprintf("skip run at %s: %v", time.Now().Format(time.RFC3339), ErrJobRunning)
+			p.update()
+			return 0, ErrJobRunning
+		case gpmv1.ConcurrencyReplace:
+			for _, c := range jobs {
+				log.Infof("replace service %s run %d", p.Name, c.pid)
+				p.mu.Lock()
+				c.stopped, c.reason = gpmv1.ExitStop, "replaced by a new run"
+				p.mu.Unlock()
+				if err := p.terminate(c); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+
+	p.rotateLog()
+	pid, err := p.run()
+	if err != nil {
+		p.Msg = err.Error()
+		p.update()
+		return 0, err
+	}
+	c := p.child()
+
+	p.mu.Lock()
+	p.jobs = append(p.jobs, c)
+	p.mu.Unlock()
+
+	now := time.Now().Unix()
+	p.StartTimestamp = now
+	p.LastRunTimestamp = now
+	p.Msg = ""
+	p.update()
+	log.Infof("run service %s at pid: %d", p.Name, pid)
+
+	p.jobWg.Add(1)
+	go p.waitJob(c)
+	return pid, nil
+}
+
+// waitJob 等待 cron 服务的一次运行结束, 记录退出信息
+func (p *Process) waitJob(c *child) {
+	defer p.jobWg.Done()
+	<-c.exited
+
+	p.mu.Lock()
+	for i, job := range p.jobs {
+		if job == c {
+			p.jobs = append(p.jobs[:i], p.jobs[i+1:]...)
+			break
+		}
+	}
+	kind, reason := c.stopped, c.reason
+	running := len(p.jobs)
+	if p.c == c {
+		p.c = nil
+		if running > 0 {
+			p.c = p.jobs[running-1]
+		}
+	}
+	p.mu.Unlock()
+
+	message := exitReason(c.status)
+	if reason != "" {
+		message = reason
+	}
+	if kind == "" {
+		kind = p.exitKind(c, c.status)
+	}
+	p.recordExit(c, kind, message)
+	log.Infof("service %s(%d) run finished: %s", p.Name, c.pid, message)
+
+	p.LastExit = c.status
+	p.setPid(p.child())
+	if kind == gpmv1.ExitCrash || kind == gpmv1.ExitOOM {
+		p.Msg = fmt.Sprintf("last run failed: %s", message)
+	}
+	p.update()
+}
+
+// stopJobs 停止 cron 服务所有运行中的进程, 并等待退出记录保存
+func (p *Process) stopJobs(reason string) error {
+	return p.endJobs(reason, p.terminate)
+}
+
+// killJobs 强制结束 cron 服务所有运行中的进程
+func (p *Process) killJobs(reason string) error {
+	return p.endJobs(reason, func(c *child) error {
+		if c.isExited() {
+			return nil
+		}
+		if err := signalChild(c, syscall.SIGKILL, p.KillMode == gpmv1.KillModeGroup); err != nil && !c.isExited() {
+			return err
+		}
+		<-c.exited
+		return nil
+	})
+}
+
+func (p *Process) endJobs(reason string, end func(c *child) error) error {
+	p.mu.Lock()
+	jobs := append([]*child{}, p.jobs...)
+	for _, c := range jobs {
+		c.stopped = reason
+	}
+	p.mu.Unlock()
+
+	for _, c := range jobs {
+		if err := end(c); err != nil {
+			return err
+		}
+	}
+	p.jobWg.Wait()
+	p.NextRunTimestamp = 0
+	p.Health, p.HealthMsg = "", ""
+	return nil
+}
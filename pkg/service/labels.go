@@ -0,0 +1,123 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var labelRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_./]*[A-Za-z0-9])?$`)
+
+// validateLabels 检查服务标签, 标签名称不能为空, 名称和值只能包含字母、数字以及 -_./
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !labelRegexp.MatchString(key) {
+			return fmt.Errorf("invalid label key '%s'", key)
+		}
+		if value != "" && !labelRegexp.MatchString(value) {
+			return fmt.Errorf("invalid label %s value '%s'", key, value)
+		}
+	}
+	return nil
+}
+
+const (
+	selectorEquals    = "="
+	selectorNotEquals = "!="
+	selectorExists    = "exists"
+	selectorNotExists = "!exists"
+)
+
+type labelRequirement struct {
+	key   string
+	op    string
+	value string
+}
+
+// labelSelector 标签选择器, 服务需要满足所有的条件
+type labelSelector []labelRequirement
+
+// parseSelector 解析标签选择器, 多个条件使用逗号分隔,
+// 支持 key=value、key==value、key!=value、key (存在标签) 以及 !key (不存在标签)
+func parseSelector(text string) (labelSelector, error) {
+	selector := labelSelector{}
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		req := labelRequirement{}
+		switch {
+		case strings.Contains(item, "!="):
+			parts := strings.SplitN(item, "!=", 2)
+			req = labelRequirement{key: parts[0], op: selectorNotEquals, value: parts[1]}
+		case strings.Contains(item, "="):
+			parts := strings.SplitN(strings.Replace(item, "==", "=", 1), "=", 2)
+			req = labelRequirement{key: parts[0], op: selectorEquals, value: parts[1]}
+		case strings.HasPrefix(item, "!"):
+			req = labelRequirement{key: strings.TrimPrefix(item, "!"), op: selectorNotExists}
+		default:
+			req = labelRequirement{key: item, op: selectorExists}
+		}
+
+		req.key = strings.TrimSpace(req.key)
+		req.value = strings.TrimSpace(req.value)
+		if !labelRegexp.MatchString(req.key) {
+			return nil, fmt.Errorf("invalid selector '%s'", item)
+		}
+		if req.value != "" && !labelRegexp.MatchString(req.value) {
+			return nil, fmt.Errorf("invalid selector '%s'", item)
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+// matches 判断标签是否满足选择器的所有条件
+func (s labelSelector) matches(labels map[string]string) bool {
+	for _, req := range s {
+		value, ok := labels[req.key]
+		switch req.op {
+		case selectorEquals:
+			if !ok || value != req.value {
+				return false
+			}
+		case selectorNotEquals:
+			if ok && value == req.value {
+				return false
+			}
+		case selectorExists:
+			if !ok {
+				return false
+			}
+		case selectorNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
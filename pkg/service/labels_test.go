@@ -0,0 +1,101 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    labelSelector
+		wantErr bool
+	}{
+		{text: "", want: labelSelector{}},
+		{text: "app=web", want: labelSelector{{key: "app", op: selectorEquals, value: "web"}}},
+		{text: "app==web", want: labelSelector{{key: "app", op: selectorEquals, value: "web"}}},
+		{text: "env!=dev", want: labelSelector{{key: "env", op: selectorNotEquals, value: "dev"}}},
+		{text: "canary", want: labelSelector{{key: "canary", op: selectorExists}}},
+		{text: "!canary", want: labelSelector{{key: "canary", op: selectorNotExists}}},
+		{
+			text: " app = web , tier!=frontend,!canary, ",
+			want: labelSelector{
+				{key: "app", op: selectorEquals, value: "web"},
+				{key: "tier", op: selectorNotEquals, value: "frontend"},
+				{key: "canary", op: selectorNotExists},
+			},
+		},
+		{text: "example.com/team=a_b", want: labelSelector{{key: "example.com/team", op: selectorEquals, value: "a_b"}}},
+		{text: "=web", wantErr: true},
+		{text: "!=web", wantErr: true},
+		{text: "!", wantErr: true},
+		{text: "app=we b", wantErr: true},
+		{text: "app===web", wantErr: true},
+		{text: "-app", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseSelector(tt.text)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSelector(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSelector(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"app": "web", "env": "prod", "canary": ""}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"app=web", true},
+		{"app=api", false},
+		{"env!=dev", true},
+		{"env!=prod", false},
+		{"tier!=frontend", true},
+		{"canary", true},
+		{"tier", false},
+		{"!tier", true},
+		{"!canary", false},
+		{"app=web,env!=dev,!tier", true},
+		{"app=web,tier", false},
+	}
+
+	for _, tt := range tests {
+		s, err := parseSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("parseSelector(%q): %v", tt.selector, err)
+		}
+		if got := s.matches(labels); got != tt.want {
+			t.Errorf("%q matches %v = %v, want %v", tt.selector, labels, got, tt.want)
+		}
+	}
+}
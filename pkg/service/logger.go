@@ -0,0 +1,507 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// logWriter 写入服务实例的日志文件, 超过大小上限或者时间间隔后切分日志.
+// 日志只在行的边界切分, 切分时先重命名当前文件再创建新文件, 不会丢失或者重复写入日志
+type logWriter struct {
+	mu sync.Mutex
+	// service 服务名称, name 日志文件路径
+	service string
+	name    string
+	f       *os.File
+	// size 当前文件的大小, opened 当前文件的创建时间
+	size   int64
+	opened time.Time
+	// lineStart 当前文件为空或者以换行符结尾
+	lineStart bool
+
+	maxSize  int64
+	interval time.Duration
+	timer    *time.Timer
+	// param 清理归档日志的参数
+	param *gpmv1.ProcLog
+}
+
+func newLogWriter(service, name string) *logWriter {
+	return &logWriter{service: service, name: name, lineStart: true}
+}
+
+// setLimit 设置切分和清理日志的参数, 大小上限和时间间隔为 0 时不切分
+func (w *logWriter) setLimit(param *gpmv1.ProcLog) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var maxSize int64
+	var interval time.Duration
+	if param != nil {
+		maxSize = param.MaxSize
+		interval = time.Duration(param.Interval) * time.Second
+	}
+	w.param = param
+	w.maxSize = maxSize
+	if w.interval == interval {
+		return
+	}
+	w.interval = interval
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	if interval > 0 {
+		w.timer = time.AfterFunc(interval, w.tick)
+	}
+}
+
+// tick 按时间切分日志, 当前行还未结束时由下一次写入在行尾切分
+func (w *logWriter) tick() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.interval <= 0 {
+		return
+	}
+	if w.lineStart && w.due(time.Now()) {
+		w.rotate()
+	}
+	next := w.interval
+	if w.f != nil {
+		next = w.interval - time.Since(w.opened)
+	}
+	if next <= 0 {
+		next = time.Second
+	}
+	w.timer = time.AfterFunc(next, w.tick)
+}
+
+// due 判断当前文件是否需要切分
+func (w *logWriter) due(now time.Time) bool {
+	if w.f == nil || w.size == 0 {
+		return false
+	}
+	return (w.maxSize > 0 && w.size >= w.maxSize) || (w.interval > 0 && now.Sub(w.opened) >= w.interval)
+}
+
+func (w *logWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n := 0
+	for len(b) > 0 {
+		if w.f == nil {
+			if err := w.open(); err != nil {
+				return n, err
+			}
+		}
+		now := time.Now()
+		if w.lineStart && w.due(now) {
+			w.rotate()
+			continue
+		}
+
+		chunk := b
+		if w.due(now) {
+			// 当前行写完后切分
+			if i := bytes.IndexByte(b, '\n'); i >= 0 {
+				chunk = b[:i+1]
+			}
+		} else if w.maxSize > 0 && w.size+int64(len(b)) > w.maxSize {
+			// 写入到超过大小上限后的第一个换行符, 剩余的内容写入新的文件
+			start := w.maxSize - w.size - 1
+			if start < 0 {
+				start = 0
+			}
+			if i := bytes.IndexByte(b[start:], '\n'); i >= 0 {
+				chunk = b[:int(start)+i+1]
+			}
+		}
+
+		m, err := w.f.Write(chunk)
+		n += m
+		w.size += int64(m)
+		if m > 0 {
+			w.lineStart = chunk[m-1] == '\n'
+		}
+		if err != nil {
+			return n, err
+		}
+		b = b[m:]
+	}
+	return n, nil
+}
+
+// Rotate 立即切分日志, 启动服务进程前归档上一次的日志
+func (w *logWriter) Rotate() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.f == nil {
+		if err := w.open(); err != nil {
+			log.Errorf("open log %s: %v", w.name, err)
+			return
+		}
+	}
+	w.rotate()
+}
+
+func (w *logWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	w.interval = 0
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
+
+func (w *logWriter) open() error {
+	_ = os.MkdirAll(filepath.Dir(w.name), os.ModePerm)
+	f, err := os.OpenFile(w.name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	w.f = f
+	w.size = stat.Size()
+	w.opened = time.Now()
+	w.lineStart = true
+	return nil
+}
+
+// rotate 将当前文件重命名为归档文件, 并创建新的文件
+func (w *logWriter) rotate() {
+	if w.f != nil && w.size == 0 {
+		return
+	}
+	if w.f != nil {
+		_ = w.f.Close()
+		w.f = nil
+	}
+
+	archive := archiveName(w.name, time.Now())
+	rerr := os.Rename(w.name, archive)
+	if rerr != nil && !os.IsNotExist(rerr) {
+		log.Errorf("rotate log %s: %v", w.name, rerr)
+	}
+	if err := w.open(); err != nil {
+		log.Errorf("open log %s: %v", w.name, err)
+		return
+	}
+	if rerr != nil {
+		// 重命名失败时继续写入原来的文件, 避免每次写入都尝试切分
+		w.size = 0
+		return
+	}
+	// 后台压缩归档日志并清理超出限制的日志
+	go cleanLogs(w.service, w.param)
+}
+
+// archiveName 返回归档日志的文件名 <name>-<时间>, 同一秒内多次切分时添加递增的序号
+func archiveName(name string, now time.Time) string {
+	archive := name + "-" + now.Format(timeFormat)
+	matches, _ := filepath.Glob(archive + "*")
+	if len(matches) == 0 {
+		return archive
+	}
+	seq := 0
+	for _, match := range matches {
+		suffix := strings.TrimPrefix(match, archive)
+		suffix = strings.TrimSuffix(strings.TrimSuffix(suffix, ".tmp"), ".gz")
+		if n, err := strconv.Atoi(strings.TrimPrefix(suffix, "-")); err == nil && n > seq {
+			seq = n
+		}
+	}
+	return fmt.Sprintf("%s-%d", archive, seq+1)
+}
+
+const (
+	logStdout = "stdout"
+	logStderr = "stderr"
+)
+
+// logRecord 日志文件中的一条记录, 格式为 "<时间> <stdout|stderr> <F|P> <内容>".
+// 时间为 RFC3339Nano 格式, P 表示记录只是一行的一部分, 需要与后续同一来源的记录拼接
+type logRecord struct {
+	t       time.Time
+	stream  string
+	partial bool
+	text    string
+}
+
+// parseLogRecord 解析日志记录, 不符合格式的行 (旧版本的日志) 作为标准输出的一行
+func parseLogRecord(line string) *logRecord {
+	parts := strings.SplitN(line, " ", 4)
+	if len(parts) == 4 && (parts[1] == logStdout || parts[1] == logStderr) && (parts[2] == "F" || parts[2] == "P") {
+		t, err := time.Parse(time.RFC3339Nano, parts[0])
+		if err == nil {
+			return &logRecord{t: t, stream: parts[1], partial: parts[2] == "P", text: parts[3]}
+		}
+	}
+	return &logRecord{stream: logStdout, text: line}
+}
+
+// logDecoder 将被拆分为多条记录的行重新拼接
+type logDecoder struct {
+	pending map[string]*logRecord
+}
+
+func newLogDecoder() *logDecoder {
+	return &logDecoder{pending: map[string]*logRecord{}}
+}
+
+// decode 解析一行日志记录, 行还不完整时返回 nil
+func (d *logDecoder) decode(line string) *logRecord {
+	rec := parseLogRecord(line)
+	if prev, ok := d.pending[rec.stream]; ok {
+		prev.text += rec.text
+		prev.partial = rec.partial
+		rec = prev
+	}
+	if rec.partial {
+		d.pending[rec.stream] = rec
+		return nil
+	}
+	delete(d.pending, rec.stream)
+	return rec
+}
+
+// flush 返回所有还不完整的行
+func (d *logDecoder) flush() []*logRecord {
+	records := make([]*logRecord, 0, len(d.pending))
+	for _, stream := range []string{logStdout, logStderr} {
+		if rec, ok := d.pending[stream]; ok {
+			records = append(records, rec)
+			delete(d.pending, stream)
+		}
+	}
+	return records
+}
+
+// decodeLogLines 解析多行日志记录
+func decodeLogLines(lines []string) []*logRecord {
+	d := newLogDecoder()
+	records := make([]*logRecord, 0, len(lines))
+	for _, line := range lines {
+		if rec := d.decode(line); rec != nil {
+			records = append(records, rec)
+		}
+	}
+	return append(records, d.flush()...)
+}
+
+// logStreamWriter 将服务进程一个输出流的内容转换为日志记录, 记录读取到内容的时间和来源
+type logStreamWriter struct {
+	w      *logWriter
+	stream string
+}
+
+func (sw *logStreamWriter) Write(b []byte) (int, error) {
+	prefix := time.Now().Format(time.RFC3339Nano) + " " + sw.stream + " "
+	buf := bytes.NewBuffer(make([]byte, 0, len(b)+64))
+	for data := b; len(data) > 0; {
+		tag, line := "P ", data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			tag, line = "F ", data[:i]
+			data = data[i+1:]
+		} else {
+			data = nil
+		}
+		buf.WriteString(prefix)
+		buf.WriteString(tag)
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	// 同一次写入的记录一起写入日志, 不会与其他输出流的记录交错
+	if _, err := sw.w.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+type logKey struct {
+	name  string
+	index int32
+}
+
+// logPipe 服务实例的日志管道, 由 gpmd 分别读取服务进程的标准输出和标准错误并写入日志文件
+type logPipe struct {
+	key    logKey
+	w      *logWriter
+	stdout *logStream
+	stderr *logStream
+}
+
+// logStream 服务进程的一个输出流
+type logStream struct {
+	key logKey
+	sw  *logStreamWriter
+
+	// path 管道文件的路径, r 为 gpmd 持有的读取端 (windows 下每个进程使用单独的匿名管道)
+	path string
+	r    *os.File
+
+	// mu 保证同时只有一个 sync, synced 在 drain 读取完管道中已有的输出后通知 sync
+	mu     sync.Mutex
+	synced chan struct{}
+}
+
+// logPipes 所有服务实例的日志管道, 修改服务或者重启 gpmd 后继续使用同一个管道
+var logPipes = struct {
+	sync.Mutex
+	m map[logKey]*logPipe
+}{m: map[logKey]*logPipe{}}
+
+// openLogPipe 返回服务实例的日志管道, 不存在时创建
+func openLogPipe(name string, index int32, param *gpmv1.ProcLog) (*logPipe, error) {
+	logPipes.Lock()
+	defer logPipes.Unlock()
+
+	key := logKey{name: name, index: index}
+	lp, ok := logPipes.m[key]
+	if !ok {
+		w := newLogWriter(name, filepath.Join(config.LoadRoot(), "logs", name, instanceLogName(name, index)))
+		lp = &logPipe{
+			key:    key,
+			w:      w,
+			stdout: &logStream{key: key, sw: &logStreamWriter{w: w, stream: logStdout}},
+			stderr: &logStream{key: key, sw: &logStreamWriter{w: w, stream: logStderr}},
+		}
+		if err := lp.stdout.open(); err != nil {
+			return nil, fmt.Errorf("open log pipe: %v", err)
+		}
+		if err := lp.stderr.open(); err != nil {
+			lp.stdout.close()
+			return nil, fmt.Errorf("open log pipe: %v", err)
+		}
+		logPipes.m[key] = lp
+	}
+	lp.w.setLimit(param)
+
+	return lp, nil
+}
+
+// output 返回服务进程的标准输出和标准错误
+func (lp *logPipe) output() (stdout, stderr *os.File, err error) {
+	stdout, err = lp.stdout.output()
+	if err != nil {
+		return nil, nil, err
+	}
+	stderr, err = lp.stderr.output()
+	if err != nil {
+		_ = stdout.Close()
+		return nil, nil, err
+	}
+	return stdout, stderr, nil
+}
+
+// closeLogPipes 关闭服务所有实例的日志管道
+func closeLogPipes(name string) {
+	logPipes.Lock()
+	defer logPipes.Unlock()
+
+	for key, lp := range logPipes.m {
+		if key.name != name {
+			continue
+		}
+		lp.stdout.close()
+		lp.stderr.close()
+		_ = lp.w.Close()
+		delete(logPipes.m, key)
+	}
+}
+
+// drain 读取管道中服务进程的输出写入日志, 直到管道被关闭
+func (s *logStream) drain(r *os.File) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			s.write(buf[:n])
+		}
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			// sync 中断了读取, 读取管道中剩余的输出后继续
+			s.flush(r, buf)
+			continue
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *logStream) write(b []byte) {
+	if _, err := s.sw.Write(b); err != nil {
+		log.Errorf("write service %s log: %v", s.key.name, err)
+	}
+}
+
+// syncLogPipe 等待服务实例日志管道中已经写入的输出写入日志文件, 用于进程退出后读取最后的日志
+func syncLogPipe(name string, index int32) {
+	logPipes.Lock()
+	lp, ok := logPipes.m[logKey{name: name, index: index}]
+	logPipes.Unlock()
+	if !ok {
+		return
+	}
+	lp.stdout.sync()
+	lp.stderr.sync()
+}
+
+// serviceLog 返回服务第一个实例的标准输出日志, 用于写入钩子等命令的输出
+func serviceLog(s *gpmv1.Service) *logStreamWriter {
+	lp, err := openLogPipe(s.Name, 0, s.Log)
+	if err != nil {
+		log.Errorf("open service %s log: %v", s.Name, err)
+		return nil
+	}
+	return lp.stdout.sw
+}
@@ -0,0 +1,248 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// logSegment 服务的日志文件, 包括正在写入的日志和归档日志
+type logSegment struct {
+	path string
+	// index 实例序号
+	index int32
+	// t 归档时间, seq 同一秒内切分的序号. 正在写入的日志 t 为零值
+	t    time.Time
+	seq  int
+	gz   bool
+	size int64
+}
+
+func (s *logSegment) archived() bool {
+	return !s.t.IsZero()
+}
+
+// open 打开日志文件, 压缩的日志返回解压后的内容. 列出日志文件后归档日志可能已经被压缩, 这时打开压缩后的文件
+func (s *logSegment) open() (io.ReadCloser, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) && s.archived() && !s.gz {
+		s.path += ".gz"
+		s.gz = true
+		f, err = os.Open(s.path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !s.gz {
+		return f, nil
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &gzipReadCloser{Reader: zr, f: f}, nil
+}
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (r *gzipReadCloser) Close() error {
+	_ = r.Reader.Close()
+	return r.f.Close()
+}
+
+// logSegments 返回服务所有的日志文件, 归档日志按照时间从早到晚排序, 正在写入的日志排在最后
+func logSegments(name string) ([]*logSegment, error) {
+	root := filepath.Join(config.LoadRoot(), "logs", name)
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// <name>[.<序号>].log[-<时间>[-<序号>][.gz]]
+	re := regexp.MustCompile(`^` + regexp.QuoteMeta(name) + `(?:\.(\d+))?\.log(?:-(\d{14})(?:-(\d+))?(\.gz)?)?$`)
+	segments := make([]*logSegment, 0)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		m := re.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		seg := &logSegment{path: filepath.Join(root, entry.Name()), size: info.Size(), gz: m[4] != ""}
+		if m[1] != "" {
+			index, _ := strconv.ParseInt(m[1], 10, 32)
+			seg.index = int32(index)
+		}
+		if m[2] != "" {
+			seg.t, err = time.ParseInLocation(timeFormat, m[2], time.Local)
+			if err != nil {
+				continue
+			}
+			seg.seq, _ = strconv.Atoi(m[3])
+		}
+		segments = append(segments, seg)
+	}
+
+	sort.SliceStable(segments, func(i, j int) bool {
+		a, b := segments[i], segments[j]
+		if a.archived() != b.archived() {
+			return a.archived()
+		}
+		if !a.t.Equal(b.t) {
+			return a.t.Before(b.t)
+		}
+		if a.seq != b.seq {
+			return a.seq < b.seq
+		}
+		return a.index < b.index
+	})
+
+	return segments, nil
+}
+
+// logCleaning 同一时间只执行一次日志清理
+var logCleaning sync.Mutex
+
+// cleanLogs 压缩服务的归档日志, 并删除过期或者超出数量、总大小限制的最早的归档日志
+func cleanLogs(name string, param *gpmv1.ProcLog) {
+	logCleaning.Lock()
+	defer logCleaning.Unlock()
+
+	// 删除上一次压缩中断时留下的临时文件
+	root := filepath.Join(config.LoadRoot(), "logs", name)
+	tmps, _ := filepath.Glob(filepath.Join(root, name+"*.gz.tmp"))
+	for _, tmp := range tmps {
+		_ = os.Remove(tmp)
+	}
+
+	segments, err := logSegments(name)
+	if err != nil {
+		return
+	}
+
+	var total int64
+	files := 0
+	for _, seg := range segments {
+		if seg.archived() && !seg.gz {
+			if err = compressLog(seg.path); err != nil {
+				log.Errorf("compress log %s: %v", seg.path, err)
+			} else {
+				seg.path += ".gz"
+				seg.gz = true
+				if stat, _ := os.Stat(seg.path); stat != nil {
+					seg.size = stat.Size()
+				}
+			}
+		}
+		total += seg.size
+		if seg.archived() {
+			files += 1
+		}
+	}
+
+	if param == nil {
+		return
+	}
+
+	now := time.Now()
+	for _, seg := range segments {
+		if !seg.archived() {
+			break
+		}
+		expired := param.Expire > 0 && now.Sub(seg.t) > time.Duration(param.Expire)*24*time.Hour
+		overFiles := param.MaxFiles > 0 && files > int(param.MaxFiles)
+		overSize := param.MaxTotalSize > 0 && total > param.MaxTotalSize
+		if !expired && !overFiles && !overSize {
+			break
+		}
+
+		if err = os.Remove(seg.path); err != nil {
+			log.Errorf("remove log %s: %v", seg.path, err)
+			continue
+		}
+		files -= 1
+		total -= seg.size
+		log.Infof("remove log: %s", seg.path)
+	}
+}
+
+// compressLog 使用 gzip 压缩归档日志, 压缩完成后删除原文件
+func compressLog(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	stat, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".gz.tmp"
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, stat.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	zw.Name = filepath.Base(path)
+	zw.ModTime = stat.ModTime()
+	_, err = io.Copy(zw, src)
+	if err == nil {
+		err = zw.Close()
+	}
+	if e := dst.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmp, path+".gz")
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	_ = os.Chtimes(path+".gz", stat.ModTime(), stat.ModTime())
+	return os.Remove(path)
+}
@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !windows
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/vine-io/gpm/pkg/internal/config"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+const (
+	// logSyncTimeout 等待读取管道中剩余输出的超时时间
+	logSyncTimeout = time.Second
+	// logFlushWait 管道中超过这个时间没有输出时认为已经读取完
+	logFlushWait = time.Millisecond * 10
+)
+
+// open 创建服务实例输出流的命名管道, 并开始读取管道中的输出. 管道在 gpmd 重启后继续使用
+func (s *logStream) open() error {
+	s.path = filepath.Join(config.LoadRoot(), "services", s.key.name, fmt.Sprintf("%d.%s.pipe", s.key.index, s.sw.stream))
+	stat, err := os.Lstat(s.path)
+	if err == nil && stat.Mode()&os.ModeNamedPipe == 0 {
+		_ = os.Remove(s.path)
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		_ = os.MkdirAll(filepath.Dir(s.path), os.ModePerm)
+		if err = syscall.Mkfifo(s.path, 0600); err != nil {
+			return err
+		}
+	}
+
+	// 以读写方式打开, 服务进程全部退出后读取不会返回 EOF
+	r, err := os.OpenFile(s.path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	s.r = r
+	s.synced = make(chan struct{}, 1)
+	go s.drain(r)
+
+	return nil
+}
+
+// output 返回服务进程写入的一端. 服务进程同样以读写方式打开管道,
+// gpmd 重启期间写入不会因为 SIGPIPE 失败, 输出暂存在管道中, 管道写满后阻塞直到 gpmd 重新读取
+func (s *logStream) output() (*os.File, error) {
+	return os.OpenFile(s.path, os.O_RDWR, 0)
+}
+
+// sync 中断 drain 的读取, 等待 drain 将管道中已有的输出写入日志
+func (s *logStream) sync() {
+	if s.r == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.synced:
+	default:
+	}
+	if err := s.r.SetReadDeadline(time.Unix(1, 0)); err != nil {
+		return
+	}
+	select {
+	case <-s.synced:
+	case <-time.After(logSyncTimeout * 2):
+		log.Warnf("sync service %s log pipe %s timeout", s.key.name, s.path)
+	}
+}
+
+// flush 读取管道中当前所有的输出, 管道中暂时没有输出时完成并通知 sync
+func (s *logStream) flush(r *os.File, buf []byte) {
+	end := time.Now().Add(logSyncTimeout)
+	for time.Now().Before(end) {
+		_ = r.SetReadDeadline(time.Now().Add(logFlushWait))
+		n, err := r.Read(buf)
+		if n > 0 {
+			s.write(buf[:n])
+		}
+		if err != nil {
+			break
+		}
+	}
+	_ = r.SetReadDeadline(time.Time{})
+
+	select {
+	case s.synced <- struct{}{}:
+	default:
+	}
+}
+
+func (s *logStream) close() {
+	if s.r != nil {
+		_ = s.r.Close()
+	}
+	_ = os.Remove(s.path)
+}
@@ -0,0 +1,69 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"os"
+	"time"
+)
+
+func (s *logStream) open() error {
+	return nil
+}
+
+// output 为服务进程创建匿名管道, 进程退出后停止读取
+func (s *logStream) output() (*os.File, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	drained := make(chan struct{})
+	s.mu.Lock()
+	s.synced = drained
+	s.mu.Unlock()
+	go func() {
+		s.drain(r)
+		_ = r.Close()
+		close(drained)
+	}()
+	return w, nil
+}
+
+// sync 等待最近一次启动的进程的输出读取完成, 进程退出后匿名管道返回 EOF
+func (s *logStream) sync() {
+	s.mu.Lock()
+	drained := s.synced
+	s.mu.Unlock()
+	if drained == nil {
+		return
+	}
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+	}
+}
+
+// flush 匿名管道不会设置读取超时
+func (s *logStream) flush(r *os.File, buf []byte) {}
+
+func (s *logStream) close() {}
@@ -0,0 +1,370 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hpcloud/tail"
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+)
+
+// logQuery 查询服务日志的条件
+type logQuery struct {
+	*gpmv1.LogOptions
+
+	since time.Time
+	until time.Time
+	re    *regexp.Regexp
+}
+
+func newLogQuery(opts *gpmv1.LogOptions) (*logQuery, error) {
+	if opts == nil {
+		opts = &gpmv1.LogOptions{}
+	}
+	if opts.Number < 0 {
+		return nil, fmt.Errorf("invalid number %d", opts.Number)
+	}
+
+	q := &logQuery{LogOptions: opts}
+	if opts.Since > 0 {
+		q.since = time.Unix(opts.Since, 0)
+	}
+	if opts.Until > 0 {
+		q.until = time.Unix(opts.Until, 0)
+	}
+	if opts.Since > 0 && opts.Until > 0 && opts.Until < opts.Since {
+		return nil, fmt.Errorf("until is before since")
+	}
+	if opts.Grep != "" {
+		re, err := regexp.Compile(opts.Grep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid grep: %v", err)
+		}
+		q.re = re
+	}
+	return q, nil
+}
+
+// match 判断日志是否满足查询条件
+func (q *logQuery) match(rec *logRecord) bool {
+	if q.StderrOnly && rec.stream != logStderr {
+		return false
+	}
+	if q.Since > 0 || q.Until > 0 {
+		// 旧版本的日志没有记录写入时间
+		if rec.t.IsZero() {
+			return false
+		}
+		sec := rec.t.Unix()
+		if (q.Since > 0 && sec < q.Since) || (q.Until > 0 && sec > q.Until) {
+			return false
+		}
+	}
+	if q.re != nil && !q.re.MatchString(rec.text) {
+		return false
+	}
+	return true
+}
+
+// after 判断日志是否在查询的时间范围之后
+func (q *logQuery) after(rec *logRecord) bool {
+	return q.Until > 0 && !rec.t.IsZero() && rec.t.Unix() > q.Until
+}
+
+// segments 返回服务实例中可能包含符合条件的日志文件, 按照写入的顺序排列
+func (q *logQuery) segments(name string, index int32) ([]*logSegment, error) {
+	all, err := logSegments(name)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*logSegment, 0)
+	// prev 上一个归档日志的时间, 当前文件中的日志都在这个时间之后写入
+	var prev time.Time
+	for _, seg := range all {
+		if seg.index != index {
+			continue
+		}
+		lower := prev
+		if seg.archived() {
+			prev = seg.t
+		}
+		// 文件名中的时间只精确到秒
+		if !q.since.IsZero() && seg.archived() && seg.t.Add(time.Second).Before(q.since) {
+			continue
+		}
+		if !q.until.IsZero() && !lower.IsZero() && lower.Add(-time.Second).After(q.until) {
+			break
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments, nil
+}
+
+// logPosition history 读取到的位置, follow 从这里继续读取新写入的日志
+type logPosition struct {
+	// f history 读取的正在写入的日志文件, 日志被切分后仍然可以读取其中剩余的日志, 为 nil 时表示没有正在写入的日志
+	f      *os.File
+	offset int64
+}
+
+func (pos *logPosition) close() {
+	if pos != nil && pos.f != nil {
+		_ = pos.f.Close()
+	}
+}
+
+// history 返回服务实例已经写入的符合条件的日志, 以及当前日志文件已读取的位置, 调用者需要关闭返回的位置.
+// 最后不完整的行保留在 d 中, flush 为 true 时同样返回
+func (q *logQuery) history(name string, index int32, d *logDecoder, flush bool) ([]*logRecord, *logPosition, error) {
+	segments, err := q.segments(name, index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := make([]*logRecord, 0)
+	add := func(rec *logRecord) {
+		if !q.match(rec) {
+			return
+		}
+		records = append(records, rec)
+		// 只保留最后 number 行
+		if q.Number > 0 && int64(len(records)) >= 2*q.Number {
+			records = append(records[:0:0], records[int64(len(records))-q.Number:]...)
+		}
+	}
+	scan := func(line string) {
+		if rec := d.decode(line); rec != nil {
+			add(rec)
+		}
+	}
+
+	pos := &logPosition{}
+	if q.Number == 0 {
+		for _, seg := range segments {
+			if err = q.read(seg, pos, scan); err != nil {
+				pos.close()
+				return nil, nil, err
+			}
+		}
+	} else {
+		// 从最新的日志文件开始向前读取, 每个文件只读取一次, 只读取到包含最后 number 行日志的文件
+		chunks := make([][]string, 0)
+		for i := len(segments) - 1; i >= 0; i-- {
+			lines := make([]string, 0)
+			if err = q.read(segments[i], pos, func(line string) { lines = append(lines, line) }); err != nil {
+				pos.close()
+				return nil, nil, err
+			}
+			chunks = append(chunks, lines)
+			if q.count(chunks) > q.Number {
+				break
+			}
+		}
+		for i := len(chunks) - 1; i >= 0; i-- {
+			for _, line := range chunks[i] {
+				scan(line)
+			}
+		}
+	}
+	if flush {
+		for _, rec := range d.flush() {
+			add(rec)
+		}
+	}
+
+	if q.Number > 0 && int64(len(records)) > q.Number {
+		records = records[int64(len(records))-q.Number:]
+	}
+	return records, pos, nil
+}
+
+// read 按顺序读取日志文件中的每一行, 正在写入的日志文件保持打开, 并记录读取的位置
+func (q *logQuery) read(seg *logSegment, pos *logPosition, fn func(line string)) error {
+	if seg.archived() {
+		_, err := scanLogSegment(seg, fn)
+		return err
+	}
+
+	f, err := os.Open(seg.path)
+	if err != nil {
+		return err
+	}
+	pos.close()
+	pos.f = f
+	pos.offset, err = scanLog(f, false, fn)
+	return err
+}
+
+// count 返回已经读取的日志中符合条件的行数, chunks 按照从新到旧的顺序排列.
+// 一行日志可能被拆分到两个文件中, 最早的文件开头可能是不完整的行, 调用者需要多读取一行保证结果完整
+func (q *logQuery) count(chunks [][]string) int64 {
+	var n int64
+	d := newLogDecoder()
+	for i := len(chunks) - 1; i >= 0; i-- {
+		for _, line := range chunks[i] {
+			if rec := d.decode(line); rec != nil && q.match(rec) {
+				n += 1
+			}
+		}
+	}
+	for _, rec := range d.flush() {
+		if q.match(rec) {
+			n += 1
+		}
+	}
+	return n
+}
+
+// following 判断是否需要继续监听新写入的日志
+func (q *logQuery) following() bool {
+	return q.Follow && (q.Until == 0 || time.Now().Unix() <= q.Until)
+}
+
+// follow 从 history 读取到的位置开始监听服务实例日志文件新写入的日志, 直到 ctx 结束或者超出查询的时间范围.
+// 期间日志被切分时先读取切分前文件中剩余的日志, 再从头读取新的文件
+func (q *logQuery) follow(ctx context.Context, name string, index int32, pos *logPosition, d *logDecoder, fn func(rec *logRecord, t time.Time)) error {
+	// handle 处理读取到的一行日志, 超出查询的时间范围时返回 false
+	handle := func(text string, t time.Time) bool {
+		rec := d.decode(text)
+		if rec == nil {
+			return true
+		}
+		if q.after(rec) {
+			return false
+		}
+		if q.match(rec) {
+			fn(rec, t)
+		}
+		return true
+	}
+
+	f := filepath.Join(config.LoadRoot(), "logs", name, instanceLogName(name, index))
+	offset := pos.offset
+	stat, _ := os.Stat(f)
+	if current, err := pos.f.Stat(); err == nil && (stat == nil || !os.SameFile(current, stat)) {
+		// history 读取后日志被切分
+		if _, err = pos.f.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		stop := false
+		now := time.Now()
+		if _, err = scanLog(pos.f, true, func(line string) {
+			if !stop {
+				stop = !handle(line, now)
+			}
+		}); err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+		offset = 0
+	}
+	if pos.f == nil || stat == nil || stat.Size() < offset {
+		offset = 0
+	}
+
+	t, err := tail.TailFile(f, tail.Config{
+		Location: &tail.SeekInfo{Offset: offset, Whence: io.SeekStart},
+		ReOpen:   true,
+		Follow:   true,
+		Poll:     true,
+	})
+	if err != nil {
+		return err
+	}
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-t.Lines:
+			if !ok {
+				return t.Err()
+			}
+			if line.Err != nil {
+				return line.Err
+			}
+			if !handle(line.Text, line.Time) {
+				return nil
+			}
+		}
+	}
+}
+
+// newServiceLog 返回服务实例日志记录对应的 ServiceLog, 旧版本的日志没有记录写入时间, 使用读取的时间 t
+func newServiceLog(name string, index int32, rec *logRecord, t time.Time) *gpmv1.ServiceLog {
+	if !rec.t.IsZero() {
+		t = rec.t
+	}
+	return &gpmv1.ServiceLog{Text: rec.text, Timestamp: t.Unix(), Stream: rec.stream, Service: name, Instance: index}
+}
+
+// scanLogSegment 按顺序读取日志文件中的每一行, 返回读取的完整行的字节数.
+// 正在写入的日志文件最后不完整的行不会被读取, 已经被清理的归档日志跳过
+func scanLogSegment(seg *logSegment, fn func(line string)) (int64, error) {
+	rc, err := seg.open()
+	if os.IsNotExist(err) && seg.archived() {
+		// 归档日志在读取前被清理
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	return scanLog(rc, seg.archived(), fn)
+}
+
+// scanLog 按顺序读取 r 中的每一行, complete 为 false 时最后不完整的行不会被读取
+func scanLog(r io.Reader, complete bool, fn func(line string)) (int64, error) {
+	br := bufio.NewReaderSize(r, 64*1024)
+	var n int64
+	for {
+		line, err := br.ReadString('\n')
+		if err == io.EOF {
+			if line != "" && complete {
+				fn(line)
+			}
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		n += int64(len(line))
+		fn(strings.TrimSuffix(line, "\n"))
+	}
+}
@@ -0,0 +1,221 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/config"
+	verrs "github.com/vine-io/vine/lib/errors"
+)
+
+// logMergeDelay 合并多个服务的日志时等待的时间, 避免读取日志的延迟打乱日志的顺序
+const logMergeDelay = time.Second
+
+// mergedLog 等待合并输出的日志
+type mergedLog struct {
+	name  string
+	index int32
+	rec   *logRecord
+	t     time.Time
+}
+
+func (m *mergedLog) serviceLog() *gpmv1.ServiceLog {
+	return newServiceLog(m.name, m.index, m.rec, m.t)
+}
+
+func (m *mergedLog) time() time.Time {
+	if !m.rec.t.IsZero() {
+		return m.rec.t
+	}
+	return m.t
+}
+
+func sortMergedLogs(logs []*mergedLog) {
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].time().Before(logs[j].time())
+	})
+}
+
+// selectServices 返回指定名称或者满足标签选择器的服务
+func (g *manager) selectServices(ctx context.Context, names []string, selector string, all bool) ([]*gpmv1.Service, error) {
+	if len(names) == 0 && selector == "" && !all {
+		return nil, verrs.BadRequest(g.Name(), "missing services, specify names, selector or all")
+	}
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+
+	services := make([]*gpmv1.Service, 0)
+	if len(names) > 0 {
+		seen := map[string]struct{}{}
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			s, err := g.getService(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			if sel.matches(s.Labels) {
+				services = append(services, s)
+			}
+		}
+		return services, nil
+	}
+
+	list, err := g.db.FindAllServices(ctx)
+	if err != nil {
+		return nil, verrs.InternalServerError(g.Name(), err.Error())
+	}
+	for _, s := range list {
+		if sel.matches(s.Labels) {
+			services = append(services, s)
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return services, nil
+}
+
+// TailLogs 同时读取多个服务所有实例的日志, 按照写入时间合并后返回
+func (g *manager) TailLogs(ctx context.Context, names []string, selector string, all bool, opts *gpmv1.LogOptions, sender IOWriter) error {
+	q, err := newLogQuery(opts)
+	if err != nil {
+		return verrs.BadRequest(g.Name(), err.Error())
+	}
+	services, err := g.selectServices(ctx, names, selector, all)
+	if err != nil {
+		return err
+	}
+
+	// source 一个服务实例的日志
+	type source struct {
+		name  string
+		index int32
+		d     *logDecoder
+		pos   *logPosition
+	}
+	sources := make([]*source, 0, len(services))
+	history := make([]*mergedLog, 0)
+	now := time.Now()
+	for _, s := range services {
+		for index := int32(0); index == 0 || index < s.Replicas; index++ {
+			// 还没有启动过的实例没有日志
+			if stat, _ := os.Stat(filepath.Join(config.LoadRoot(), "logs", s.Name, instanceLogName(s.Name, index))); stat == nil {
+				continue
+			}
+			src := &source{name: s.Name, index: index, d: newLogDecoder()}
+			records, pos, err := q.history(s.Name, index, src.d, !q.Follow)
+			if err != nil {
+				return verrs.InternalServerError(g.Name(), "read service '%s' log: %v", s.Name, err)
+			}
+			defer pos.close()
+			src.pos = pos
+			sources = append(sources, src)
+			for _, rec := range records {
+				history = append(history, &mergedLog{name: s.Name, index: index, rec: rec, t: now})
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return verrs.NotFound(g.Name(), "no service log matches")
+	}
+
+	sortMergedLogs(history)
+	if q.Number > 0 && int64(len(history)) > q.Number {
+		history = history[int64(len(history))-q.Number:]
+	}
+	for _, m := range history {
+		_ = sender.Send(m.serviceLog())
+	}
+	if !q.following() {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logs := make(chan *mergedLog, 128)
+	ech := make(chan error, len(sources))
+	for _, src := range sources {
+		go func(src *source) {
+			ech <- q.follow(ctx, src.name, src.index, src.pos, src.d, func(rec *logRecord, t time.Time) {
+				select {
+				case logs <- &mergedLog{name: src.name, index: src.index, rec: rec, t: t}:
+				case <-ctx.Done():
+				}
+			})
+		}(src)
+	}
+
+	// flush 按照时间顺序输出 deadline 之前写入的日志
+	pending := make([]*mergedLog, 0)
+	flush := func(deadline time.Time) {
+		sortMergedLogs(pending)
+		i := 0
+		for ; i < len(pending) && !pending[i].time().After(deadline); i++ {
+			_ = sender.Send(pending[i].serviceLog())
+		}
+		pending = append(pending[:0:0], pending[i:]...)
+	}
+
+	ticker := time.NewTicker(logMergeDelay / 4)
+	defer ticker.Stop()
+	running := len(sources)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case m := <-logs:
+			pending = append(pending, m)
+		case <-ticker.C:
+			flush(time.Now().Add(-logMergeDelay))
+		case err = <-ech:
+			if err != nil {
+				return verrs.InternalServerError(g.Name(), "read service log: %v", err)
+			}
+			running -= 1
+			if running > 0 {
+				continue
+			}
+			// 所有服务的日志都已超出查询的时间范围
+			for len(logs) > 0 {
+				pending = append(pending, <-logs)
+			}
+			sortMergedLogs(pending)
+			for _, m := range pending {
+				_ = sender.Send(m.serviceLog())
+			}
+			return nil
+		}
+	}
+}
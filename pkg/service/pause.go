@@ -0,0 +1,158 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	verrs "github.com/vine-io/vine/lib/errors"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+func (g *manager) Pause(ctx context.Context, name string) (*gpmv1.Service, error) {
+	s, err := g.getService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g.RLock()
+	p := g.ps[s.Name]
+	g.RUnlock()
+
+	if p.Status != gpmv1.StatusRunning {
+		return nil, verrs.Conflict(g.Name(), "service %s is %s", name, p.Status)
+	}
+
+	paused := make([]*Process, 0)
+	for _, r := range p.instances() {
+		if r.Status != gpmv1.StatusRunning {
+			continue
+		}
+		if err = r.pause(); err != nil {
+			// 恢复已经暂停的实例
+			for _, item := range paused {
+				_ = item.resume()
+			}
+			return nil, verrs.InternalServerError(g.Name(), "pause service %s: %v", name, err)
+		}
+		paused = append(paused, r)
+	}
+	for _, r := range paused {
+		r.Status = gpmv1.StatusPaused
+		r.update()
+	}
+
+	return p.Service, nil
+}
+
+func (g *manager) Resume(ctx context.Context, name string) (*gpmv1.Service, error) {
+	s, err := g.getService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g.RLock()
+	p := g.ps[s.Name]
+	g.RUnlock()
+
+	if p.Status != gpmv1.StatusPaused {
+		return nil, verrs.Conflict(g.Name(), "service %s is %s", name, p.Status)
+	}
+	if err = g.resumeInstances(p); err != nil {
+		return nil, verrs.InternalServerError(g.Name(), "resume service %s: %v", name, err)
+	}
+
+	return p.Service, nil
+}
+
+// resumeInstances 恢复服务所有暂停的实例
+func (g *manager) resumeInstances(p *Process) error {
+	for _, r := range p.instances() {
+		if r.Status != gpmv1.StatusPaused {
+			continue
+		}
+		if err := r.resume(); err != nil {
+			return err
+		}
+		r.Status = gpmv1.StatusRunning
+		r.update()
+	}
+	return nil
+}
+
+// pause 暂停实例的所有进程, 优先使用 cgroup freezer, 不支持时向进程组发送 SIGSTOP
+func (p *Process) pause() error {
+	children := p.ownChildren()
+	if len(children) == 0 && p.Type != gpmv1.ServiceCron {
+		return ErrProcessNotFound
+	}
+	if len(children) == 0 {
+		return nil
+	}
+
+	err := freezeCgroup(p.Name, p.index, true)
+	if err == nil {
+		log.Infof("freeze service %s instance %d", p.Name, p.index)
+		return nil
+	}
+	log.Infof("freeze service %s instance %d: %v, send SIGSTOP instead", p.Name, p.index, err)
+
+	for _, c := range children {
+		if err = pauseChild(c); err != nil && !c.isExited() {
+			return err
+		}
+	}
+	return nil
+}
+
+// resume 恢复实例的所有进程, 同时解冻 cgroup 并发送 SIGCONT
+func (p *Process) resume() error {
+	if err := freezeCgroup(p.Name, p.index, false); err == nil {
+		log.Infof("thaw service %s instance %d", p.Name, p.index)
+	}
+
+	for _, c := range p.ownChildren() {
+		if err := resumeChild(c); err != nil && !c.isExited() {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownChildren 返回实例自身运行中的进程, cron 服务包括同时运行的所有进程
+func (p *Process) ownChildren() []*child {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	children := make([]*child, 0)
+	if p.c != nil && !p.c.isExited() {
+		children = append(children, p.c)
+	}
+	for _, c := range p.jobs {
+		if c != p.c && !c.isExited() {
+			children = append(children, c)
+		}
+	}
+	return children
+}
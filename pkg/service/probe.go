@@ -0,0 +1,235 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+// validateProbe 检查探测参数, 并设置默认值
+func validateProbe(probe *gpmv1.Probe, prefix string) error {
+	if err := probe.ValidateE(prefix); err != nil {
+		return err
+	}
+	switch {
+	case probe.InitialDelay < 0:
+		return fmt.Errorf("field '%sinitialDelay' must not be negative", prefix)
+	case probe.Interval < 0:
+		return fmt.Errorf("field '%sinterval' must not be negative", prefix)
+	case probe.Timeout < 0:
+		return fmt.Errorf("field '%stimeout' must not be negative", prefix)
+	case probe.FailureThreshold < 0:
+		return fmt.Errorf("field '%sfailureThreshold' must not be negative", prefix)
+	}
+
+	switch probe.Type {
+	case gpmv1.ProbeHTTP:
+		if probe.Url == "" {
+			return fmt.Errorf("field '%surl' is required", prefix)
+		}
+	case gpmv1.ProbeTCP:
+		if probe.Address == "" {
+			return fmt.Errorf("field '%saddress' is required", prefix)
+		}
+	case gpmv1.ProbeExec:
+		if len(probe.Command) == 0 {
+			return fmt.Errorf("field '%scommand' is required", prefix)
+		}
+	}
+	return nil
+}
+
+// prober 记录单个探测的执行状态
+type prober struct {
+	probe *gpmv1.Probe
+	timer *time.Timer
+
+	failures int
+	// health 探测结果, 连续失败达到阈值前保持上一次的结果
+	health string
+	err    error
+}
+
+func newProber(probe *gpmv1.Probe) *prober {
+	if probe == nil {
+		return nil
+	}
+
+	return &prober{
+		probe:  probe,
+		timer:  time.NewTimer(time.Duration(probe.InitialDelay) * time.Second),
+		health: gpmv1.HealthUnknown,
+	}
+}
+
+// C 返回下一次探测的时间, prober 为 nil 时永远不会触发
+func (pr *prober) C() <-chan time.Time {
+	if pr == nil {
+		return nil
+	}
+	return pr.timer.C
+}
+
+func (pr *prober) stop() {
+	if pr != nil {
+		pr.timer.Stop()
+	}
+}
+
+// check 执行一次探测, 并安排下一次探测, pid 为服务进程 id
+func (pr *prober) check(p *Process, pid int) {
+	defer pr.timer.Reset(time.Duration(pr.probe.Interval) * time.Second)
+
+	timeout := time.Duration(pr.probe.Timeout) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	pr.err = runProbe(ctx, p, pr.probe, pid)
+	if pr.err == nil {
+		pr.failures = 0
+		pr.health = gpmv1.HealthHealthy
+		return
+	}
+
+	pr.failures += 1
+	if pr.failures >= int(pr.probe.FailureThreshold) {
+		pr.health = gpmv1.HealthUnhealthy
+	}
+}
+
+func (pr *prober) unhealthy() bool {
+	return pr != nil && pr.health == gpmv1.HealthUnhealthy
+}
+
+// mergeHealth 合并多个探测的结果, 任意探测失败时服务为 unhealthy
+func mergeHealth(probers ...*prober) (string, string) {
+	health := ""
+	msg := ""
+	for _, pr := range probers {
+		if pr == nil {
+			continue
+		}
+		switch {
+		case pr.health == gpmv1.HealthUnhealthy:
+			health = gpmv1.HealthUnhealthy
+		case pr.health == gpmv1.HealthUnknown && health != gpmv1.HealthUnhealthy:
+			health = gpmv1.HealthUnknown
+		case health == "":
+			health = gpmv1.HealthHealthy
+		}
+		if pr.err != nil {
+			msg = fmt.Sprintf("%s probe: %v", pr.probe.Type, pr.err)
+		}
+	}
+	return health, msg
+}
+
+func runProbe(ctx context.Context, p *Process, probe *gpmv1.Probe, pid int) error {
+	switch probe.Type {
+	case gpmv1.ProbeHTTP:
+		return httpProbe(ctx, probe)
+	case gpmv1.ProbeTCP:
+		return tcpProbe(ctx, probe)
+	case gpmv1.ProbeExec:
+		return execProbe(ctx, p, probe, pid)
+	default:
+		return fmt.Errorf("unknown probe type %s", probe.Type)
+	}
+}
+
+func httpProbe(ctx context.Context, probe *gpmv1.Probe) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.Url, nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = rsp.Body.Close()
+
+	if probe.ExpectStatus > 0 {
+		if rsp.StatusCode != int(probe.ExpectStatus) {
+			return fmt.Errorf("GET %s: status %d, expect %d", probe.Url, rsp.StatusCode, probe.ExpectStatus)
+		}
+		return nil
+	}
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GET %s: status %d", probe.Url, rsp.StatusCode)
+	}
+	return nil
+}
+
+func tcpProbe(ctx context.Context, probe *gpmv1.Probe) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", probe.Address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// execProbe 在服务目录下以服务的用户执行命令, 和 hook 一样注入服务引用的密钥, 环境变量 GPM_PID 为探测的服务进程 id
+func execProbe(ctx context.Context, p *Process, probe *gpmv1.Probe, pid int) error {
+	s := p.Service
+	env, err := serviceEnv(s)
+	if err != nil {
+		return err
+	}
+	if err = p.injectSecrets(env); err != nil {
+		return err
+	}
+	env["GPM_INSTANCE"] = strconv.Itoa(int(p.index))
+	env["GPM_PID"] = strconv.Itoa(pid)
+	cmd := exec.CommandContext(ctx, probe.Command[0], probe.Command[1:]...)
+	cmd.Env = environ(env)
+	cmd.Dir = s.Dir
+	if s.SysProcAttr != nil {
+		injectSysProcAttr(cmd, s.SysProcAttr)
+	}
+	cmd.WaitDelay = cmdWaitDelay
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if len(out) > 0 {
+			return fmt.Errorf("%v: %s", err, truncate(string(out), 256))
+		}
+		return err
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n] + "..."
+	}
+	return s
+}
@@ -26,12 +26,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -40,12 +40,18 @@ import (
 	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
 	"github.com/vine-io/gpm/pkg/internal/config"
 	"github.com/vine-io/gpm/pkg/internal/store"
-	"github.com/vine-io/pkg/unit"
 	log "github.com/vine-io/vine/lib/logger"
 )
 
 const timeFormat = "20060102150405"
 
+const (
+	// 默认停止服务的信号
+	defaultStopSignal = "SIGINT"
+	// 默认停止服务的超时时间
+	defaultStopTimeout = time.Second * 5
+)
+
 var (
 	ErrProcessNotFound = errors.New("process not found")
 )
@@ -53,68 +59,345 @@ var (
 type Process struct {
 	*gpmv1.Service
 
-	pr *proc.Process
+	// index 实例序号, 第一个实例的状态保存在服务中
+	index int32
+
+	mu sync.RWMutex
+	// c 当前运行的服务进程
+	c *child
+	// replicas 服务的其他实例, 只在第一个实例中保存
+	replicas []*Process
+	// jobs cron 服务运行中的进程
+	jobs []*child
+	// jobWg 等待 cron 服务的进程退出并记录
+	jobWg sync.WaitGroup
 
 	db *store.DB
 
 	done chan struct{}
 }
 
+// child 由 gpmd 启动或接管的服务进程
+type child struct {
+	pid   int
+	start time.Time
+	// pgid 进程组 id, forking 服务的守护进程可能不是进程组的主进程
+	pgid int
+	// createTime 进程创建时间 (毫秒), exe 进程的可执行文件路径, 用于 gpmd 重启后确认进程没有变化
+	createTime int64
+	exe        string
+
+	// cmd 为 nil 时表示 gpmd 重启后接管的进程, 无法通过 Wait 回收
+	cmd *exec.Cmd
+	pr  *os.Process
+
+	// exited 在进程退出后关闭
+	exited chan struct{}
+	// status 进程退出信息, exited 关闭后有效
+	status *gpmv1.ExitStatus
+	// reason 进程被 gpmd 结束的原因, 如存活探测失败
+	reason string
+	// kind 进程被 gpmd 结束时退出记录中的原因, 为空时根据退出信息判断
+	kind string
+	// oomKills 进程启动时 cgroup 中因内存不足结束进程的次数, 不支持 cgroup 时为 -1
+	oomKills int64
+	// stopped cron 服务进程被 gpmd 停止时的退出原因
+	stopped string
+}
+
 func NewProcess(in *gpmv1.Service, db *store.DB) *Process {
+	process := newInstance(in, db, 0)
+	for i := int32(1); i < in.Replicas; i++ {
+		process.replicas = append(process.replicas, newInstance(instanceService(in, i, db), db, i))
+	}
+
+	return process
+}
+
+func newInstance(in *gpmv1.Service, db *store.DB, index int32) *Process {
 	process := &Process{
 		Service: in,
+		index:   index,
 		db:      db,
 		done:    make(chan struct{}, 1),
 	}
 	if process.Pid != 0 {
-		p, err := proc.NewProcess(int32(process.Pid))
+		var c *child
+		err := verifyIdentity(int(process.Pid), process.PidCreateTime, process.PidExe, process.StartTimestamp)
+		if err == nil {
+			c, err = adoptChild(int(process.Pid), time.Unix(process.StartTimestamp, 0))
+		} else if !errors.Is(err, ErrProcessNotFound) {
+			log.Warnf("service %s instance %d skip adopting pid %d: %v", process.Name, index, process.Pid, err)
+		}
 		if err == nil {
-			process.pr = p
+			c.oomKills = cgroupOOMKills(process.Name, index)
+			process.c = c
+			// 继续读取 gpmd 重启前服务进程写入管道的输出
+			if _, err = openLogPipe(process.Name, index, process.Log); err != nil {
+				log.Errorf("open service %s log: %v", process.Name, err)
+			}
 		} else {
 			process.Pid = 0
+			process.PidCreateTime, process.PidExe = 0, ""
+			// 运行中的服务由 boot 重新启动
+			if process.Status != gpmv1.StatusRunning {
+				process.Status = gpmv1.StatusStopped
+			}
 		}
 	}
 
 	return process
 }
 
+// instanceService 复制服务的配置, 并读取实例保存的状态
+func instanceService(in *gpmv1.Service, index int32, db *store.DB) *gpmv1.Service {
+	s := &gpmv1.Service{}
+	in.DeepCopyInto(s)
+	s.Pid = 0
+	s.PidCreateTime = 0
+	s.PidExe = ""
+	s.StartTimestamp = 0
+	s.Status = gpmv1.StatusInit
+	s.Msg = ""
+	s.Health = ""
+	s.HealthMsg = ""
+	s.LastExit = nil
+	s.Stat = nil
+	s.Instances = nil
+	s.Restarts = 0
+
+	instance, _ := db.FindInstance(context.TODO(), in.Name, index)
+	if instance != nil {
+		s.Pid = instance.Pid
+		s.StartTimestamp = instance.StartTimestamp
+		s.Status = instance.Status
+		s.Msg = instance.Msg
+		s.LastExit = instance.LastExit
+		s.Restarts = instance.Restarts
+		s.PidCreateTime = instance.PidCreateTime
+		s.PidExe = instance.PidExe
+	}
+	return s
+}
+
+// instances 返回服务的所有实例
+func (p *Process) instances() []*Process {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]*Process{p}, p.replicas...)
+}
+
+// instance 返回实例的状态
+func (p *Process) instance() *gpmv1.Instance {
+	return &gpmv1.Instance{
+		Index:          p.index,
+		Pid:            p.Pid,
+		StartTimestamp: p.StartTimestamp,
+		Status:         p.Status,
+		Msg:            p.Msg,
+		Health:         p.Health,
+		HealthMsg:      p.HealthMsg,
+		LastExit:       p.LastExit,
+		Restarts:       p.Restarts,
+		PidCreateTime:  p.PidCreateTime,
+		PidExe:         p.PidExe,
+	}
+}
+
+// logName 返回实例的日志文件名称
+func (p *Process) logName() string {
+	return instanceLogName(p.Name, p.index)
+}
+
+func instanceLogName(name string, index int32) string {
+	if index == 0 {
+		return name + ".log"
+	}
+	return fmt.Sprintf("%s.%d.log", name, index)
+}
+
+// startChild 启动子进程, 并由单独的 goroutine 等待回收.
+// 通过 shim 启动时等待服务命令执行后再读取进程信息, 避免记录 shim 的可执行文件
+func startChild(cmd *exec.Cmd, sh *shim) (*child, error) {
+	if err := cmd.Start(); err != nil {
+		if sh != nil {
+			sh.close()
+		}
+		return nil, err
+	}
+	if sh != nil {
+		if err := sh.wait(); err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return nil, err
+		}
+	}
+
+	c := &child{
+		pid:    cmd.Process.Pid,
+		pgid:   cmd.Process.Pid,
+		start:  time.Now(),
+		cmd:    cmd,
+		pr:     cmd.Process,
+		exited: make(chan struct{}),
+	}
+	c.createTime, c.exe = identify(c.pid)
+	go c.wait()
+
+	return c, nil
+}
+
+// adoptChild 接管 gpmd 重启前启动的进程, 该进程不再是 gpmd 的子进程, 只能轮询其状态
+func adoptChild(pid int, start time.Time) (*child, error) {
+	exists, err := proc.PidExists(int32(pid))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrProcessNotFound
+	}
+	pr, err := os.FindProcess(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &child{
+		pid:    pid,
+		pgid:   processGroup(pid),
+		start:  start,
+		pr:     pr,
+		exited: make(chan struct{}),
+	}
+	c.createTime, c.exe = identify(pid)
+	go c.poll()
+
+	return c, nil
+}
+
+func (c *child) wait() {
+	_ = c.cmd.Wait()
+	c.exit(c.cmd.ProcessState)
+}
+
+func (c *child) poll() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		exists, err := proc.PidExists(int32(c.pid))
+		if err == nil && !exists {
+			c.exit(nil)
+			return
+		}
+	}
+}
+
+// exit 记录进程退出信息, state 为 nil 时表示无法获取退出码
+func (c *child) exit(state *os.ProcessState) {
+	status := &gpmv1.ExitStatus{
+		Pid:            int64(c.pid),
+		Code:           -1,
+		StartTimestamp: c.start.Unix(),
+		ExitTimestamp:  time.Now().Unix(),
+	}
+	if state != nil {
+		status.Code = int32(state.ExitCode())
+		if ws, ok := state.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+			status.Signal = signalName(ws.Signal())
+		}
+	}
+	c.status = status
+	close(c.exited)
+}
+
+// isExited 判断进程是否已经退出
+func (c *child) isExited() bool {
+	select {
+	case <-c.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *Process) child() *child {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.c
+}
+
+func (p *Process) setChild(c *child) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.c = c
+	p.setPid(c)
+}
+
+// setPid 保存进程 id 以及用于确认进程的信息
+func (p *Process) setPid(c *child) {
+	if c != nil {
+		p.Pid = int64(c.pid)
+		p.PidCreateTime, p.PidExe = c.createTime, c.exe
+	} else {
+		p.Pid = 0
+		p.PidCreateTime, p.PidExe = 0, ""
+	}
+}
+
 func (p *Process) Start() (int32, error) {
-	var pid int32
-	var err error
-	if p.pr == nil || p.pr.Pid == 0 {
-		pid, err = p.run()
+	if p.Type == gpmv1.ServiceCron {
+		return p.startCron()
+	}
+
+	if c := p.child(); c == nil || c.isExited() {
+		_, err := p.run()
 		if err != nil {
 			return 0, err
 		}
 		p.StartTimestamp = time.Now().Unix()
+		p.Restarts = 0
+		if p.Type == gpmv1.ServiceOneshot {
+			p.LastRunTimestamp = p.StartTimestamp
+		}
 	}
-	pid = int32(p.Pid)
+	c := p.child()
 
-	p.done = make(chan struct{}, 1)
-	p.done <- struct{}{}
+	done := make(chan struct{}, 1)
+	done <- struct{}{}
+	p.done = done
 
-	if p.AutoRestart > 0 {
-		go p.watching()
-	}
+	go p.watching(done, c)
 	if p.Log != nil {
-		go p.rotating()
+		go p.rotating(done)
 	}
 
-	return pid, nil
+	return int32(c.pid), nil
 }
 
 func (p *Process) run() (int32, error) {
-	log.Infof("process command: %s %s", p.Bin, strings.Join(p.Args, " "))
-	cmd := exec.Command(p.Bin, p.Args...)
+	c, err := p.spawn()
+	if err != nil {
+		return 0, err
+	}
 
-	cmd.Env = os.Environ()
-	if p.Env != nil {
-		env := make([]string, 0)
-		for k, v := range p.Env {
-			env = append(env, fmt.Sprintf("%s=%s", k, v))
-		}
-		cmd.Env = append(cmd.Env, env...)
+	p.setChild(c)
+	return int32(c.pid), nil
+}
+
+// spawn 启动服务进程, 不替换当前运行的进程
+func (p *Process) spawn() (*child, error) {
+	env, err := serviceEnv(p.Service)
+	if err != nil {
+		return nil, err
 	}
+	if err = p.injectSecrets(env); err != nil {
+		return nil, err
+	}
+	env["GPM_INSTANCE"] = strconv.Itoa(int(p.index))
+
+	// 参数中可能引用密钥, 只记录替换前的参数
+	log.Infof("process command: %s %s", p.Bin, strings.Join(p.Args, " "))
+	cmd := exec.Command(p.Bin, expandArgs(p.Args, env)...)
+	cmd.Env = environ(env)
 
 	if p.Dir != "" {
 		cmd.Dir = p.Dir
@@ -124,234 +407,443 @@ func (p *Process) run() (int32, error) {
 		injectSysProcAttr(cmd, p.SysProcAttr)
 	}
 
+	// 服务进程的输出通过 gpmd 持有的管道写入日志
+	lp, err := openLogPipe(p.Name, p.index, p.Log)
+	if err != nil {
+		return nil, err
+	}
+	stdout, stderr, err := lp.output()
+	if err != nil {
+		return nil, err
+	}
+	defer stdout.Close()
+	defer stderr.Close()
+
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	// 进程直接在实例的 cgroup 中创建, 启动阶段创建的子进程同样受到资源限制
+	resetCgroup(p.Name, p.index)
+	cgroup, release, err := joinCgroup(cmd, p.Name, p.index, p.Resources)
+	if err != nil {
+		// 无法设置资源限制时不运行服务
+		return nil, fmt.Errorf("set resource limits: %v", err)
+	}
+	defer release()
+
+	var sh *shim
+	if p.Type == gpmv1.ServiceForking {
+		// 删除上一次运行遗留的 pidFile
+		_ = os.Remove(p.pidFilePath())
+	}
+	listen := len(p.Sockets) > 0
+	if listen {
+		files, err := listenSockets(p.Name, p.Sockets)
+		if err != nil {
+			return nil, err
+		}
+		activateSockets(cmd, p.Sockets, files)
+	}
+	// LISTEN_PID, 资源限制和调度参数需要在执行服务命令前设置, 内核不支持 CgroupFD 时需要在执行前加入 cgroup,
+	// 通过 gpmd 执行服务命令
+	if listen || needShim(p.SysProcAttr) || cgroup != "" {
+		if sh, err = shimCommand(cmd, listen, p.SysProcAttr, cgroup); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := startChild(cmd, sh)
+	if err != nil {
+		return nil, err
+	}
+	c.oomKills = cgroupOOMKills(p.Name, p.index)
+
+	if p.Type == gpmv1.ServiceForking {
+		oomKills := c.oomKills
+		if c, err = p.waitPidFile(c); err != nil {
+			return nil, err
+		}
+		c.oomKills = oomKills
+	}
+
+	return c, nil
+}
+
+// rotateLog 链接服务的日志目录, 并在每次启动进程前归档上一次的日志
+func (p *Process) rotateLog() {
 	root := filepath.Join(config.LoadRoot(), "logs", p.Name)
 	target := filepath.Join(p.Dir, "logs")
 	_ = os.MkdirAll(target, os.ModePerm)
 	_ = os.Remove(root)
 	_ = os.Symlink(target, root)
 
-	flog := filepath.Join(root, p.Name+".log")
-	_ = os.Rename(flog, filepath.Join(root, fmt.Sprintf("%s.log-%s", p.Name, time.Now().Format(timeFormat))))
-
-	lw, err := os.OpenFile(flog, os.O_CREATE|os.O_RDWR|os.O_APPEND, os.ModePerm)
+	lp, err := openLogPipe(p.Name, p.index, p.Log)
 	if err != nil {
-		return 0, err
+		log.Errorf("open service %s log: %v", p.Name, err)
+		return
 	}
+	lp.w.Rotate()
+}
 
-	cmd.Stdout = lw
-	cmd.Stderr = lw
+func (p *Process) watching(done chan struct{}, c *child) {
+	log.Infof("start service %s(%d) watching", p.Name, c.pid)
+	bo := newBackoff(p.Service)
+	go p.probing(done, c)
+	go p.watchingStat(done, c)
+	for {
+		select {
+		case _, ok := <-done:
+			if !ok {
+				log.Infof("stop service %s(%d) watching", p.Name, c.pid)
+				return
+			}
+		case <-c.exited:
+			// 服务被主动停止, 由 Stop 记录退出信息
+			if isClosed(done) {
+				log.Infof("stop service %s(%d) watching", p.Name, c.pid)
+				return
+			}
+			log.Infof("service %s(%d) exited: %s", p.Name, c.pid, exitReason(c.status))
+			if !p.handleExit(bo, done, c) {
+				log.Infof("stop service %s watching", p.Name)
+				return
+			}
+			if c = p.child(); c == nil {
+				return
+			}
+			go p.probing(done, c)
+			go p.watchingStat(done, c)
+		}
+	}
+}
 
-	err = cmd.Start()
-	if err != nil {
-		return 0, err
+// handleExit 根据重启策略处理进程退出, 返回 false 时停止监听
+func (p *Process) handleExit(bo *backoff, done chan struct{}, c *child) bool {
+	status := c.status
+	reason := exitReason(status)
+	p.mu.RLock()
+	if c.reason != "" {
+		reason = c.reason
+		// 被 gpmd 结束的进程视为异常退出
+		status = nil
 	}
+	// 资源占用超过阈值被结束的进程不受重启策略限制
+	force := c.kind == gpmv1.ExitThreshold
+	p.mu.RUnlock()
+	uptime := time.Unix(c.status.ExitTimestamp, 0).Sub(c.start)
+	p.recordExit(c, p.exitKind(c, status), reason)
+	p.setChild(nil)
+	p.LastExit = c.status
+	p.Health, p.HealthMsg = "", ""
 
-	var pr *os.Process
 	for {
-		pr = cmd.Process
-		if pr != nil {
-			break
+		if !bo.shouldRestart(status) && !force {
+			if exitSuccess(status) {
+				p.Status = gpmv1.StatusStopped
+				p.Msg = ""
+			} else {
+				p.Status = gpmv1.StatusFailed
+				p.Msg = reason
+			}
+			log.Infof("service %s exited (%s), restart policy: %s", p.Name, reason, bo.policy)
+			p.update()
+			return false
 		}
-		time.Sleep(time.Millisecond * 300)
-	}
 
-	_ = lw.Close()
-	p.pr, _ = proc.NewProcess(int32(pr.Pid))
-	p.Pid = int64(pr.Pid)
-	return p.pr.Pid, nil
+		delay, ok := bo.next(time.Now(), uptime)
+		if !ok {
+			p.Status = gpmv1.StatusCrashLoop
+			p.Msg = fmt.Sprintf("restarted %d times within %v, last error: %s", bo.retries, bo.window, reason)
+			log.Errorf("service %s crashloop: %s", p.Name, p.Msg)
+			p.update()
+			return false
+		}
+
+		log.Infof("service %s exited (%s), restart after %v", p.Name, reason, delay)
+		p.Msg = fmt.Sprintf("%s, restart after %v", reason, delay)
+		p.update()
+		if !p.sleep(done, delay) {
+			return false
+		}
+
+		p.rotateLog()
+		pid, err := p.run()
+		if err != nil {
+			log.Errorf("restart service %s: %v", p.Name, err)
+			reason, status, uptime, force = err.Error(), nil, 0, false
+			continue
+		}
+
+		p.StartTimestamp = time.Now().Unix()
+		p.Status = gpmv1.StatusRunning
+		p.Msg = ""
+		p.Restarts += 1
+		p.update()
+		log.Infof("restart service %s at pid: %d", p.Name, pid)
+		return true
+	}
 }
 
-func (p *Process) watching() {
-	log.Infof("start service %s(%d) watching", p.Name, p.Pid)
-	timer := time.NewTicker(time.Second * 5)
-	defer timer.Stop()
+// probing 定时执行服务的健康探测, 存活探测失败时结束进程, 由 watching 根据重启策略处理
+func (p *Process) probing(done chan struct{}, c *child) {
+	liveness := newProber(p.LivenessProbe)
+	readiness := newProber(p.ReadinessProbe)
+	if liveness == nil && readiness == nil {
+		return
+	}
+	defer liveness.stop()
+	defer readiness.stop()
+
+	p.setHealth(c, gpmv1.HealthUnknown, "")
 	for {
 		select {
-		case _, ok := <-p.done:
+		case _, ok := <-done:
 			if !ok {
-				log.Infof("stop service %s(%d) watching", p.Name, p.Pid)
 				return
 			}
+		case <-c.exited:
+			return
+		case <-liveness.C():
+			// 暂停的进程无法响应探测, 不视为失败
+			if p.Status == gpmv1.StatusPaused {
+				continue
+			}
+			liveness.check(p, c.pid)
+		case <-readiness.C():
+			if p.Status == gpmv1.StatusPaused {
+				continue
+			}
+			readiness.check(p, c.pid)
+		}
+
+		health, msg := mergeHealth(liveness, readiness)
+		p.setHealth(c, health, msg)
+
+		if liveness.unhealthy() {
+			p.mu.Lock()
+			c.reason = fmt.Sprintf("liveness probe failed: %v", liveness.err)
+			p.mu.Unlock()
+			log.Errorf("service %s(%d) %s", p.Name, c.pid, c.reason)
+			if err := p.terminate(c); err != nil {
+				log.Errorf("stop service %s(%d): %v", p.Name, c.pid, err)
+			}
+			return
+		}
+	}
+}
+
+// setHealth 更新服务的健康状态, 状态变化时保存
+func (p *Process) setHealth(c *child, health, msg string) {
+	if c.isExited() || (p.Health == health && p.HealthMsg == msg) {
+		return
+	}
+	if p.Health != health {
+		log.Infof("service %s(%d) health: %s", p.Name, c.pid, health)
+	}
+	p.Health, p.HealthMsg = health, msg
+	p.update()
+}
+
+// sleep 等待指定的时间, 服务被停止时返回 false
+func (p *Process) sleep(done chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	for {
+		select {
 		case <-timer.C:
-			pr, err := proc.NewProcess(int32(p.Pid))
-			if err != nil {
-				pid, _ := p.run()
-				log.Infof("restart service(dead) %s at pid: %d", p.Name, pid)
-				p.db.UpdateService(context.TODO(), p.Service)
-			} else {
-				status, _ := pr.Status()
-				if status == "Z" {
-					log.Infof("watching service(pid=%d) %s status: %s", p.Pid, p.Name, status)
-					_ = p.kill()
-					pid, _ := p.run()
-					p.db.UpdateService(context.TODO(), p.Service)
-					log.Infof("restart service(Z) %s at pid: %d", p.Name, pid)
-				}
+			return true
+		case _, ok := <-done:
+			if !ok {
+				return false
 			}
 		}
 	}
 }
 
-func (p *Process) rotating() {
+// isClosed 判断 done 是否已经关闭
+func isClosed(done chan struct{}) bool {
+	select {
+	case _, ok := <-done:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (p *Process) update() {
+	p.UpdateTimestamp = time.Now().Unix()
+	if p.index != 0 {
+		if err := p.db.UpdateInstance(context.TODO(), p.Name, p.instance()); err != nil {
+			log.Errorf("update service %s instance %d: %v", p.Name, p.index, err)
+		}
+		return
+	}
+	if _, err := p.db.UpdateService(context.TODO(), p.Service); err != nil {
+		log.Errorf("update service %s: %v", p.Name, err)
+	}
+}
+
+func (p *Process) rotating(done chan struct{}) {
 	timer := time.NewTicker(time.Hour * 1)
 	log.Infof("start service %s(%d) rotating", p.Name, p.Pid)
 	defer timer.Stop()
 	for {
 		select {
-		case _, ok := <-p.done:
+		case _, ok := <-done:
 			if !ok {
 				log.Infof("stop service %s(%d) rotating", p.Name, p.Pid)
 				return
 			}
 		case <-timer.C:
-			now := time.Now()
-			param := p.Log
-			// 日志目录
-			root := filepath.Join(config.LoadRoot(), "logs", p.Name)
-			// 当前日志文件
-			plog := filepath.Join(root, p.Name+".log")
-
-			stat, _ := os.Stat(plog)
-			// 日志大小超过额定值，进行日志切分
-			if stat != nil && stat.Size() > param.MaxSize {
-				log.Infof("log %s greater than %s, rotates it", plog, unit.ConvAuto(param.MaxSize, 2))
-				err := rotate(plog, stat.Size(), param.MaxSize)
-				if err != nil {
-					log.Errorf("%v", err)
-				}
-			}
-
-			// 遍历服务所有日志文件
-			filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
-				if err != nil {
-					return err
-				}
-
-				if d.IsDir() && root != path {
-					return filepath.SkipDir
-				}
-
-				if !d.Type().IsRegular() {
-					return nil
-				}
-
-				name := d.Name()
-				parts := strings.Split(name, "-")
-				if len(parts) > 1 {
-					logT, _ := time.Parse(timeFormat, parts[1])
-					// 删除过期的日志文件
-					if now.Sub(logT).Hours() > float64(param.Expire*24) {
-						_ = os.Remove(path)
-						log.Infof("remove expired log: %s", path)
-					}
-				}
-
-				return nil
-			})
+			// 日志的切分由 logWriter 在写入时完成, 这里定时清理过期的日志
+			cleanLogs(p.Name, p.Log)
 		}
 	}
 }
 
 func (p *Process) Kill() error {
-	if p.pr == nil {
-		return ErrProcessNotFound
+	p.closeDone()
+	if p.Type == gpmv1.ServiceCron {
+		return p.killJobs(gpmv1.ExitStop)
 	}
 
-	go func() {
-		defer func() {
-			if e := recover(); e != nil {
-				log.Errorf("close %s channel", p.Name)
-			}
-		}()
-
-		close(p.done)
-	}()
+	c := p.child()
+	if c == nil {
+		return ErrProcessNotFound
+	}
 
-	return p.kill()
+	return p.kill(c, gpmv1.ExitStop)
 }
 
-func (p *Process) kill() error {
-	pr, err := os.FindProcess(int(p.pr.Pid))
-	if err != nil {
-		return err
+func (p *Process) kill(c *child, reason string) error {
+	group := p.KillMode == gpmv1.KillModeGroup
+	if c.isExited() && !(group && groupAlive(c.pgid)) {
+		p.reaped(c, reason)
+		return nil
 	}
-	err = pr.Kill()
-	if err != nil {
-		return err
-	}
-	_, err = pr.Wait()
-	if err != nil {
-		return err
-	}
-	if err = pr.Release(); err != nil {
+
+	if err := signalChild(c, syscall.SIGKILL, group); err != nil && !c.isExited() {
 		return err
 	}
-	p.Pid = 0
-	p.pr = nil
+	<-c.exited
+	p.reaped(c, reason)
 
 	return nil
 }
 
-func (p *Process) Stop() error {
-	if p.pr == nil {
+// Stop 停止服务进程, reason 为退出记录中的原因, 如 stop, upgrade
+func (p *Process) Stop(reason string) error {
+	p.closeDone()
+	if p.Type == gpmv1.ServiceCron {
+		return p.stopJobs(reason)
+	}
+
+	c := p.child()
+	if c == nil {
 		return ErrProcessNotFound
 	}
 
-	go func() {
-		defer func() {
-			if e := recover(); e != nil {
-				log.Errorf("close %s channel", p.Name)
-			}
-		}()
+	return p.stop(c, reason)
+}
 
-		close(p.done)
-	}()
+func (p *Process) stop(c *child, reason string) error {
+	if err := p.terminate(c); err != nil {
+		return err
+	}
+	p.reaped(c, reason)
 
-	return p.stop()
+	return nil
 }
 
-func (p *Process) stop() error {
-	pr, err := os.FindProcess(int(p.pr.Pid))
+// terminate 按照服务的停止方式结束进程, 并等待进程退出
+func (p *Process) terminate(c *child) error {
+	name := p.StopSignal
+	if name == "" {
+		name = defaultStopSignal
+	}
+	sig, err := parseSignal(name)
 	if err != nil {
 		return err
 	}
+	timeout := defaultStopTimeout
+	if p.StopTimeout > 0 {
+		timeout = time.Duration(p.StopTimeout) * time.Second
+	}
+	group := p.KillMode == gpmv1.KillModeGroup
 
-	if runtime.GOOS == "windows" {
-		err = pr.Kill()
-	} else {
-		err = pr.Signal(syscall.SIGINT)
+	if c.isExited() && !(group && groupAlive(c.pgid)) {
+		return nil
 	}
 
-	if err != nil {
+	log.Infof("stop service %s(%d) with %s", p.Name, c.pid, signalName(sig))
+	if err = signalChild(c, sig, group); err != nil && !c.isExited() {
 		return err
 	}
 
-	after := time.After(time.Second * 5)
-	done := make(chan struct{}, 1)
+	if !waitExited(c, group, timeout) {
+		log.Infof("service %s(%d) not exited after %v, kill it", p.Name, c.pid, timeout)
+		_ = signalChild(c, syscall.SIGKILL, group)
+		<-c.exited
+	}
 
-	go func(pp *os.Process) {
-		_, _ = pp.Wait()
-		done <- struct{}{}
-	}(pr)
+	return nil
+}
 
+// waitExited 等待进程退出, group 为 true 时同时等待进程组内所有进程退出, 超时返回 false
+func waitExited(c *child, group bool, timeout time.Duration) bool {
+	after := time.After(timeout)
 	select {
+	case <-c.exited:
 	case <-after:
-		_ = pr.Kill()
-		_, _ = pr.Wait()
-	case <-done:
+		return false
+	}
+	if !group {
+		return true
 	}
 
-	_ = pr.Release()
-	p.Pid = 0
-	p.pr = nil
+	ticker := time.NewTicker(time.Millisecond * 100)
+	defer ticker.Stop()
+	for groupAlive(c.pgid) {
+		select {
+		case <-ticker.C:
+		case <-after:
+			return false
+		}
+	}
+	return true
+}
 
-	return nil
+// reaped 记录被停止进程的退出信息
+func (p *Process) reaped(c *child, reason string) {
+	p.recordExit(c, reason, exitReason(c.status))
+	p.LastExit = c.status
+	p.Health, p.HealthMsg = "", ""
+	p.setChild(nil)
+}
+
+// closeDone 通知 watching 和 rotating 退出
+func (p *Process) closeDone() {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Errorf("close %s channel", p.Name)
+		}
+	}()
+
+	close(p.done)
 }
 
 func statProcess(s *gpmv1.Service) {
+	s.Stat = statInstance(s.Name, 0, s.Pid, s.StartTimestamp)
+}
+
+// statInstance 获取服务实例的资源占用, 优先读取实例所在的 cgroup
+func statInstance(name string, index int32, pid, start int64) *gpmv1.Stat {
 	var pr *proc.Process
-	if s.Pid > 0 {
-		pr, _ = proc.NewProcess(int32(s.Pid))
+	if pid > 0 {
+		if stat := statCgroup(name, index, start); stat != nil {
+			return stat
+		}
+		pr, _ = proc.NewProcess(int32(pid))
 	}
 	stat := &gpmv1.Stat{}
 	if pr != nil {
@@ -363,39 +855,5 @@ func statProcess(s *gpmv1.Service) {
 		}
 		stat.CpuPercent, _ = pr.CPUPercent()
 	}
-	s.Stat = stat
-}
-
-func rotate(rl string, total, size int64) error {
-	pf, e := os.OpenFile(rl, os.O_RDWR|os.O_SYNC, os.ModePerm)
-	if e != nil {
-		return fmt.Errorf("open log file %s: %v", rl, e)
-	}
-	defer pf.Close()
-
-	//_, e = pf.Seek(size, 0)
-	buf := make([]byte, size)
-	n, e := pf.Read(buf)
-	if e != nil {
-		return fmt.Errorf("open log file %s: %v", rl, e)
-	}
-
-	flog := rl + "-" + time.Now().Format(timeFormat)
-	e = os.WriteFile(flog, buf[:n], os.ModePerm)
-	if e != nil {
-		return fmt.Errorf("write log %s: %v", flog, e)
-	}
-
-	buf1 := make([]byte, total-size)
-	n, e = pf.Read(buf1)
-	if e != nil {
-		return fmt.Errorf("open log file %s: %v", rl, e)
-	}
-
-	e = os.WriteFile(rl, buf1[:n], os.ModePerm)
-	if e != nil {
-		return fmt.Errorf("rewrite log file %s: %v", rl, e)
-	}
-
-	return nil
+	return stat
 }
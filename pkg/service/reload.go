@@ -0,0 +1,184 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	verrs "github.com/vine-io/vine/lib/errors"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// validateReload 检查服务的重新加载方式, 信号和命令只能指定一个
+func validateReload(reload *gpmv1.Reload) error {
+	if reload == nil {
+		return nil
+	}
+	if err := reload.ValidateE("reload."); err != nil {
+		return err
+	}
+	if (reload.Signal == "") == (len(reload.Command) == 0) {
+		return fmt.Errorf("reload requires either a signal or a command")
+	}
+	if reload.Signal != "" {
+		if _, err := parseSignal(reload.Signal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *manager) Signal(ctx context.Context, name, signal string) (*gpmv1.Service, error) {
+	s, err := g.getService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := parseSignal(signal)
+	if err != nil {
+		return nil, verrs.BadRequest(g.Name(), err.Error())
+	}
+
+	g.RLock()
+	p := g.ps[s.Name]
+	g.RUnlock()
+
+	if err = g.signalService(p, sig); err != nil {
+		return nil, err
+	}
+	return p.Service, nil
+}
+
+func (g *manager) Reload(ctx context.Context, name string) (*gpmv1.Service, error) {
+	s, err := g.getService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g.RLock()
+	p := g.ps[s.Name]
+	g.RUnlock()
+
+	reload := p.Reload
+	if reload == nil {
+		return nil, verrs.BadRequest(g.Name(), "service %s has no reload definition", name)
+	}
+
+	if reload.Signal != "" {
+		sig, err := parseSignal(reload.Signal)
+		if err != nil {
+			return nil, verrs.BadRequest(g.Name(), err.Error())
+		}
+		if err = g.signalService(p, sig); err != nil {
+			return nil, err
+		}
+		return p.Service, nil
+	}
+
+	children := p.children()
+	if len(children) == 0 {
+		return nil, verrs.Conflict(g.Name(), "service %s is not running", name)
+	}
+	if err = runReload(ctx, p, reload, children[0].pid); err != nil {
+		return nil, verrs.InternalServerError(g.Name(), "reload service %s: %v", name, err)
+	}
+	return p.Service, nil
+}
+
+// signalService 向服务所有实例的主进程发送信号
+func (g *manager) signalService(p *Process, sig syscall.Signal) error {
+	if runtime.GOOS == "windows" {
+		return verrs.BadRequest(g.Name(), "signal is not supported on %s", runtime.GOOS)
+	}
+
+	children := p.children()
+	if len(children) == 0 {
+		return verrs.Conflict(g.Name(), "service %s is not running", p.Name)
+	}
+	for _, c := range children {
+		log.Infof("send %s to service %s(%d)", signalName(sig), p.Name, c.pid)
+		if err := signalChild(c, sig, false); err != nil {
+			return verrs.InternalServerError(g.Name(), "signal service %s(%d): %v", p.Name, c.pid, err)
+		}
+	}
+	return nil
+}
+
+// children 返回服务所有运行中的进程, 包括其他实例和 cron 服务同时运行的进程
+func (p *Process) children() []*child {
+	children := make([]*child, 0)
+	for _, r := range p.instances() {
+		children = append(children, r.ownChildren()...)
+	}
+	return children
+}
+
+// runReload 执行服务的重新加载命令, 输出写入服务日志
+func runReload(ctx context.Context, p *Process, reload *gpmv1.Reload, pid int) error {
+	s := p.Service
+	var out io.Writer = io.Discard
+	if lw := serviceLog(s); lw != nil {
+		out = lw
+	}
+
+	timeout := time.Duration(reload.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = time.Second * 30
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	env, err := serviceEnv(s)
+	if err != nil {
+		return err
+	}
+	if err = p.injectSecrets(env); err != nil {
+		return err
+	}
+	env["GPM_PID"] = strconv.Itoa(pid)
+	cmd := exec.CommandContext(ctx, reload.Command[0], reload.Command[1:]...)
+	cmd.Env = environ(env)
+	cmd.Dir = s.Dir
+	if s.SysProcAttr != nil {
+		injectSysProcAttr(cmd, s.SysProcAttr)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.WaitDelay = cmdWaitDelay
+
+	log.Infof("reload service %s", s.Name)
+	fmt.Fprintf(out, "[gpm] %s run reload command\n", time.Now().Format(time.RFC3339))
+	if err = cmd.Run(); err != nil {
+		fmt.Fprintf(out, "[gpm] %s reload failed: %v\n", time.Now().Format(time.RFC3339), err)
+		return err
+	}
+	fmt.Fprintf(out, "[gpm] %s reload done\n", time.Now().Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,182 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+const (
+	// 默认首次重启等待时间
+	defaultInitialBackoff = time.Second * 1
+	// 默认重启等待时间上限
+	defaultMaxBackoff = time.Minute * 5
+	// 默认统计重启次数的时间窗口
+	defaultRestartWindow = time.Minute * 10
+	// 默认时间窗口内允许的最大重启次数
+	defaultMaxRetries = 5
+)
+
+// backoff 根据服务的重启策略计算重启等待时间, 并统计时间窗口内的重启次数
+type backoff struct {
+	policy  string
+	initial time.Duration
+	max     time.Duration
+	retries int
+	window  time.Duration
+
+	delay    time.Duration
+	restarts []time.Time
+}
+
+func newBackoff(s *gpmv1.Service) *backoff {
+	b := &backoff{
+		initial: defaultInitialBackoff,
+		max:     defaultMaxBackoff,
+		retries: defaultMaxRetries,
+		window:  defaultRestartWindow,
+	}
+
+	// 兼容 autoRestart 参数, oneshot 服务默认不重启
+	if s.AutoRestart > 0 && s.Type != gpmv1.ServiceOneshot {
+		b.policy = gpmv1.RestartAlways
+	} else {
+		b.policy = gpmv1.RestartNever
+	}
+
+	rp := s.RestartPolicy
+	if rp == nil {
+		return b
+	}
+	if rp.Policy != "" {
+		b.policy = rp.Policy
+	}
+	if rp.InitialBackoff > 0 {
+		b.initial = time.Duration(rp.InitialBackoff) * time.Second
+	}
+	if rp.MaxBackoff > 0 {
+		b.max = time.Duration(rp.MaxBackoff) * time.Second
+	}
+	if b.max < b.initial {
+		b.max = b.initial
+	}
+	// MaxRetries 小于 0 时不限制重启次数
+	if rp.MaxRetries != 0 {
+		b.retries = int(rp.MaxRetries)
+	}
+	if rp.Window > 0 {
+		b.window = time.Duration(rp.Window) * time.Second
+	}
+
+	return b
+}
+
+// mergeRestartPolicy 合并修改的重启策略参数, 返回新的重启策略, 不修改 dst
+func mergeRestartPolicy(dst, src *gpmv1.RestartPolicy) *gpmv1.RestartPolicy {
+	out := &gpmv1.RestartPolicy{}
+	if dst != nil {
+		*out = *dst
+	}
+	dst = out
+	if src.Policy != "" {
+		dst.Policy = src.Policy
+	}
+	if src.InitialBackoff > 0 {
+		dst.InitialBackoff = src.InitialBackoff
+	}
+	if src.MaxBackoff > 0 {
+		dst.MaxBackoff = src.MaxBackoff
+	}
+	if src.MaxRetries != 0 {
+		dst.MaxRetries = src.MaxRetries
+	}
+	if src.Window > 0 {
+		dst.Window = src.Window
+	}
+	return dst
+}
+
+// shouldRestart 判断进程退出后是否需要重启
+func (b *backoff) shouldRestart(status *gpmv1.ExitStatus) bool {
+	switch b.policy {
+	case gpmv1.RestartAlways:
+		return true
+	case gpmv1.RestartOnFailure:
+		return !exitSuccess(status)
+	default:
+		return false
+	}
+}
+
+// next 返回下一次重启前的等待时间, 时间窗口内重启次数达到上限时返回 false
+func (b *backoff) next(now time.Time, uptime time.Duration) (time.Duration, bool) {
+	// 进程稳定运行超过等待时间上限, 重新计算等待时间
+	if uptime >= b.max {
+		b.delay = 0
+	}
+
+	restarts := b.restarts[:0]
+	for _, t := range b.restarts {
+		if now.Sub(t) < b.window {
+			restarts = append(restarts, t)
+		}
+	}
+	b.restarts = restarts
+	if b.retries > 0 && len(b.restarts) >= b.retries {
+		return 0, false
+	}
+	b.restarts = append(b.restarts, now)
+
+	if b.delay == 0 {
+		b.delay = b.initial
+	} else {
+		b.delay *= 2
+	}
+	if b.delay > b.max {
+		b.delay = b.max
+	}
+
+	return b.delay, true
+}
+
+// exitSuccess 判断进程是否正常退出
+func exitSuccess(status *gpmv1.ExitStatus) bool {
+	return status != nil && status.Code == 0 && status.Signal == ""
+}
+
+// exitReason 描述进程退出的原因
+func exitReason(status *gpmv1.ExitStatus) string {
+	switch {
+	case status == nil:
+		return "process exited"
+	case status.Signal != "":
+		return "signal: " + status.Signal
+	case status.Code >= 0:
+		return fmt.Sprintf("exit status %d", status.Code)
+	default:
+		return "process exited"
+	}
+}
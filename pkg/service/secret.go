@@ -0,0 +1,178 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/gpm/pkg/internal/store"
+	verrs "github.com/vine-io/vine/lib/errors"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// 密钥的值的大小上限
+const maxSecretSize = 1024 * 1024
+
+var envNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// checkSecretName 检查密钥名称, 避免名称中的路径访问密钥目录之外的文件
+func (g *manager) checkSecretName(name string) error {
+	if !store.ValidSecretName(name) {
+		return verrs.BadRequest(g.Name(), "invalid secret name '%s'", name)
+	}
+	return nil
+}
+
+func (g *manager) CreateSecret(ctx context.Context, spec *gpmv1.SecretSpec) (*gpmv1.Secret, error) {
+	if err := g.checkSecretName(spec.Name); err != nil {
+		return nil, err
+	}
+	if len(spec.Value) == 0 {
+		return nil, verrs.BadRequest(g.Name(), "field 'spec.value' is required")
+	}
+	if len(spec.Value) > maxSecretSize {
+		return nil, verrs.BadRequest(g.Name(), "secret value is larger than %d bytes", maxSecretSize)
+	}
+
+	now := time.Now().Unix()
+	secret := &gpmv1.Secret{
+		Name:              spec.Name,
+		Size:              int64(len(spec.Value)),
+		CreationTimestamp: now,
+		UpdateTimestamp:   now,
+	}
+	if v, _ := g.db.FindSecret(ctx, spec.Name); v != nil {
+		secret.CreationTimestamp = v.CreationTimestamp
+	}
+
+	secret, err := g.db.SaveSecret(ctx, secret, spec.Value)
+	if err != nil {
+		return nil, verrs.InternalServerError(g.Name(), "save secret %s: %v", spec.Name, err)
+	}
+	log.Infof("save secret %s", spec.Name)
+
+	return secret, nil
+}
+
+func (g *manager) ListSecrets(ctx context.Context) ([]*gpmv1.Secret, error) {
+	return g.db.FindAllSecrets(ctx)
+}
+
+func (g *manager) DeleteSecret(ctx context.Context, name string) (*gpmv1.Secret, error) {
+	if err := g.checkSecretName(name); err != nil {
+		return nil, err
+	}
+	secret, err := g.db.FindSecret(ctx, name)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, verrs.NotFound(g.Name(), "secret '%s' not exists", name)
+		}
+		return nil, err
+	}
+
+	g.RLock()
+	users := make([]string, 0)
+	for _, p := range g.ps {
+		for _, ref := range p.Secrets {
+			if ref.Name == name {
+				users = append(users, p.Name)
+				break
+			}
+		}
+	}
+	g.RUnlock()
+	if len(users) > 0 {
+		return nil, verrs.Conflict(g.Name(), "secret %s is used by service %s", name, strings.Join(users, ", "))
+	}
+
+	if err = g.db.DeleteSecret(ctx, name); err != nil {
+		return nil, err
+	}
+	log.Infof("delete secret %s", name)
+
+	return secret, nil
+}
+
+// validateSecrets 检查服务引用的密钥, 每个引用只能作为环境变量或者文件传递
+func validateSecrets(refs []*gpmv1.SecretRef) error {
+	for i, ref := range refs {
+		prefix := fmt.Sprintf("secrets[%d].", i)
+		if err := ref.ValidateE(prefix); err != nil {
+			return err
+		}
+		if !store.ValidSecretName(ref.Name) {
+			return fmt.Errorf("invalid field '%sname' %s", prefix, ref.Name)
+		}
+		if (ref.Env == "") == (ref.File == "") {
+			return fmt.Errorf("secret %s requires either env or file", ref.Name)
+		}
+		if ref.Env != "" && !envNameRegexp.MatchString(ref.Env) {
+			return fmt.Errorf("invalid field '%senv' %s", prefix, ref.Env)
+		}
+		if ref.File != "" {
+			file := filepath.Clean(ref.File)
+			if filepath.IsAbs(file) || file == "." || file == ".." || strings.HasPrefix(file, ".."+string(filepath.Separator)) {
+				return fmt.Errorf("field '%sfile' must be a relative path in service directory", prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// checkSecrets 检查服务引用的密钥是否存在
+func (g *manager) checkSecrets(ctx context.Context, refs []*gpmv1.SecretRef) error {
+	for _, ref := range refs {
+		if _, err := g.db.FindSecret(ctx, ref.Name); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return fmt.Errorf("secret '%s' not exists", ref.Name)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// injectSecrets 读取服务引用的密钥, 设置为环境变量或者写入服务目录下的文件, 密钥的值不写入日志
+func (p *Process) injectSecrets(env map[string]string) error {
+	for _, ref := range p.Secrets {
+		value, err := p.db.ReadSecret(context.TODO(), ref.Name)
+		if err != nil {
+			return fmt.Errorf("read secret %s: %v", ref.Name, err)
+		}
+		if ref.Env != "" {
+			env[ref.Env] = string(value)
+			continue
+		}
+		if err = writeSecretFile(p.Dir, ref.File, value, p.SysProcAttr); err != nil {
+			return fmt.Errorf("write secret %s: %v", ref.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !windows
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"golang.org/x/sys/unix"
+)
+
+// writeSecretFile 以 0600 权限写入密钥文件, 文件属于服务的用户.
+// 服务目录可能属于服务的用户, 逐级打开目录并且不跟随符号链接, 避免 gpmd 修改服务目录之外的文件
+func writeSecretFile(dir, file string, value []byte, attr *gpmv1.SysProcAttr) error {
+	dirfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return &os.PathError{Op: "open", Path: dir, Err: err}
+	}
+	parts := strings.Split(filepath.Clean(file), string(filepath.Separator))
+	for _, part := range parts[:len(parts)-1] {
+		dir = filepath.Join(dir, part)
+		if err = unix.Mkdirat(dirfd, part, 0755); err != nil && err != unix.EEXIST {
+			_ = unix.Close(dirfd)
+			return &os.PathError{Op: "mkdir", Path: dir, Err: err}
+		}
+		fd, err := unix.Openat(dirfd, part, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		_ = unix.Close(dirfd)
+		if err != nil {
+			return &os.PathError{Op: "open", Path: dir, Err: err}
+		}
+		dirfd = fd
+	}
+	defer unix.Close(dirfd)
+
+	name := parts[len(parts)-1]
+	tmp := name + ".tmp"
+	// 删除残留的临时文件, 如果是符号链接只删除链接本身
+	if err = unix.Unlinkat(dirfd, tmp, 0); err != nil && err != unix.ENOENT {
+		return &os.PathError{Op: "remove", Path: filepath.Join(dir, tmp), Err: err}
+	}
+	fd, err := unix.Openat(dirfd, tmp, unix.O_WRONLY|unix.O_CREAT|unix.O_EXCL|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0600)
+	if err != nil {
+		return &os.PathError{Op: "open", Path: filepath.Join(dir, tmp), Err: err}
+	}
+	f := os.NewFile(uintptr(fd), filepath.Join(dir, tmp))
+	_, err = f.Write(value)
+	// 创建文件时的权限受 umask 影响
+	if err == nil {
+		err = f.Chmod(0600)
+	}
+	if err == nil && attr != nil && os.Getuid() == 0 {
+		err = f.Chown(int(attr.Uid), int(attr.Gid))
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		// renameat 替换目录中的文件名, 不会跟随目标位置的符号链接
+		if err = unix.Renameat(dirfd, tmp, dirfd, name); err != nil {
+			err = &os.LinkError{Op: "rename", Old: filepath.Join(dir, tmp), New: filepath.Join(dir, name), Err: err}
+		}
+	}
+	if err != nil {
+		_ = unix.Unlinkat(dirfd, tmp, 0)
+	}
+	return err
+}
@@ -0,0 +1,226 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !windows
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+const (
+	// shimEnv gpmd 作为 shim 运行时的启动参数
+	shimEnv = "GPM_EXEC_SHIM"
+	// shimTimeout 等待 shim 执行服务命令的超时时间
+	shimTimeout = time.Second * 10
+)
+
+// shimConfig gpmd 通过 shim 执行服务命令时的参数
+type shimConfig struct {
+	Path       string              `json:"path"`
+	Args       []string            `json:"args"`
+	Dir        string              `json:"dir,omitempty"`
+	Chroot     string              `json:"chroot,omitempty"`
+	Credential *syscall.Credential `json:"credential,omitempty"`
+	// Attr 资源限制和调度参数
+	Attr *gpmv1.SysProcAttr `json:"attr,omitempty"`
+	// Cgroup 执行服务命令前加入的 cgroup 目录
+	Cgroup string `json:"cgroup,omitempty"`
+	// ListenPid 设置 LISTEN_PID 为服务进程的 pid
+	ListenPid bool `json:"listenPid,omitempty"`
+	// StatusFd 状态管道, 执行服务命令成功后自动关闭, 失败时写入错误信息
+	StatusFd int `json:"statusFd"`
+}
+
+// shim 通过 shim 启动的服务进程的状态管道
+type shim struct {
+	r, w *os.File
+}
+
+// IsShim 判断当前进程是否为 gpmd 启动服务进程的 shim
+func IsShim() bool {
+	return os.Getenv(shimEnv) != ""
+}
+
+// RunShim 在 shim 中设置资源限制, 完成 chroot, 切换用户和设置环境变量后执行服务命令, 执行成功时不返回.
+// 服务进程的 pid 和 shim 相同, 服务命令需要的设置都在执行前完成, 不依赖 chroot 中的 /bin/sh
+func RunShim() {
+	cfg := &shimConfig{StatusFd: -1}
+	err := json.Unmarshal([]byte(os.Getenv(shimEnv)), cfg)
+	if err == nil {
+		syscall.CloseOnExec(cfg.StatusFd)
+		err = cfg.exec()
+	}
+
+	msg := []byte(fmt.Sprintf("gpmd shim: %v", err))
+	if cfg.StatusFd < 0 {
+		_, _ = os.Stderr.Write(msg)
+	} else {
+		_, _ = syscall.Write(cfg.StatusFd, msg)
+	}
+	os.Exit(127)
+}
+
+func (cfg *shimConfig) exec() error {
+	runtime.LockOSThread()
+
+	// 加入 cgroup 需要在切换用户前完成, 服务命令创建的子进程同样受到资源限制
+	if cfg.Cgroup != "" {
+		procs := filepath.Join(cfg.Cgroup, "cgroup.procs")
+		if err := os.WriteFile(procs, []byte(strconv.Itoa(os.Getpid())), 0644); err != nil {
+			return fmt.Errorf("join cgroup %s: %v", cfg.Cgroup, err)
+		}
+	}
+	// 需要在 chroot 和切换用户前设置, 提高限制需要 root 权限, oom_score_adj 需要读写 /proc
+	if cfg.Attr != nil {
+		if err := applyProcAttr(os.Getpid(), cfg.Attr); err != nil {
+			return err
+		}
+	}
+	if cfg.Chroot != "" {
+		if err := syscall.Chroot(cfg.Chroot); err != nil {
+			return fmt.Errorf("chroot %s: %v", cfg.Chroot, err)
+		}
+		if cfg.Dir == "" {
+			cfg.Dir = "/"
+		}
+	}
+	if cfg.Dir != "" {
+		if err := syscall.Chdir(cfg.Dir); err != nil {
+			return fmt.Errorf("chdir %s: %v", cfg.Dir, err)
+		}
+	}
+	if cred := cfg.Credential; cred != nil {
+		if !cred.NoSetGroups {
+			groups := make([]int, 0, len(cred.Groups))
+			for _, g := range cred.Groups {
+				groups = append(groups, int(g))
+			}
+			if err := syscall.Setgroups(groups); err != nil {
+				return fmt.Errorf("setgroups: %v", err)
+			}
+		}
+		if err := syscall.Setgid(int(cred.Gid)); err != nil {
+			return fmt.Errorf("setgid %d: %v", cred.Gid, err)
+		}
+		if err := syscall.Setuid(int(cred.Uid)); err != nil {
+			return fmt.Errorf("setuid %d: %v", cred.Uid, err)
+		}
+	}
+
+	env := make([]string, 0)
+	for _, item := range os.Environ() {
+		if !strings.HasPrefix(item, shimEnv+"=") {
+			env = append(env, item)
+		}
+	}
+	if cfg.ListenPid {
+		env = append(env, "LISTEN_PID="+strconv.Itoa(os.Getpid()))
+	}
+
+	if err := syscall.Exec(cfg.Path, cfg.Args, env); err != nil {
+		return fmt.Errorf("exec %s: %v", cfg.Path, err)
+	}
+	return nil
+}
+
+// shimCommand 改为由 gpmd 作为 shim 执行服务命令. 加入 cgroup, 资源限制, chroot, 切换用户和工作目录都由 shim 完成,
+// 需要在设置 cmd 的 ExtraFiles 之后调用
+func shimCommand(cmd *exec.Cmd, listenPid bool, attr *gpmv1.SysProcAttr, cgroup string) (*shim, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &shimConfig{
+		Path:      cmd.Path,
+		Args:      cmd.Args,
+		Dir:       cmd.Dir,
+		Cgroup:    cgroup,
+		ListenPid: listenPid,
+		StatusFd:  3 + len(cmd.ExtraFiles),
+	}
+	if needShim(attr) {
+		cfg.Attr = attr
+	}
+	if attr := cmd.SysProcAttr; attr != nil {
+		cfg.Chroot, cfg.Credential = attr.Chroot, attr.Credential
+		attr.Chroot, attr.Credential = "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		_ = r.Close()
+		_ = w.Close()
+		return nil, err
+	}
+
+	cmd.Path = exe
+	cmd.Args = []string{exe}
+	cmd.Dir = ""
+	cmd.ExtraFiles = append(cmd.ExtraFiles, w)
+	cmd.Env = append(cmd.Env, shimEnv+"="+string(data))
+
+	return &shim{r: r, w: w}, nil
+}
+
+// wait 等待 shim 执行服务命令, 在进程启动后调用
+func (s *shim) wait() error {
+	_ = s.w.Close()
+	defer s.r.Close()
+
+	_ = s.r.SetReadDeadline(time.Now().Add(shimTimeout))
+	data, err := io.ReadAll(s.r)
+	if err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return errors.New("timeout waiting for service command exec")
+		}
+		return err
+	}
+	if len(data) > 0 {
+		return errors.New(string(data))
+	}
+	return nil
+}
+
+// close 进程启动失败时关闭状态管道
+func (s *shim) close() {
+	_ = s.w.Close()
+	_ = s.r.Close()
+}
@@ -0,0 +1,49 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"errors"
+	"os/exec"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+)
+
+type shim struct{}
+
+// IsShim windows 中不使用 shim 启动服务进程
+func IsShim() bool {
+	return false
+}
+
+func RunShim() {}
+
+func shimCommand(cmd *exec.Cmd, listenPid bool, attr *gpmv1.SysProcAttr, cgroup string) (*shim, error) {
+	return nil, errors.New("exec shim is not supported on windows")
+}
+
+func (s *shim) wait() error {
+	return nil
+}
+
+func (s *shim) close() {}
@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !windows
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// parseSignal 解析信号名称, 支持 SIGTERM, TERM 和信号编号等格式
+func parseSignal(name string) (syscall.Signal, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if n, err := strconv.Atoi(name); err == nil {
+		if unix.SignalName(syscall.Signal(n)) == "" {
+			return 0, fmt.Errorf("invalid signal %s", name)
+		}
+		return syscall.Signal(n), nil
+	}
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	sig := unix.SignalNum(name)
+	if sig == 0 {
+		return 0, fmt.Errorf("invalid signal %s", name)
+	}
+	return sig, nil
+}
+
+// signalName 返回信号名称, 如 SIGTERM
+func signalName(sig syscall.Signal) string {
+	if name := unix.SignalName(sig); name != "" {
+		return name
+	}
+	return sig.String()
+}
+
+// signalChild 向服务进程发送信号, group 为 true 时发送给整个进程组
+func signalChild(c *child, sig syscall.Signal, group bool) error {
+	if group {
+		return syscall.Kill(-c.pgid, sig)
+	}
+	return c.pr.Signal(sig)
+}
+
+// pauseChild 向服务进程组发送 SIGSTOP 暂停进程
+func pauseChild(c *child) error {
+	return signalGroup(c, syscall.SIGSTOP)
+}
+
+// resumeChild 向服务进程组发送 SIGCONT 恢复进程
+func resumeChild(c *child) error {
+	return signalGroup(c, syscall.SIGCONT)
+}
+
+// signalGroup 向进程组发送信号, 进程不是进程组的主进程时只发送给进程本身
+func signalGroup(c *child, sig syscall.Signal) error {
+	err := syscall.Kill(-c.pgid, sig)
+	if errors.Is(err, syscall.ESRCH) {
+		err = c.pr.Signal(sig)
+	}
+	return err
+}
+
+// processGroup 返回进程所在的进程组 id, 服务进程启动时设置了 Setpgid, 进程组 id 与主进程 id 相同
+func processGroup(pid int) int {
+	pgid, err := syscall.Getpgid(pid)
+	// 不能结束 gpmd 所在的进程组
+	if err != nil || pgid == syscall.Getpgrp() {
+		return pid
+	}
+	return pgid
+}
+
+// groupAlive 判断进程组内是否还有存活的进程
+func groupAlive(pgid int) bool {
+	err := syscall.Kill(-pgid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,197 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// listeners gpmd 为服务监听的套接字, 服务重启时保持监听
+var listeners = struct {
+	sync.Mutex
+	// m 服务名称 -> 监听地址 -> 套接字
+	m map[string]map[string]*os.File
+}{m: map[string]map[string]*os.File{}}
+
+// parseSocket 解析监听地址, 返回协议和地址
+func parseSocket(address string) (string, string, error) {
+	network, addr := "tcp", address
+	if i := strings.Index(address, "://"); i >= 0 {
+		network, addr = address[:i], address[i+3:]
+	}
+	switch network {
+	case "tcp", "tcp4", "tcp6", "udp", "udp4", "udp6", "unix":
+	default:
+		return "", "", fmt.Errorf("unsupported network %s", network)
+	}
+	if addr == "" {
+		return "", "", fmt.Errorf("invalid address %s", address)
+	}
+	return network, addr, nil
+}
+
+// validateSockets 检查服务的监听套接字
+func validateSockets(kind string, sockets []*gpmv1.Socket) error {
+	if len(sockets) == 0 {
+		return nil
+	}
+	if runtime.GOOS == "windows" {
+		return errors.New("socket activation is not supported on windows")
+	}
+	if kind != "" && kind != gpmv1.ServiceSimple {
+		return fmt.Errorf("socket activation only supports simple service")
+	}
+	for i, item := range sockets {
+		if err := item.ValidateE(fmt.Sprintf("sockets[%d].", i)); err != nil {
+			return err
+		}
+		if _, _, err := parseSocket(item.Address); err != nil {
+			return err
+		}
+		if strings.Contains(item.Name, ":") {
+			return fmt.Errorf("invalid socket name %s", item.Name)
+		}
+	}
+	return nil
+}
+
+// listenSockets 返回服务的监听套接字, 已经监听的地址继续使用, 不再使用的地址关闭监听
+func listenSockets(name string, sockets []*gpmv1.Socket) ([]*os.File, error) {
+	listeners.Lock()
+	defer listeners.Unlock()
+
+	opened := listeners.m[name]
+	if opened == nil {
+		opened = map[string]*os.File{}
+	}
+	files := make([]*os.File, 0, len(sockets))
+	used := map[string]bool{}
+	added := make([]string, 0)
+	for _, item := range sockets {
+		f, ok := opened[item.Address]
+		if !ok {
+			var err error
+			f, err = listen(item.Address)
+			if err != nil {
+				// 关闭本次新监听的套接字
+				for _, addr := range added {
+					_ = opened[addr].Close()
+					delete(opened, addr)
+				}
+				return nil, fmt.Errorf("listen %s: %v", item.Address, err)
+			}
+			log.Infof("service %s listen on %s", name, item.Address)
+			opened[item.Address] = f
+			added = append(added, item.Address)
+		}
+		used[item.Address] = true
+		files = append(files, f)
+	}
+	for addr, f := range opened {
+		if !used[addr] {
+			log.Infof("service %s close socket %s", name, addr)
+			_ = f.Close()
+			delete(opened, addr)
+		}
+	}
+	listeners.m[name] = opened
+
+	return files, nil
+}
+
+// listen 监听地址并返回对应的文件
+func listen(address string) (*os.File, error) {
+	network, addr, err := parseSocket(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch network {
+	case "udp", "udp4", "udp6":
+		conn, err := net.ListenPacket(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		return conn.(*net.UDPConn).File()
+	case "unix":
+		_ = os.Remove(addr)
+		ln, err := net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		ul := ln.(*net.UnixListener)
+		// 关闭监听时保留套接字文件, 由复制的 fd 继续监听
+		ul.SetUnlinkOnClose(false)
+		defer ul.Close()
+		return ul.File()
+	default:
+		ln, err := net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		defer ln.Close()
+		return ln.(*net.TCPListener).File()
+	}
+}
+
+// closeSockets 关闭服务的所有监听套接字
+func closeSockets(name string) {
+	listeners.Lock()
+	defer listeners.Unlock()
+
+	for addr, f := range listeners.m[name] {
+		log.Infof("service %s close socket %s", name, addr)
+		_ = f.Close()
+	}
+	delete(listeners.m, name)
+}
+
+// activateSockets 将套接字从 fd 3 开始传递给服务进程, LISTEN_PID 由 shim 在执行服务命令前设置
+func activateSockets(cmd *exec.Cmd, sockets []*gpmv1.Socket, files []*os.File) {
+	names := make([]string, 0, len(sockets))
+	for i, item := range sockets {
+		name := item.Name
+		if name == "" {
+			name = "socket" + strconv.Itoa(i)
+		}
+		names = append(names, name)
+	}
+
+	cmd.ExtraFiles = files
+	cmd.Env = append(cmd.Env,
+		"LISTEN_FDS="+strconv.Itoa(len(files)),
+		"LISTEN_FDNAMES="+strings.Join(names, ":"),
+	)
+}
@@ -0,0 +1,214 @@
+// MIT License
+//
+// Copyright (c) 2021 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	gpmv1 "github.com/vine-io/gpm/api/types/gpm/v1"
+	"github.com/vine-io/pkg/unit"
+	log "github.com/vine-io/vine/lib/logger"
+)
+
+// validateThreshold 检查资源占用阈值, 至少需要指定一项阈值
+func validateThreshold(kind string, threshold *gpmv1.Threshold) error {
+	if threshold == nil {
+		return nil
+	}
+	if kind == gpmv1.ServiceCron {
+		return fmt.Errorf("threshold is not supported by cron service")
+	}
+	if err := threshold.ValidateE("threshold."); err != nil {
+		return err
+	}
+	if threshold.Memory == 0 && threshold.MemPercent <= 0 && threshold.CpuPercent <= 0 {
+		return fmt.Errorf("threshold requires at least one of memory, memPercent and cpuPercent")
+	}
+	if threshold.MemPercent < 0 || threshold.CpuPercent < 0 || threshold.CpuWindow < 0 || threshold.Interval < 0 {
+		return fmt.Errorf("threshold values must not be negative")
+	}
+
+	switch threshold.Action {
+	case gpmv1.ThresholdSignal:
+		if runtime.GOOS == "windows" {
+			return fmt.Errorf("threshold action signal is not supported on %s", runtime.GOOS)
+		}
+		if threshold.Signal == "" {
+			return fmt.Errorf("field 'threshold.signal' is required")
+		}
+		if _, err := parseSignal(threshold.Signal); err != nil {
+			return err
+		}
+	default:
+		if threshold.Signal != "" {
+			return fmt.Errorf("threshold signal requires action signal")
+		}
+	}
+	return nil
+}
+
+// watcher 定时检查进程的资源占用
+type watcher struct {
+	threshold *gpmv1.Threshold
+	ticker    *time.Ticker
+
+	// memOver 内存占用已经超过阈值, 回落到阈值以下前不再重复触发
+	memOver bool
+	// cpuOver cpu 占用开始持续超过阈值的时间
+	cpuOver time.Time
+	// last 上一次检查的时间和进程累计使用的 cpu 时间
+	last    time.Time
+	lastCpu time.Duration
+}
+
+func newWatcher(threshold *gpmv1.Threshold) *watcher {
+	if threshold == nil {
+		return nil
+	}
+
+	return &watcher{
+		threshold: threshold,
+		ticker:    time.NewTicker(time.Duration(threshold.Interval) * time.Second),
+	}
+}
+
+// C 返回下一次检查的时间, watcher 为 nil 时永远不会触发
+func (w *watcher) C() <-chan time.Time {
+	if w == nil {
+		return nil
+	}
+	return w.ticker.C
+}
+
+func (w *watcher) stop() {
+	if w != nil {
+		w.ticker.Stop()
+	}
+}
+
+// check 根据进程的资源占用判断是否超过阈值, 返回超过阈值的原因
+func (w *watcher) check(stat *gpmv1.Stat, start, now time.Time) string {
+	t := w.threshold
+
+	over := ""
+	memOver := false
+	switch {
+	case t.Memory > 0 && stat.Memory > t.Memory:
+		memOver = true
+		over = fmt.Sprintf("memory %s exceeds threshold %s",
+			unit.ConvAuto(int64(stat.Memory), 2), unit.ConvAuto(int64(t.Memory), 2))
+	case t.MemPercent > 0 && stat.MemPercent > t.MemPercent:
+		memOver = true
+		over = fmt.Sprintf("memory %.2f%% exceeds threshold %.2f%%", stat.MemPercent, t.MemPercent)
+	}
+	if memOver && w.memOver {
+		over = ""
+	}
+	w.memOver = memOver
+
+	// statInstance 返回启动以来的平均占用, 根据两次检查之间累计 cpu 时间的变化计算这段时间内的占用
+	used := time.Duration(stat.CpuPercent / 100 * float64(now.Sub(start)))
+	last, lastCpu := w.last, w.lastCpu
+	w.last, w.lastCpu = now, used
+	if t.CpuPercent <= 0 || last.IsZero() || !now.After(last) {
+		return over
+	}
+
+	percent := float64(used-lastCpu) / float64(now.Sub(last)) * 100
+	if percent <= t.CpuPercent {
+		w.cpuOver = time.Time{}
+		return over
+	}
+	if w.cpuOver.IsZero() {
+		w.cpuOver = last
+	}
+	window := time.Duration(t.CpuWindow) * time.Second
+	if now.Sub(w.cpuOver) >= window && over == "" {
+		// 重新计算持续时间, 仍然超过阈值时在下一个时间窗口后再次触发
+		w.cpuOver = time.Time{}
+		over = fmt.Sprintf("cpu %.2f%% exceeds threshold %.2f%% for %v", percent, t.CpuPercent, window)
+	}
+
+	return over
+}
+
+// exceed 按照阈值的处理方式处理超过阈值的进程, 返回 true 时进程已经被结束
+func (p *Process) exceed(c *child, w *watcher, msg string) bool {
+	action := w.threshold.Action
+	log.Warnf("service %s(%d) %s, action: %s", p.Name, c.pid, msg, action)
+
+	switch action {
+	case gpmv1.ThresholdRestart:
+		// 退出记录由 watching 保存
+		p.mu.Lock()
+		c.reason = msg
+		c.kind = gpmv1.ExitThreshold
+		p.mu.Unlock()
+		if err := p.terminate(c); err != nil {
+			log.Errorf("stop service %s(%d): %v", p.Name, c.pid, err)
+		}
+		return true
+	case gpmv1.ThresholdSignal:
+		sig, _ := parseSignal(w.threshold.Signal)
+		msg = fmt.Sprintf("%s, send %s", msg, signalName(sig))
+		if err := signalChild(c, sig, false); err != nil {
+			log.Errorf("signal service %s(%d): %v", p.Name, c.pid, err)
+			msg = fmt.Sprintf("%s: %v", msg, err)
+		}
+	}
+
+	p.recordEvent(c, gpmv1.ExitThreshold, msg)
+	return false
+}
+
+// watchingStat 定时检查进程的资源占用, 超过阈值时按照服务的配置处理
+func (p *Process) watchingStat(done chan struct{}, c *child) {
+	w := newWatcher(p.Threshold)
+	if w == nil {
+		return
+	}
+	defer w.stop()
+
+	start := time.Unix(c.start.Unix(), 0)
+	for {
+		select {
+		case _, ok := <-done:
+			if !ok {
+				return
+			}
+		case <-c.exited:
+			return
+		case now := <-w.C():
+			stat := statInstance(p.Name, p.index, int64(c.pid), start.Unix())
+			msg := w.check(stat, start, now)
+			if msg == "" {
+				continue
+			}
+			if p.exceed(c, w, msg) {
+				return
+			}
+		}
+	}
+}
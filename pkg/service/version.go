@@ -26,6 +26,7 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -254,10 +255,14 @@ CHUNKED:
 	p := g.ps[service.Name]
 	g.RUnlock()
 
-	isRunning := service.Status == gpmv1.StatusRunning
-	if isRunning {
+	isRunning := service.Status == gpmv1.StatusRunning || service.Status == gpmv1.StatusPaused
+	// 使用 gpmd 监听的套接字的服务, 新版本启动后再停止旧版本
+	handover := isRunning && g.canHandover(p)
+	if isRunning && !handover {
 		log.Infof("stop service: %s", service.Name)
-		g.stopService(ctx, p)
+		if _, err = g.stopService(ctx, p, gpmv1.ExitUpgrade); err != nil && isHookError(err) {
+			return err
+		}
 	}
 
 	_ = file.Close()
@@ -321,19 +326,113 @@ CHUNKED:
 	log.Infof("service %s append version %s", service.Name, spec.Version)
 	_ = ioutil.WriteFile(filepath.Join(config.LoadRoot(), "services", spec.Name, "versions", vf), []byte(""), os.ModePerm)
 
+	previous := service.Version
 	service.Version = spec.Version
 	g.db.UpdateService(ctx, service)
 
-	p = NewProcess(service, g.db)
+	if handover {
+		log.Infof("handover service %s to %s", service.Name, spec.Version)
+		p.Version = spec.Version
+		if _, err = g.handover(ctx, p, gpmv1.ExitUpgrade); err != nil {
+			// 旧版本继续运行, 恢复服务目录
+			log.Errorf("handover service %s@%s: %v, rollback to %s", service.Name, spec.Version, err, previous)
+			result := &gpmv1.UpgradeServiceResult{
+				Error:    fmt.Sprintf("start version %s failed: %v", spec.Version, err),
+				Rollback: previous,
+			}
+			if e := g.rollback(ctx, p.Service, previous, false); e != nil {
+				result.Error += fmt.Sprintf(", rollback to %s failed: %v", previous, e)
+				result.Rollback = ""
+			}
+			return stream.Send(result)
+		}
+	} else {
+		p = NewProcess(service, g.db)
+	}
 	if isRunning {
-		log.Infof("start service %s", service.Name)
-		g.startService(ctx, p)
+		if !handover {
+			log.Infof("start service %s", service.Name)
+			_, err = g.startService(ctx, p)
+		}
+
+		if spec.VerifyTimeout > 0 {
+			timeout := time.Duration(spec.VerifyTimeout) * time.Second
+			_ = stream.Send(&gpmv1.UpgradeServiceResult{Message: fmt.Sprintf("verify service %s@%s in %v", service.Name, spec.Version, timeout)})
+			if err == nil {
+				err = g.verifyUpgrade(ctx, p, timeout)
+			}
+			if err != nil {
+				log.Errorf("verify service %s@%s: %v, rollback to %s", service.Name, spec.Version, err, previous)
+				result := &gpmv1.UpgradeServiceResult{
+					Error:    fmt.Sprintf("verify version %s failed: %v", spec.Version, err),
+					Rollback: previous,
+				}
+				if e := g.rollback(ctx, p.Service, previous, true); e != nil {
+					result.Error += fmt.Sprintf(", rollback to %s failed: %v", previous, e)
+					result.Rollback = ""
+				}
+				return stream.Send(result)
+			}
+		}
 	}
 
 	return stream.Send(&gpmv1.UpgradeServiceResult{IsOk: true})
 
 }
 
+// verifyUpgrade 检查升级后的服务在验证时间内保持运行, 配置了健康探测时还需要通过探测
+func (g *manager) verifyUpgrade(ctx context.Context, p *Process, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instances := p.instances()
+	ech := make(chan error, len(instances))
+	for _, r := range instances {
+		go func(r *Process) {
+			ech <- verifyInstance(ctx, r, timeout)
+		}(r)
+	}
+
+	var err error
+	for range instances {
+		if e := <-ech; e != nil && err == nil {
+			err = e
+			cancel()
+		}
+	}
+	return err
+}
+
+// verifyInstance 检查单个实例在 timeout 内是否正常运行
+func verifyInstance(ctx context.Context, p *Process, timeout time.Duration) error {
+	c := p.child()
+	if c == nil {
+		return fmt.Errorf("service not running: %s", p.Msg)
+	}
+
+	probed := p.LivenessProbe != nil || p.ReadinessProbe != nil
+	ticker := time.NewTicker(time.Millisecond * 500)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.exited:
+			return fmt.Errorf("process exited: %s", exitReason(c.status))
+		case <-ticker.C:
+			if p.Health == gpmv1.HealthUnhealthy {
+				return fmt.Errorf("health probe failed: %s", p.HealthMsg)
+			}
+		case <-ctx.Done():
+			if ctx.Err() != context.DeadlineExceeded {
+				return ctx.Err()
+			}
+			if probed && p.Health != gpmv1.HealthHealthy {
+				return fmt.Errorf("service not healthy after %v: %s", timeout, p.HealthMsg)
+			}
+			return nil
+		}
+	}
+}
+
 func (g *manager) Rollback(ctx context.Context, name string, version string) error {
 	s, err := g.getService(ctx, name)
 	if err != nil {
@@ -355,28 +454,40 @@ func (g *manager) Rollback(ctx context.Context, name string, version string) err
 		return verrs.NotFound(g.Name(), "invalid version '%s' of service:%s", version, name)
 	}
 
+	return g.rollback(ctx, s, version, s.Status == gpmv1.StatusRunning || s.Status == gpmv1.StatusPaused)
+}
+
+// rollback 将服务目录重新链接到指定版本, isRunning 为 true 时重启服务
+func (g *manager) rollback(ctx context.Context, s *gpmv1.Service, version string, isRunning bool) error {
 	g.RLock()
 	p := g.ps[s.Name]
 	g.RUnlock()
-	isRunning := s.Status == gpmv1.StatusRunning
-	if isRunning {
-		g.stopService(ctx, p)
+	handover := isRunning && g.canHandover(p)
+	if isRunning && !handover {
+		if _, err := g.stopService(ctx, p, gpmv1.ExitUpgrade); err != nil && isHookError(err) {
+			return err
+		}
 	}
 	dir := s.Dir
 	root := s.Dir + "_" + version
 	log.Infof("relink %s -> %s", dir, root)
 	_ = os.Remove(dir)
-	err = os.Symlink(root, dir)
-	if err != nil {
+	if err := os.Symlink(root, dir); err != nil {
 		return verrs.InternalServerError(g.Name(), err.Error())
 	}
 
 	s.Version = version
-	_, err = g.db.UpdateService(ctx, s)
+	_, err := g.db.UpdateService(ctx, s)
 	if err != nil {
 		return err
 	}
 
+	if handover {
+		p.Version = version
+		_, err = g.handover(ctx, p, gpmv1.ExitUpgrade)
+		return err
+	}
+
 	p = NewProcess(s, g.db)
 	if isRunning {
 		g.startService(ctx, p)